@@ -0,0 +1,30 @@
+-- Review moderation: free-text reviews, moderation state and user reports
+
+-- Add review text and moderation state to ratings
+ALTER TABLE ratings ADD COLUMN IF NOT EXISTS review TEXT;
+ALTER TABLE ratings ADD COLUMN IF NOT EXISTS moderation_status VARCHAR(16) NOT NULL DEFAULT 'none'
+    CHECK (moderation_status IN ('none', 'pending', 'approved', 'hidden'));
+ALTER TABLE ratings ADD COLUMN IF NOT EXISTS moderation_reason VARCHAR(255);
+
+-- Partial index for the moderation queue (only flagged rows are indexed)
+CREATE INDEX IF NOT EXISTS idx_ratings_moderation_status
+    ON ratings(moderation_status, updated_at)
+    WHERE moderation_status <> 'none';
+
+-- Create rating_reports table
+CREATE TABLE IF NOT EXISTS rating_reports (
+    id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    rating_id INTEGER NOT NULL,
+    reporter_id VARCHAR(100) NOT NULL,
+    reason VARCHAR(500),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    -- One report per reporter per rating
+    CONSTRAINT uq_rating_report_reporter UNIQUE (rating_id, reporter_id),
+
+    -- Foreign key to ratings table
+    CONSTRAINT fk_rating_reports_rating
+        FOREIGN KEY (rating_id)
+        REFERENCES ratings(id)
+        ON DELETE CASCADE
+);
@@ -0,0 +1,16 @@
+-- Rating anomaly detection: movies whose aggregate is under review
+
+CREATE TABLE IF NOT EXISTS rating_anomaly_reviews (
+    movie_title VARCHAR(255) PRIMARY KEY,
+    reason VARCHAR(255) NOT NULL,
+    -- Pre-burst public average, NULL when the average is not frozen
+    frozen_average DECIMAL(2,1),
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    -- Foreign key to movies table
+    CONSTRAINT fk_rating_anomaly_reviews_movie
+        FOREIGN KEY (movie_title)
+        REFERENCES movies(title)
+        ON DELETE CASCADE
+);
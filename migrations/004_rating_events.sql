@@ -0,0 +1,32 @@
+-- Rating history: append-only audit trail of rating changes
+
+CREATE TABLE IF NOT EXISTS rating_events (
+    id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    rating_id INTEGER NOT NULL,
+    movie_title VARCHAR(255) NOT NULL,
+    rater_id VARCHAR(100) NOT NULL,
+    event_type VARCHAR(16) NOT NULL CHECK (event_type IN ('created', 'updated')),
+    old_rating DECIMAL(2,1),
+    new_rating DECIMAL(2,1) NOT NULL,
+    client_ip VARCHAR(64),
+    user_agent VARCHAR(512),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+    -- No foreign keys: the audit trail outlives deleted movies and ratings
+);
+
+-- Create index for history queries by movie (newest first)
+CREATE INDEX IF NOT EXISTS idx_rating_events_movie_title ON rating_events(movie_title, id DESC);
+CREATE INDEX IF NOT EXISTS idx_rating_events_rater_id ON rating_events(rater_id);
+
+-- Reject updates and deletes to keep the table append-only
+CREATE OR REPLACE FUNCTION reject_rating_events_mutation()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'rating_events is append-only';
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER rating_events_append_only
+    BEFORE UPDATE OR DELETE ON rating_events
+    FOR EACH ROW
+    EXECUTE FUNCTION reject_rating_events_mutation();
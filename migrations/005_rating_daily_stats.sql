@@ -0,0 +1,65 @@
+-- Time-windowed rating aggregates backed by daily rollups
+
+-- rated_at changes only when a rating is submitted, unlike updated_at which
+-- also moves on moderation changes; rollups bucket ratings by this day
+ALTER TABLE ratings ADD COLUMN IF NOT EXISTS rated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP;
+UPDATE ratings SET rated_at = updated_at;
+
+-- Create rating_daily_stats table
+CREATE TABLE IF NOT EXISTS rating_daily_stats (
+    movie_title VARCHAR(255) NOT NULL,
+    day DATE NOT NULL,
+    rating_sum DECIMAL(12,1) NOT NULL DEFAULT 0,
+    rating_count INTEGER NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (movie_title, day),
+
+    -- Foreign key to movies table
+    CONSTRAINT fk_rating_daily_stats_movie
+        FOREIGN KEY (movie_title)
+        REFERENCES movies(title)
+        ON DELETE CASCADE
+);
+
+-- Create index for windowed rankings (all movies over a day range)
+CREATE INDEX IF NOT EXISTS idx_rating_daily_stats_day ON rating_daily_stats(day);
+
+-- Backfill rollups from existing visible ratings
+INSERT INTO rating_daily_stats (movie_title, day, rating_sum, rating_count)
+SELECT movie_title, (rated_at AT TIME ZONE 'UTC')::date, SUM(rating), COUNT(*)
+FROM ratings
+WHERE moderation_status <> 'hidden'
+GROUP BY movie_title, (rated_at AT TIME ZONE 'UTC')::date
+ON CONFLICT (movie_title, day) DO NOTHING;
+
+-- Keep rollups in sync on insert, upsert, moderation changes and deletes.
+-- Each visible rating counts once, on the (UTC) day it was last submitted.
+CREATE OR REPLACE FUNCTION maintain_rating_daily_stats()
+RETURNS TRIGGER AS $$
+BEGIN
+    -- Remove the old contribution
+    IF TG_OP IN ('UPDATE', 'DELETE') AND OLD.moderation_status <> 'hidden' THEN
+        UPDATE rating_daily_stats
+           SET rating_sum = rating_sum - OLD.rating,
+               rating_count = rating_count - 1
+         WHERE movie_title = OLD.movie_title
+           AND day = (OLD.rated_at AT TIME ZONE 'UTC')::date;
+    END IF;
+
+    -- Add the new contribution
+    IF TG_OP IN ('INSERT', 'UPDATE') AND NEW.moderation_status <> 'hidden' THEN
+        INSERT INTO rating_daily_stats (movie_title, day, rating_sum, rating_count)
+        VALUES (NEW.movie_title, (NEW.rated_at AT TIME ZONE 'UTC')::date, NEW.rating, 1)
+        ON CONFLICT (movie_title, day) DO UPDATE
+           SET rating_sum = rating_daily_stats.rating_sum + EXCLUDED.rating_sum,
+               rating_count = rating_daily_stats.rating_count + EXCLUDED.rating_count;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER ratings_maintain_daily_stats
+    AFTER INSERT OR DELETE OR UPDATE OF rating, rated_at, moderation_status ON ratings
+    FOR EACH ROW
+    EXECUTE FUNCTION maintain_rating_daily_stats();
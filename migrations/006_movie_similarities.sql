@@ -0,0 +1,25 @@
+-- Item-to-item similar movies, recomputed periodically from co-ratings
+
+CREATE TABLE IF NOT EXISTS movie_similarities (
+    movie_title VARCHAR(255) NOT NULL,
+    similar_title VARCHAR(255) NOT NULL,
+    -- Adjusted cosine similarity over co-raters, in [-1, 1]
+    similarity DOUBLE PRECISION NOT NULL,
+    co_raters INTEGER NOT NULL,
+    computed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (movie_title, similar_title),
+
+    -- Foreign keys to movies table
+    CONSTRAINT fk_movie_similarities_movie
+        FOREIGN KEY (movie_title)
+        REFERENCES movies(title)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_movie_similarities_similar
+        FOREIGN KEY (similar_title)
+        REFERENCES movies(title)
+        ON DELETE CASCADE
+);
+
+-- Create index for the top-K lookup per movie
+CREATE INDEX IF NOT EXISTS idx_movie_similarities_rank ON movie_similarities(movie_title, similarity DESC);
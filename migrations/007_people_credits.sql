@@ -0,0 +1,48 @@
+-- Cast, crew and people entities
+
+-- Create people table
+CREATE TABLE IF NOT EXISTS people (
+    id VARCHAR(64) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    birth_date DATE,
+    biography TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create index for name search and the director/actor movie filters
+CREATE INDEX IF NOT EXISTS idx_people_name ON people(LOWER(name));
+
+CREATE TRIGGER update_people_updated_at
+    BEFORE UPDATE ON people
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Create movie_credits table
+CREATE TABLE IF NOT EXISTS movie_credits (
+    id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    movie_id VARCHAR(64) NOT NULL,
+    person_id VARCHAR(64) NOT NULL,
+    role VARCHAR(16) NOT NULL
+        CHECK (role IN ('director', 'writer', 'actor')),
+    character_name VARCHAR(255),
+    billing_order INTEGER,
+
+    -- Foreign keys to movies and people tables
+    CONSTRAINT fk_movie_credits_movie
+        FOREIGN KEY (movie_id)
+        REFERENCES movies(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_movie_credits_person
+        FOREIGN KEY (person_id)
+        REFERENCES people(id)
+        ON DELETE CASCADE
+);
+
+-- A person holds each role once per movie, except actors playing several characters
+CREATE UNIQUE INDEX IF NOT EXISTS uq_movie_credits_role
+    ON movie_credits(movie_id, person_id, role, COALESCE(character_name, ''));
+
+-- Create indexes for credits of a movie and filmography of a person
+CREATE INDEX IF NOT EXISTS idx_movie_credits_movie_id ON movie_credits(movie_id);
+CREATE INDEX IF NOT EXISTS idx_movie_credits_person_id ON movie_credits(person_id);
@@ -0,0 +1,48 @@
+-- Franchises and curated collections of movies
+
+-- Create collections table
+CREATE TABLE IF NOT EXISTS collections (
+    id VARCHAR(64) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    owner_id VARCHAR(100) NOT NULL,
+    visibility VARCHAR(16) NOT NULL DEFAULT 'public'
+        CHECK (visibility IN ('public', 'private')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for listing by owner and name search
+CREATE INDEX IF NOT EXISTS idx_collections_owner_id ON collections(owner_id);
+CREATE INDEX IF NOT EXISTS idx_collections_name ON collections(LOWER(name));
+
+CREATE TRIGGER update_collections_updated_at
+    BEFORE UPDATE ON collections
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Create collection_items table; positions are 0-based and contiguous per collection
+CREATE TABLE IF NOT EXISTS collection_items (
+    collection_id VARCHAR(64) NOT NULL,
+    movie_id VARCHAR(64) NOT NULL,
+    position INTEGER NOT NULL CHECK (position >= 0),
+    added_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (collection_id, movie_id),
+
+    -- Foreign keys to collections and movies tables
+    CONSTRAINT fk_collection_items_collection
+        FOREIGN KEY (collection_id)
+        REFERENCES collections(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_collection_items_movie
+        FOREIGN KEY (movie_id)
+        REFERENCES movies(id)
+        ON DELETE CASCADE
+);
+
+-- Create index for reading a collection in order
+CREATE INDEX IF NOT EXISTS idx_collection_items_position ON collection_items(collection_id, position);
+
+-- Create index for finding the collections of a movie
+CREATE INDEX IF NOT EXISTS idx_collection_items_movie_id ON collection_items(movie_id);
@@ -0,0 +1,137 @@
+-- Managed genre taxonomy with aliases, multiple genres per movie and free-form tags
+
+-- Genre keys are case- and punctuation-insensitive: "Sci-Fi" and "sci fi" both become "sci fi".
+-- Must match biz.GenreKey.
+CREATE OR REPLACE FUNCTION genre_key(value TEXT)
+RETURNS TEXT AS $$
+    SELECT TRIM(REGEXP_REPLACE(LOWER(value), '[^a-z0-9]+', ' ', 'g'));
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- Create genres table; the ID is a slug of the name's key, e.g. science-fiction
+CREATE TABLE IF NOT EXISTS genres (
+    id VARCHAR(100) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create genre_aliases table; every genre is also an alias of itself
+CREATE TABLE IF NOT EXISTS genre_aliases (
+    alias VARCHAR(100) PRIMARY KEY,
+    genre_id VARCHAR(100) NOT NULL,
+
+    -- Foreign key to genres table
+    CONSTRAINT fk_genre_aliases_genre
+        FOREIGN KEY (genre_id)
+        REFERENCES genres(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_genre_aliases_genre_id ON genre_aliases(genre_id);
+
+-- Create movie_genres table; position 0 is the movie's primary genre
+CREATE TABLE IF NOT EXISTS movie_genres (
+    movie_id VARCHAR(64) NOT NULL,
+    genre_id VARCHAR(100) NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (movie_id, genre_id),
+
+    -- Foreign keys to movies and genres tables
+    CONSTRAINT fk_movie_genres_movie
+        FOREIGN KEY (movie_id)
+        REFERENCES movies(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_movie_genres_genre
+        FOREIGN KEY (genre_id)
+        REFERENCES genres(id)
+        ON DELETE CASCADE
+);
+
+-- Create index for the genre filter
+CREATE INDEX IF NOT EXISTS idx_movie_genres_genre_id ON movie_genres(genre_id);
+
+-- Create movie_tags table; tags are stored lowercase
+CREATE TABLE IF NOT EXISTS movie_tags (
+    movie_id VARCHAR(64) NOT NULL,
+    tag VARCHAR(50) NOT NULL,
+
+    PRIMARY KEY (movie_id, tag),
+
+    -- Foreign key to movies table
+    CONSTRAINT fk_movie_tags_movie
+        FOREIGN KEY (movie_id)
+        REFERENCES movies(id)
+        ON DELETE CASCADE
+);
+
+-- Create index for the tag filter
+CREATE INDEX IF NOT EXISTS idx_movie_tags_tag ON movie_tags(tag);
+
+-- Seed the base taxonomy
+INSERT INTO genres (id, name) VALUES
+    ('action', 'Action'),
+    ('adventure', 'Adventure'),
+    ('animation', 'Animation'),
+    ('biography', 'Biography'),
+    ('comedy', 'Comedy'),
+    ('crime', 'Crime'),
+    ('documentary', 'Documentary'),
+    ('drama', 'Drama'),
+    ('family', 'Family'),
+    ('fantasy', 'Fantasy'),
+    ('history', 'History'),
+    ('horror', 'Horror'),
+    ('musical', 'Musical'),
+    ('mystery', 'Mystery'),
+    ('romance', 'Romance'),
+    ('science-fiction', 'Science Fiction'),
+    ('thriller', 'Thriller'),
+    ('war', 'War'),
+    ('western', 'Western')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO genre_aliases (alias, genre_id)
+SELECT genre_key(name), id FROM genres
+ON CONFLICT DO NOTHING;
+
+INSERT INTO genre_aliases (alias, genre_id) VALUES
+    ('animated', 'animation'),
+    ('biopic', 'biography'),
+    ('documentaries', 'documentary'),
+    ('historical', 'history'),
+    ('music', 'musical'),
+    ('romantic', 'romance'),
+    ('sci fi', 'science-fiction'),
+    ('scifi', 'science-fiction'),
+    ('sf', 'science-fiction'),
+    ('suspense', 'thriller')
+ON CONFLICT DO NOTHING;
+
+-- Normalize existing genre strings. A movie's genre may list several genres
+-- separated by commas, slashes or pipes; parts matching no alias become new genres.
+CREATE TEMPORARY TABLE movie_genre_parts AS
+SELECT movies.id AS movie_id, TRIM(part.value) AS name, genre_key(part.value) AS key, part.ord
+FROM movies, REGEXP_SPLIT_TO_TABLE(movies.genre, '[,/|]') WITH ORDINALITY AS part(value, ord)
+WHERE genre_key(part.value) <> '';
+
+INSERT INTO genres (id, name)
+SELECT DISTINCT ON (key) REPLACE(key, ' ', '-'), name
+FROM movie_genre_parts
+WHERE key NOT IN (SELECT alias FROM genre_aliases)
+ORDER BY key, name
+ON CONFLICT DO NOTHING;
+
+INSERT INTO genre_aliases (alias, genre_id)
+SELECT DISTINCT parts.key, genres.id
+FROM movie_genre_parts parts
+JOIN genres ON genres.id = REPLACE(parts.key, ' ', '-')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO movie_genres (movie_id, genre_id, position)
+SELECT parts.movie_id, genre_aliases.genre_id, MIN(parts.ord) - 1
+FROM movie_genre_parts parts
+JOIN genre_aliases ON genre_aliases.alias = parts.key
+GROUP BY parts.movie_id, genre_aliases.genre_id
+ON CONFLICT DO NOTHING;
+
+DROP TABLE movie_genre_parts;
@@ -0,0 +1,74 @@
+-- Repeatable titles disambiguated by release year, and alternate titles
+
+-- title_key identifies a movie wherever other tables and caches refer to it by
+-- title (ratings, rollups, similarities, leaderboards). It is the title itself,
+-- or "Title (YYYY)" when another movie already holds the plain title.
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS title_key VARCHAR(255);
+UPDATE movies SET title_key = title WHERE title_key IS NULL;
+ALTER TABLE movies ALTER COLUMN title_key SET NOT NULL;
+ALTER TABLE movies ADD CONSTRAINT uq_movies_title_key UNIQUE (title_key);
+
+-- Repoint foreign keys from title to title_key
+ALTER TABLE ratings DROP CONSTRAINT IF EXISTS fk_ratings_movie;
+ALTER TABLE ratings ADD CONSTRAINT fk_ratings_movie
+    FOREIGN KEY (movie_title)
+    REFERENCES movies(title_key)
+    ON DELETE CASCADE;
+
+ALTER TABLE rating_anomaly_reviews DROP CONSTRAINT IF EXISTS fk_rating_anomaly_reviews_movie;
+ALTER TABLE rating_anomaly_reviews ADD CONSTRAINT fk_rating_anomaly_reviews_movie
+    FOREIGN KEY (movie_title)
+    REFERENCES movies(title_key)
+    ON DELETE CASCADE;
+
+ALTER TABLE rating_daily_stats DROP CONSTRAINT IF EXISTS fk_rating_daily_stats_movie;
+ALTER TABLE rating_daily_stats ADD CONSTRAINT fk_rating_daily_stats_movie
+    FOREIGN KEY (movie_title)
+    REFERENCES movies(title_key)
+    ON DELETE CASCADE;
+
+ALTER TABLE movie_similarities DROP CONSTRAINT IF EXISTS fk_movie_similarities_movie;
+ALTER TABLE movie_similarities ADD CONSTRAINT fk_movie_similarities_movie
+    FOREIGN KEY (movie_title)
+    REFERENCES movies(title_key)
+    ON DELETE CASCADE;
+
+ALTER TABLE movie_similarities DROP CONSTRAINT IF EXISTS fk_movie_similarities_similar;
+ALTER TABLE movie_similarities ADD CONSTRAINT fk_movie_similarities_similar
+    FOREIGN KEY (similar_title)
+    REFERENCES movies(title_key)
+    ON DELETE CASCADE;
+
+-- Titles may repeat across release years (remakes), but not within one.
+-- movies_title_key is the constraint created by UNIQUE on title in 001.
+ALTER TABLE movies DROP CONSTRAINT IF EXISTS movies_title_key;
+CREATE INDEX IF NOT EXISTS idx_movies_title ON movies(title);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_movies_title_year
+    ON movies(title, EXTRACT(YEAR FROM release_date))
+    WHERE deleted_at IS NULL;
+
+-- Create alternate_titles table (localized, original-language, working titles...)
+CREATE TABLE IF NOT EXISTS alternate_titles (
+    id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    movie_id VARCHAR(64) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    language VARCHAR(35),  -- BCP 47 tag, e.g. zh-Hans
+    region VARCHAR(3),     -- ISO 3166-1 alpha-2 or UN M49 code, e.g. CN
+    kind VARCHAR(16) NOT NULL DEFAULT 'alternative'
+        CHECK (kind IN ('original', 'localized', 'working', 'alternative')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign key to movies table
+    CONSTRAINT fk_alternate_titles_movie
+        FOREIGN KEY (movie_id)
+        REFERENCES movies(id)
+        ON DELETE CASCADE
+);
+
+-- A movie lists each title once per language and region
+CREATE UNIQUE INDEX IF NOT EXISTS uq_alternate_titles
+    ON alternate_titles(movie_id, title, COALESCE(language, ''), COALESCE(region, ''));
+
+-- Create indexes for title lookups and search
+CREATE INDEX IF NOT EXISTS idx_alternate_titles_title ON alternate_titles(title);
+CREATE INDEX IF NOT EXISTS idx_alternate_titles_movie_id ON alternate_titles(movie_id);
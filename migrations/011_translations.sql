@@ -0,0 +1,39 @@
+-- Localized movie metadata and genre names
+
+-- Create movie_translations table; locales are canonical BCP 47 tags, e.g. pt-BR
+CREATE TABLE IF NOT EXISTS movie_translations (
+    movie_id VARCHAR(64) NOT NULL,
+    locale VARCHAR(35) NOT NULL,
+    synopsis TEXT,
+    tagline VARCHAR(500),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (movie_id, locale),
+
+    -- Foreign key to movies table
+    CONSTRAINT fk_movie_translations_movie
+        FOREIGN KEY (movie_id)
+        REFERENCES movies(id)
+        ON DELETE CASCADE
+);
+
+CREATE TRIGGER update_movie_translations_updated_at
+    BEFORE UPDATE ON movie_translations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Create genre_translations table
+CREATE TABLE IF NOT EXISTS genre_translations (
+    genre_id VARCHAR(100) NOT NULL,
+    locale VARCHAR(35) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+
+    PRIMARY KEY (genre_id, locale),
+
+    -- Foreign key to genres table
+    CONSTRAINT fk_genre_translations_genre
+        FOREIGN KEY (genre_id)
+        REFERENCES genres(id)
+        ON DELETE CASCADE
+);
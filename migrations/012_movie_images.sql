@@ -0,0 +1,40 @@
+-- Posters and stills uploaded for movies
+
+-- Create movie_images table; files live in the blob store under blob_key
+CREATE TABLE IF NOT EXISTS movie_images (
+    id VARCHAR(64) PRIMARY KEY,
+    movie_id VARCHAR(64) NOT NULL,
+    kind VARCHAR(16) NOT NULL CHECK (kind IN ('poster', 'still')),
+    content_type VARCHAR(50) NOT NULL,
+    width INTEGER NOT NULL CHECK (width > 0),
+    height INTEGER NOT NULL CHECK (height > 0),
+    size_bytes BIGINT NOT NULL,
+    blob_key VARCHAR(512) NOT NULL,
+    url TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign key to movies table
+    CONSTRAINT fk_movie_images_movie
+        FOREIGN KEY (movie_id)
+        REFERENCES movies(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_movie_images_movie_id ON movie_images(movie_id);
+
+-- Create movie_image_thumbnails table, one row per generated width
+CREATE TABLE IF NOT EXISTS movie_image_thumbnails (
+    image_id VARCHAR(64) NOT NULL,
+    width INTEGER NOT NULL CHECK (width > 0),
+    height INTEGER NOT NULL CHECK (height > 0),
+    blob_key VARCHAR(512) NOT NULL,
+    url TEXT NOT NULL,
+
+    PRIMARY KEY (image_id, width),
+
+    -- Foreign key to movie_images table
+    CONSTRAINT fk_movie_image_thumbnails_image
+        FOREIGN KEY (image_id)
+        REFERENCES movie_images(id)
+        ON DELETE CASCADE
+);
@@ -0,0 +1,25 @@
+-- External identifiers of movies (IMDb, TMDB, EIDR)
+
+-- Create movie_external_ids table; a movie has at most one ID per scheme and
+-- an ID belongs to at most one movie. IDs are stored in canonical form.
+CREATE TABLE IF NOT EXISTS movie_external_ids (
+    movie_id VARCHAR(64) NOT NULL,
+    scheme VARCHAR(16) NOT NULL CHECK (scheme IN ('imdb', 'tmdb', 'eidr')),
+    value VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (movie_id, scheme),
+    CONSTRAINT uq_movie_external_ids_scheme_value UNIQUE (scheme, value),
+
+    -- Foreign key to movies table
+    CONSTRAINT fk_movie_external_ids_movie
+        FOREIGN KEY (movie_id)
+        REFERENCES movies(id)
+        ON DELETE CASCADE
+);
+
+CREATE TRIGGER update_movie_external_ids_updated_at
+    BEFORE UPDATE ON movie_external_ids
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
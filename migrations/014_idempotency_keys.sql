@@ -0,0 +1,16 @@
+-- Responses replayed to requests retried with the same Idempotency-Key, used
+-- when Redis is not configured
+
+-- Create idempotency_keys table; a NULL response marks a request in progress
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    principal VARCHAR(128) NOT NULL,
+    key VARCHAR(255) NOT NULL,
+    request_hash CHAR(64) NOT NULL,
+    response BYTEA,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (principal, key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
@@ -0,0 +1,6 @@
+-- Optimistic concurrency: a version counting the writes to each movie and
+-- rating. Writes made against an older version than the current one are
+-- rejected instead of overwriting the changes made since.
+
+ALTER TABLE movies ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE ratings ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,26 @@
+-- Rankings and similar-movie scoring treat the genre at position 0 as a
+-- movie's primary genre, and the genre column holds its canonical name
+
+-- Migration 009 numbered genres by their place in the old genre string, which
+-- leaves gaps where parts were empty
+UPDATE movie_genres
+SET position = numbered.position
+FROM (
+    SELECT movie_id, genre_id, ROW_NUMBER() OVER (PARTITION BY movie_id ORDER BY position, genre_id) - 1 AS position
+    FROM movie_genres
+) numbered
+WHERE movie_genres.movie_id = numbered.movie_id
+  AND movie_genres.genre_id = numbered.genre_id
+  AND movie_genres.position <> numbered.position;
+
+-- Movies created before genres were resolved kept the genre string as given,
+-- e.g. "sci-fi, action". Rebuild the rankings afterwards to move their
+-- leaderboard entries to the segments of the canonical genres.
+UPDATE movies
+SET genre = genres.name,
+    version = movies.version + 1
+FROM movie_genres
+JOIN genres ON genres.id = movie_genres.genre_id
+WHERE movie_genres.movie_id = movies.id
+  AND movie_genres.position = 0
+  AND movies.genre <> genres.name;
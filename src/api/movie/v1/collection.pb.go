@@ -0,0 +1,988 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
+// source: movie/v1/collection.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Collection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Visibility    string                 `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"` // public or private
+	ItemCount     int32                  `protobuf:"varint,6,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	Items         []*CollectionItem      `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`         // Omitted in listings
+	Rating        *CollectionRating      `protobuf:"bytes,8,opt,name=rating,proto3,oneof" json:"rating,omitempty"` // Omitted in listings
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_movie_v1_collection_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_collection_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_movie_v1_collection_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Collection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Collection) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Collection) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *Collection) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *Collection) GetItems() []*CollectionItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Collection) GetRating() *CollectionRating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+func (x *Collection) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Collection) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CollectionItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movie         *MovieItem             `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"` // 0-based
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionItem) Reset() {
+	*x = CollectionItem{}
+	mi := &file_movie_v1_collection_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionItem) ProtoMessage() {}
+
+func (x *CollectionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_collection_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionItem.ProtoReflect.Descriptor instead.
+func (*CollectionItem) Descriptor() ([]byte, []int) {
+	return file_movie_v1_collection_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CollectionItem) GetMovie() *MovieItem {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+func (x *CollectionItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *CollectionItem) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+// Aggregate of the members' ratings; the average is weighted by rating count
+type CollectionRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Average       float64                `protobuf:"fixed64,1,opt,name=average,proto3" json:"average,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	RatedMovies   int32                  `protobuf:"varint,3,opt,name=rated_movies,json=ratedMovies,proto3" json:"rated_movies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionRating) Reset() {
+	*x = CollectionRating{}
+	mi := &file_movie_v1_collection_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionRating) ProtoMessage() {}
+
+func (x *CollectionRating) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_collection_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionRating.ProtoReflect.Descriptor instead.
+func (*CollectionRating) Descriptor() ([]byte, []int) {
+	return file_movie_v1_collection_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CollectionRating) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *CollectionRating) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CollectionRating) GetRatedMovies() int32 {
+	if x != nil {
+		return x.RatedMovies
+	}
+	return 0
+}
+
+// Messages for CreateCollection
+type CreateCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Visibility    *string                `protobuf:"bytes,3,opt,name=visibility,proto3,oneof" json:"visibility,omitempty"` // public (default) or private
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
+	mi := &file_movie_v1_collection_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionRequest) ProtoMessage() {}
+
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_collection_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_collection_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetVisibility() string {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return ""
+}
+
+// Messages for GetCollection
+type GetCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionRequest) Reset() {
+	*x = GetCollectionRequest{}
+	mi := &file_movie_v1_collection_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionRequest) ProtoMessage() {}
+
+func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_collection_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_collection_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Messages for UpdateCollection
+type UpdateCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // from path
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Visibility    *string                `protobuf:"bytes,4,opt,name=visibility,proto3,oneof" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCollectionRequest) Reset() {
+	*x = UpdateCollectionRequest{}
+	mi := &file_movie_v1_collection_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionRequest) ProtoMessage() {}
+
+func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_collection_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_collection_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetVisibility() string {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return ""
+}
+
+// Messages for DeleteCollection
+type DeleteCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
+	mi := &file_movie_v1_collection_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_collection_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_collection_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCollectionReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionReply) Reset() {
+	*x = DeleteCollectionReply{}
+	mi := &file_movie_v1_collection_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionReply) ProtoMessage() {}
+
+func (x *DeleteCollectionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_collection_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionReply.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_collection_proto_rawDescGZIP(), []int{7}
+}
+
+// Messages for ListCollections
+type ListCollectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             *string                `protobuf:"bytes,1,opt,name=q,proto3,oneof" json:"q,omitempty"`
+	OwnerId       *string                `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3,oneof" json:"owner_id,omitempty"`
+	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsRequest) Reset() {
+	*x = ListCollectionsRequest{}
+	mi := &file_movie_v1_collection_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsRequest) ProtoMessage() {}
+
+func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_collection_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_collection_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListCollectionsRequest) GetQ() string {
+	if x != nil && x.Q != nil {
+		return *x.Q
+	}
+	return ""
+}
+
+func (x *ListCollectionsRequest) GetOwnerId() string {
+	if x != nil && x.OwnerId != nil {
+		return *x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListCollectionsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListCollectionsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type ListCollectionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Collection          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsReply) Reset() {
+	*x = ListCollectionsReply{}
+	mi := &file_movie_v1_collection_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsReply) ProtoMessage() {}
+
+func (x *ListCollectionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_collection_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsReply.ProtoReflect.Descriptor instead.
+func (*ListCollectionsReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_collection_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListCollectionsReply) GetItems() []*Collection {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListCollectionsReply) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+// Messages for AddCollectionItem
+type AddCollectionItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // from path
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Position      *int32                 `protobuf:"varint,3,opt,name=position,proto3,oneof" json:"position,omitempty"` // 0-based; appended when omitted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCollectionItemRequest) Reset() {
+	*x = AddCollectionItemRequest{}
+	mi := &file_movie_v1_collection_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCollectionItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCollectionItemRequest) ProtoMessage() {}
+
+func (x *AddCollectionItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_collection_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCollectionItemRequest.ProtoReflect.Descriptor instead.
+func (*AddCollectionItemRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_collection_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddCollectionItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddCollectionItemRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AddCollectionItemRequest) GetPosition() int32 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+// Messages for RemoveCollectionItem
+type RemoveCollectionItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCollectionItemRequest) Reset() {
+	*x = RemoveCollectionItemRequest{}
+	mi := &file_movie_v1_collection_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCollectionItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCollectionItemRequest) ProtoMessage() {}
+
+func (x *RemoveCollectionItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_collection_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCollectionItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCollectionItemRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_collection_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RemoveCollectionItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveCollectionItemRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// Messages for ReorderCollectionItems
+type ReorderCollectionItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`         // from path
+	Titles        []string               `protobuf:"bytes,2,rep,name=titles,proto3" json:"titles,omitempty"` // Every movie of the collection, in the new order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCollectionItemsRequest) Reset() {
+	*x = ReorderCollectionItemsRequest{}
+	mi := &file_movie_v1_collection_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCollectionItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCollectionItemsRequest) ProtoMessage() {}
+
+func (x *ReorderCollectionItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_collection_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCollectionItemsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionItemsRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_collection_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReorderCollectionItemsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReorderCollectionItemsRequest) GetTitles() []string {
+	if x != nil {
+		return x.Titles
+	}
+	return nil
+}
+
+var File_movie_v1_collection_proto protoreflect.FileDescriptor
+
+const file_movie_v1_collection_proto_rawDesc = "" +
+	"\n" +
+	"\x19movie/v1/collection.proto\x12\fapi.movie.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14movie/v1/movie.proto\"\xb3\x03\n" +
+	"\n" +
+	"Collection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x19\n" +
+	"\bowner_id\x18\x04 \x01(\tR\aownerId\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\tR\n" +
+	"visibility\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x06 \x01(\x05R\titemCount\x122\n" +
+	"\x05items\x18\a \x03(\v2\x1c.api.movie.v1.CollectionItemR\x05items\x12;\n" +
+	"\x06rating\x18\b \x01(\v2\x1e.api.movie.v1.CollectionRatingH\x01R\x06rating\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"\f_descriptionB\t\n" +
+	"\a_rating\"\x92\x01\n" +
+	"\x0eCollectionItem\x12-\n" +
+	"\x05movie\x18\x01 \x01(\v2\x17.api.movie.v1.MovieItemR\x05movie\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x125\n" +
+	"\badded_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\"e\n" +
+	"\x10CollectionRating\x12\x18\n" +
+	"\aaverage\x18\x01 \x01(\x01R\aaverage\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12!\n" +
+	"\frated_movies\x18\x03 \x01(\x05R\vratedMovies\"\x98\x01\n" +
+	"\x17CreateCollectionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12#\n" +
+	"\n" +
+	"visibility\x18\x03 \x01(\tH\x01R\n" +
+	"visibility\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_visibility\"&\n" +
+	"\x14GetCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb6\x01\n" +
+	"\x17UpdateCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12#\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\tH\x02R\n" +
+	"visibility\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_visibility\")\n" +
+	"\x17DeleteCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15DeleteCollectionReply\"\xab\x01\n" +
+	"\x16ListCollectionsRequest\x12\x11\n" +
+	"\x01q\x18\x01 \x01(\tH\x00R\x01q\x88\x01\x01\x12\x1e\n" +
+	"\bowner_id\x18\x02 \x01(\tH\x01R\aownerId\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x03 \x01(\x05H\x02R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x04 \x01(\tH\x03R\x06cursor\x88\x01\x01B\x04\n" +
+	"\x02_qB\v\n" +
+	"\t_owner_idB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursor\"|\n" +
+	"\x14ListCollectionsReply\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.api.movie.v1.CollectionR\x05items\x12$\n" +
+	"\vnext_cursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\"n\n" +
+	"\x18AddCollectionItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1f\n" +
+	"\bposition\x18\x03 \x01(\x05H\x00R\bposition\x88\x01\x01B\v\n" +
+	"\t_position\"C\n" +
+	"\x1bRemoveCollectionItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"G\n" +
+	"\x1dReorderCollectionItemsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06titles\x18\x02 \x03(\tR\x06titles2\xd4\a\n" +
+	"\x11CollectionService\x12l\n" +
+	"\x10CreateCollection\x12%.api.movie.v1.CreateCollectionRequest\x1a\x18.api.movie.v1.Collection\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/collections\x12h\n" +
+	"\rGetCollection\x12\".api.movie.v1.GetCollectionRequest\x1a\x18.api.movie.v1.Collection\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/collections/{id}\x12q\n" +
+	"\x10UpdateCollection\x12%.api.movie.v1.UpdateCollectionRequest\x1a\x18.api.movie.v1.Collection\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/collections/{id}\x12y\n" +
+	"\x10DeleteCollection\x12%.api.movie.v1.DeleteCollectionRequest\x1a#.api.movie.v1.DeleteCollectionReply\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/collections/{id}\x12q\n" +
+	"\x0fListCollections\x12$.api.movie.v1.ListCollectionsRequest\x1a\".api.movie.v1.ListCollectionsReply\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/collections\x12y\n" +
+	"\x11AddCollectionItem\x12&.api.movie.v1.AddCollectionItemRequest\x1a\x18.api.movie.v1.Collection\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/collections/{id}/items\x12\x84\x01\n" +
+	"\x14RemoveCollectionItem\x12).api.movie.v1.RemoveCollectionItemRequest\x1a\x18.api.movie.v1.Collection\"'\x82\xd3\xe4\x93\x02!*\x1f/collections/{id}/items/{title}\x12\x83\x01\n" +
+	"\x16ReorderCollectionItems\x12+.api.movie.v1.ReorderCollectionItemsRequest\x1a\x18.api.movie.v1.Collection\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/collections/{id}/itemsB\x1cZ\x1aRobin-Camp/api/movie/v1;v1b\x06proto3"
+
+var (
+	file_movie_v1_collection_proto_rawDescOnce sync.Once
+	file_movie_v1_collection_proto_rawDescData []byte
+)
+
+func file_movie_v1_collection_proto_rawDescGZIP() []byte {
+	file_movie_v1_collection_proto_rawDescOnce.Do(func() {
+		file_movie_v1_collection_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_movie_v1_collection_proto_rawDesc), len(file_movie_v1_collection_proto_rawDesc)))
+	})
+	return file_movie_v1_collection_proto_rawDescData
+}
+
+var file_movie_v1_collection_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_movie_v1_collection_proto_goTypes = []any{
+	(*Collection)(nil),                    // 0: api.movie.v1.Collection
+	(*CollectionItem)(nil),                // 1: api.movie.v1.CollectionItem
+	(*CollectionRating)(nil),              // 2: api.movie.v1.CollectionRating
+	(*CreateCollectionRequest)(nil),       // 3: api.movie.v1.CreateCollectionRequest
+	(*GetCollectionRequest)(nil),          // 4: api.movie.v1.GetCollectionRequest
+	(*UpdateCollectionRequest)(nil),       // 5: api.movie.v1.UpdateCollectionRequest
+	(*DeleteCollectionRequest)(nil),       // 6: api.movie.v1.DeleteCollectionRequest
+	(*DeleteCollectionReply)(nil),         // 7: api.movie.v1.DeleteCollectionReply
+	(*ListCollectionsRequest)(nil),        // 8: api.movie.v1.ListCollectionsRequest
+	(*ListCollectionsReply)(nil),          // 9: api.movie.v1.ListCollectionsReply
+	(*AddCollectionItemRequest)(nil),      // 10: api.movie.v1.AddCollectionItemRequest
+	(*RemoveCollectionItemRequest)(nil),   // 11: api.movie.v1.RemoveCollectionItemRequest
+	(*ReorderCollectionItemsRequest)(nil), // 12: api.movie.v1.ReorderCollectionItemsRequest
+	(*timestamppb.Timestamp)(nil),         // 13: google.protobuf.Timestamp
+	(*MovieItem)(nil),                     // 14: api.movie.v1.MovieItem
+}
+var file_movie_v1_collection_proto_depIdxs = []int32{
+	1,  // 0: api.movie.v1.Collection.items:type_name -> api.movie.v1.CollectionItem
+	2,  // 1: api.movie.v1.Collection.rating:type_name -> api.movie.v1.CollectionRating
+	13, // 2: api.movie.v1.Collection.created_at:type_name -> google.protobuf.Timestamp
+	13, // 3: api.movie.v1.Collection.updated_at:type_name -> google.protobuf.Timestamp
+	14, // 4: api.movie.v1.CollectionItem.movie:type_name -> api.movie.v1.MovieItem
+	13, // 5: api.movie.v1.CollectionItem.added_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: api.movie.v1.ListCollectionsReply.items:type_name -> api.movie.v1.Collection
+	3,  // 7: api.movie.v1.CollectionService.CreateCollection:input_type -> api.movie.v1.CreateCollectionRequest
+	4,  // 8: api.movie.v1.CollectionService.GetCollection:input_type -> api.movie.v1.GetCollectionRequest
+	5,  // 9: api.movie.v1.CollectionService.UpdateCollection:input_type -> api.movie.v1.UpdateCollectionRequest
+	6,  // 10: api.movie.v1.CollectionService.DeleteCollection:input_type -> api.movie.v1.DeleteCollectionRequest
+	8,  // 11: api.movie.v1.CollectionService.ListCollections:input_type -> api.movie.v1.ListCollectionsRequest
+	10, // 12: api.movie.v1.CollectionService.AddCollectionItem:input_type -> api.movie.v1.AddCollectionItemRequest
+	11, // 13: api.movie.v1.CollectionService.RemoveCollectionItem:input_type -> api.movie.v1.RemoveCollectionItemRequest
+	12, // 14: api.movie.v1.CollectionService.ReorderCollectionItems:input_type -> api.movie.v1.ReorderCollectionItemsRequest
+	0,  // 15: api.movie.v1.CollectionService.CreateCollection:output_type -> api.movie.v1.Collection
+	0,  // 16: api.movie.v1.CollectionService.GetCollection:output_type -> api.movie.v1.Collection
+	0,  // 17: api.movie.v1.CollectionService.UpdateCollection:output_type -> api.movie.v1.Collection
+	7,  // 18: api.movie.v1.CollectionService.DeleteCollection:output_type -> api.movie.v1.DeleteCollectionReply
+	9,  // 19: api.movie.v1.CollectionService.ListCollections:output_type -> api.movie.v1.ListCollectionsReply
+	0,  // 20: api.movie.v1.CollectionService.AddCollectionItem:output_type -> api.movie.v1.Collection
+	0,  // 21: api.movie.v1.CollectionService.RemoveCollectionItem:output_type -> api.movie.v1.Collection
+	0,  // 22: api.movie.v1.CollectionService.ReorderCollectionItems:output_type -> api.movie.v1.Collection
+	15, // [15:23] is the sub-list for method output_type
+	7,  // [7:15] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_movie_v1_collection_proto_init() }
+func file_movie_v1_collection_proto_init() {
+	if File_movie_v1_collection_proto != nil {
+		return
+	}
+	file_movie_v1_movie_proto_init()
+	file_movie_v1_collection_proto_msgTypes[0].OneofWrappers = []any{}
+	file_movie_v1_collection_proto_msgTypes[3].OneofWrappers = []any{}
+	file_movie_v1_collection_proto_msgTypes[5].OneofWrappers = []any{}
+	file_movie_v1_collection_proto_msgTypes[8].OneofWrappers = []any{}
+	file_movie_v1_collection_proto_msgTypes[9].OneofWrappers = []any{}
+	file_movie_v1_collection_proto_msgTypes[10].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_movie_v1_collection_proto_rawDesc), len(file_movie_v1_collection_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_movie_v1_collection_proto_goTypes,
+		DependencyIndexes: file_movie_v1_collection_proto_depIdxs,
+		MessageInfos:      file_movie_v1_collection_proto_msgTypes,
+	}.Build()
+	File_movie_v1_collection_proto = out.File
+	file_movie_v1_collection_proto_goTypes = nil
+	file_movie_v1_collection_proto_depIdxs = nil
+}
@@ -0,0 +1,1658 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: movie/v1/collection.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Collection with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Collection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Collection with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CollectionMultiError, or
+// nil if none found.
+func (m *Collection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Collection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for OwnerId
+
+	// no validation rules for Visibility
+
+	// no validation rules for ItemCount
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CollectionValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CollectionValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CollectionValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if m.Rating != nil {
+
+		if all {
+			switch v := interface{}(m.GetRating()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CollectionValidationError{
+						field:  "Rating",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CollectionValidationError{
+						field:  "Rating",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRating()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CollectionValidationError{
+					field:  "Rating",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CollectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectionMultiError is an error wrapping multiple validation errors
+// returned by Collection.ValidateAll() if the designated constraints aren't met.
+type CollectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectionMultiError) AllErrors() []error { return m }
+
+// CollectionValidationError is the validation error returned by
+// Collection.Validate if the designated constraints aren't met.
+type CollectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectionValidationError) ErrorName() string { return "CollectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CollectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectionValidationError{}
+
+// Validate checks the field values on CollectionItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CollectionItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CollectionItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CollectionItemMultiError,
+// or nil if none found.
+func (m *CollectionItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CollectionItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMovie()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionItemValidationError{
+					field:  "Movie",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionItemValidationError{
+					field:  "Movie",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMovie()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionItemValidationError{
+				field:  "Movie",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Position
+
+	if all {
+		switch v := interface{}(m.GetAddedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionItemValidationError{
+					field:  "AddedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionItemValidationError{
+					field:  "AddedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionItemValidationError{
+				field:  "AddedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CollectionItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectionItemMultiError is an error wrapping multiple validation errors
+// returned by CollectionItem.ValidateAll() if the designated constraints
+// aren't met.
+type CollectionItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectionItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectionItemMultiError) AllErrors() []error { return m }
+
+// CollectionItemValidationError is the validation error returned by
+// CollectionItem.Validate if the designated constraints aren't met.
+type CollectionItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectionItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectionItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectionItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectionItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectionItemValidationError) ErrorName() string { return "CollectionItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CollectionItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollectionItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectionItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectionItemValidationError{}
+
+// Validate checks the field values on CollectionRating with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CollectionRating) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CollectionRating with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CollectionRatingMultiError, or nil if none found.
+func (m *CollectionRating) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CollectionRating) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Average
+
+	// no validation rules for Count
+
+	// no validation rules for RatedMovies
+
+	if len(errors) > 0 {
+		return CollectionRatingMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectionRatingMultiError is an error wrapping multiple validation errors
+// returned by CollectionRating.ValidateAll() if the designated constraints
+// aren't met.
+type CollectionRatingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectionRatingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectionRatingMultiError) AllErrors() []error { return m }
+
+// CollectionRatingValidationError is the validation error returned by
+// CollectionRating.Validate if the designated constraints aren't met.
+type CollectionRatingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectionRatingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectionRatingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectionRatingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectionRatingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectionRatingValidationError) ErrorName() string { return "CollectionRatingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CollectionRatingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollectionRating.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectionRatingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectionRatingValidationError{}
+
+// Validate checks the field values on CreateCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCollectionRequestMultiError, or nil if none found.
+func (m *CreateCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if m.Visibility != nil {
+		// no validation rules for Visibility
+	}
+
+	if len(errors) > 0 {
+		return CreateCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCollectionRequestMultiError) AllErrors() []error { return m }
+
+// CreateCollectionRequestValidationError is the validation error returned by
+// CreateCollectionRequest.Validate if the designated constraints aren't met.
+type CreateCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCollectionRequestValidationError) ErrorName() string {
+	return "CreateCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCollectionRequestValidationError{}
+
+// Validate checks the field values on GetCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCollectionRequestMultiError, or nil if none found.
+func (m *GetCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCollectionRequestMultiError) AllErrors() []error { return m }
+
+// GetCollectionRequestValidationError is the validation error returned by
+// GetCollectionRequest.Validate if the designated constraints aren't met.
+type GetCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCollectionRequestValidationError) ErrorName() string {
+	return "GetCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCollectionRequestValidationError{}
+
+// Validate checks the field values on UpdateCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCollectionRequestMultiError, or nil if none found.
+func (m *UpdateCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if m.Visibility != nil {
+		// no validation rules for Visibility
+	}
+
+	if len(errors) > 0 {
+		return UpdateCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCollectionRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCollectionRequestValidationError is the validation error returned by
+// UpdateCollectionRequest.Validate if the designated constraints aren't met.
+type UpdateCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCollectionRequestValidationError) ErrorName() string {
+	return "UpdateCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCollectionRequestValidationError{}
+
+// Validate checks the field values on DeleteCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCollectionRequestMultiError, or nil if none found.
+func (m *DeleteCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCollectionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCollectionRequestValidationError is the validation error returned by
+// DeleteCollectionRequest.Validate if the designated constraints aren't met.
+type DeleteCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCollectionRequestValidationError) ErrorName() string {
+	return "DeleteCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCollectionRequestValidationError{}
+
+// Validate checks the field values on DeleteCollectionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCollectionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCollectionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCollectionReplyMultiError, or nil if none found.
+func (m *DeleteCollectionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCollectionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteCollectionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCollectionReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteCollectionReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCollectionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCollectionReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCollectionReplyMultiError) AllErrors() []error { return m }
+
+// DeleteCollectionReplyValidationError is the validation error returned by
+// DeleteCollectionReply.Validate if the designated constraints aren't met.
+type DeleteCollectionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCollectionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCollectionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCollectionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCollectionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCollectionReplyValidationError) ErrorName() string {
+	return "DeleteCollectionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCollectionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCollectionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCollectionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCollectionReplyValidationError{}
+
+// Validate checks the field values on ListCollectionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCollectionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCollectionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCollectionsRequestMultiError, or nil if none found.
+func (m *ListCollectionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCollectionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Q != nil {
+		// no validation rules for Q
+	}
+
+	if m.OwnerId != nil {
+		// no validation rules for OwnerId
+	}
+
+	if m.Limit != nil {
+		// no validation rules for Limit
+	}
+
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if len(errors) > 0 {
+		return ListCollectionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCollectionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCollectionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCollectionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCollectionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCollectionsRequestMultiError) AllErrors() []error { return m }
+
+// ListCollectionsRequestValidationError is the validation error returned by
+// ListCollectionsRequest.Validate if the designated constraints aren't met.
+type ListCollectionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCollectionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCollectionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCollectionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCollectionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCollectionsRequestValidationError) ErrorName() string {
+	return "ListCollectionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCollectionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCollectionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCollectionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCollectionsRequestValidationError{}
+
+// Validate checks the field values on ListCollectionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCollectionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCollectionsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCollectionsReplyMultiError, or nil if none found.
+func (m *ListCollectionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCollectionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCollectionsReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCollectionsReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCollectionsReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.NextCursor != nil {
+		// no validation rules for NextCursor
+	}
+
+	if len(errors) > 0 {
+		return ListCollectionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCollectionsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListCollectionsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListCollectionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCollectionsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCollectionsReplyMultiError) AllErrors() []error { return m }
+
+// ListCollectionsReplyValidationError is the validation error returned by
+// ListCollectionsReply.Validate if the designated constraints aren't met.
+type ListCollectionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCollectionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCollectionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCollectionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCollectionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCollectionsReplyValidationError) ErrorName() string {
+	return "ListCollectionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCollectionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCollectionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCollectionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCollectionsReplyValidationError{}
+
+// Validate checks the field values on AddCollectionItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddCollectionItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddCollectionItemRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddCollectionItemRequestMultiError, or nil if none found.
+func (m *AddCollectionItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddCollectionItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	if m.Position != nil {
+		// no validation rules for Position
+	}
+
+	if len(errors) > 0 {
+		return AddCollectionItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddCollectionItemRequestMultiError is an error wrapping multiple validation
+// errors returned by AddCollectionItemRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddCollectionItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddCollectionItemRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddCollectionItemRequestMultiError) AllErrors() []error { return m }
+
+// AddCollectionItemRequestValidationError is the validation error returned by
+// AddCollectionItemRequest.Validate if the designated constraints aren't met.
+type AddCollectionItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddCollectionItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddCollectionItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddCollectionItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddCollectionItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddCollectionItemRequestValidationError) ErrorName() string {
+	return "AddCollectionItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddCollectionItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddCollectionItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddCollectionItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddCollectionItemRequestValidationError{}
+
+// Validate checks the field values on RemoveCollectionItemRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveCollectionItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveCollectionItemRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveCollectionItemRequestMultiError, or nil if none found.
+func (m *RemoveCollectionItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveCollectionItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	if len(errors) > 0 {
+		return RemoveCollectionItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveCollectionItemRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveCollectionItemRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveCollectionItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveCollectionItemRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveCollectionItemRequestMultiError) AllErrors() []error { return m }
+
+// RemoveCollectionItemRequestValidationError is the validation error returned
+// by RemoveCollectionItemRequest.Validate if the designated constraints
+// aren't met.
+type RemoveCollectionItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveCollectionItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveCollectionItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveCollectionItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveCollectionItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveCollectionItemRequestValidationError) ErrorName() string {
+	return "RemoveCollectionItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveCollectionItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveCollectionItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveCollectionItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveCollectionItemRequestValidationError{}
+
+// Validate checks the field values on ReorderCollectionItemsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReorderCollectionItemsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReorderCollectionItemsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReorderCollectionItemsRequestMultiError, or nil if none found.
+func (m *ReorderCollectionItemsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReorderCollectionItemsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ReorderCollectionItemsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReorderCollectionItemsRequestMultiError is an error wrapping multiple
+// validation errors returned by ReorderCollectionItemsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ReorderCollectionItemsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReorderCollectionItemsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReorderCollectionItemsRequestMultiError) AllErrors() []error { return m }
+
+// ReorderCollectionItemsRequestValidationError is the validation error
+// returned by ReorderCollectionItemsRequest.Validate if the designated
+// constraints aren't met.
+type ReorderCollectionItemsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderCollectionItemsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderCollectionItemsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderCollectionItemsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorderCollectionItemsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorderCollectionItemsRequestValidationError) ErrorName() string {
+	return "ReorderCollectionItemsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReorderCollectionItemsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorderCollectionItemsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorderCollectionItemsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorderCollectionItemsRequestValidationError{}
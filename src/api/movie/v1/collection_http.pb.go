@@ -0,0 +1,381 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v6.32.1
+// source: movie/v1/collection.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationCollectionServiceAddCollectionItem = "/api.movie.v1.CollectionService/AddCollectionItem"
+const OperationCollectionServiceCreateCollection = "/api.movie.v1.CollectionService/CreateCollection"
+const OperationCollectionServiceDeleteCollection = "/api.movie.v1.CollectionService/DeleteCollection"
+const OperationCollectionServiceGetCollection = "/api.movie.v1.CollectionService/GetCollection"
+const OperationCollectionServiceListCollections = "/api.movie.v1.CollectionService/ListCollections"
+const OperationCollectionServiceRemoveCollectionItem = "/api.movie.v1.CollectionService/RemoveCollectionItem"
+const OperationCollectionServiceReorderCollectionItems = "/api.movie.v1.CollectionService/ReorderCollectionItems"
+const OperationCollectionServiceUpdateCollection = "/api.movie.v1.CollectionService/UpdateCollection"
+
+type CollectionServiceHTTPServer interface {
+	// AddCollectionItem Add a movie to a collection
+	AddCollectionItem(context.Context, *AddCollectionItemRequest) (*Collection, error)
+	// CreateCollection Create a new collection owned by the calling rater
+	CreateCollection(context.Context, *CreateCollectionRequest) (*Collection, error)
+	// DeleteCollection Delete a collection; its movies are kept
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionReply, error)
+	// GetCollection Get a collection with its movies in order and its aggregate rating
+	GetCollection(context.Context, *GetCollectionRequest) (*Collection, error)
+	// ListCollections List public collections and the caller's own, with name search and pagination
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsReply, error)
+	// RemoveCollectionItem Remove a movie from a collection
+	RemoveCollectionItem(context.Context, *RemoveCollectionItemRequest) (*Collection, error)
+	// ReorderCollectionItems Put the movies of a collection in a new order
+	ReorderCollectionItems(context.Context, *ReorderCollectionItemsRequest) (*Collection, error)
+	// UpdateCollection Update the given fields of a collection
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*Collection, error)
+}
+
+func RegisterCollectionServiceHTTPServer(s *http.Server, srv CollectionServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/collections", _CollectionService_CreateCollection0_HTTP_Handler(srv))
+	r.GET("/collections/{id}", _CollectionService_GetCollection0_HTTP_Handler(srv))
+	r.PATCH("/collections/{id}", _CollectionService_UpdateCollection0_HTTP_Handler(srv))
+	r.DELETE("/collections/{id}", _CollectionService_DeleteCollection0_HTTP_Handler(srv))
+	r.GET("/collections", _CollectionService_ListCollections0_HTTP_Handler(srv))
+	r.POST("/collections/{id}/items", _CollectionService_AddCollectionItem0_HTTP_Handler(srv))
+	r.DELETE("/collections/{id}/items/{title}", _CollectionService_RemoveCollectionItem0_HTTP_Handler(srv))
+	r.PUT("/collections/{id}/items", _CollectionService_ReorderCollectionItems0_HTTP_Handler(srv))
+}
+
+func _CollectionService_CreateCollection0_HTTP_Handler(srv CollectionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateCollectionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCollectionServiceCreateCollection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateCollection(ctx, req.(*CreateCollectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Collection)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CollectionService_GetCollection0_HTTP_Handler(srv CollectionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCollectionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCollectionServiceGetCollection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCollection(ctx, req.(*GetCollectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Collection)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CollectionService_UpdateCollection0_HTTP_Handler(srv CollectionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateCollectionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCollectionServiceUpdateCollection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Collection)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CollectionService_DeleteCollection0_HTTP_Handler(srv CollectionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCollectionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCollectionServiceDeleteCollection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteCollectionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CollectionService_ListCollections0_HTTP_Handler(srv CollectionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCollectionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCollectionServiceListCollections)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCollections(ctx, req.(*ListCollectionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCollectionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CollectionService_AddCollectionItem0_HTTP_Handler(srv CollectionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddCollectionItemRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCollectionServiceAddCollectionItem)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddCollectionItem(ctx, req.(*AddCollectionItemRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Collection)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CollectionService_RemoveCollectionItem0_HTTP_Handler(srv CollectionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveCollectionItemRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCollectionServiceRemoveCollectionItem)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveCollectionItem(ctx, req.(*RemoveCollectionItemRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Collection)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CollectionService_ReorderCollectionItems0_HTTP_Handler(srv CollectionServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReorderCollectionItemsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCollectionServiceReorderCollectionItems)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReorderCollectionItems(ctx, req.(*ReorderCollectionItemsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Collection)
+		return ctx.Result(200, reply)
+	}
+}
+
+type CollectionServiceHTTPClient interface {
+	// AddCollectionItem Add a movie to a collection
+	AddCollectionItem(ctx context.Context, req *AddCollectionItemRequest, opts ...http.CallOption) (rsp *Collection, err error)
+	// CreateCollection Create a new collection owned by the calling rater
+	CreateCollection(ctx context.Context, req *CreateCollectionRequest, opts ...http.CallOption) (rsp *Collection, err error)
+	// DeleteCollection Delete a collection; its movies are kept
+	DeleteCollection(ctx context.Context, req *DeleteCollectionRequest, opts ...http.CallOption) (rsp *DeleteCollectionReply, err error)
+	// GetCollection Get a collection with its movies in order and its aggregate rating
+	GetCollection(ctx context.Context, req *GetCollectionRequest, opts ...http.CallOption) (rsp *Collection, err error)
+	// ListCollections List public collections and the caller's own, with name search and pagination
+	ListCollections(ctx context.Context, req *ListCollectionsRequest, opts ...http.CallOption) (rsp *ListCollectionsReply, err error)
+	// RemoveCollectionItem Remove a movie from a collection
+	RemoveCollectionItem(ctx context.Context, req *RemoveCollectionItemRequest, opts ...http.CallOption) (rsp *Collection, err error)
+	// ReorderCollectionItems Put the movies of a collection in a new order
+	ReorderCollectionItems(ctx context.Context, req *ReorderCollectionItemsRequest, opts ...http.CallOption) (rsp *Collection, err error)
+	// UpdateCollection Update the given fields of a collection
+	UpdateCollection(ctx context.Context, req *UpdateCollectionRequest, opts ...http.CallOption) (rsp *Collection, err error)
+}
+
+type CollectionServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewCollectionServiceHTTPClient(client *http.Client) CollectionServiceHTTPClient {
+	return &CollectionServiceHTTPClientImpl{client}
+}
+
+// AddCollectionItem Add a movie to a collection
+func (c *CollectionServiceHTTPClientImpl) AddCollectionItem(ctx context.Context, in *AddCollectionItemRequest, opts ...http.CallOption) (*Collection, error) {
+	var out Collection
+	pattern := "/collections/{id}/items"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCollectionServiceAddCollectionItem))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateCollection Create a new collection owned by the calling rater
+func (c *CollectionServiceHTTPClientImpl) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...http.CallOption) (*Collection, error) {
+	var out Collection
+	pattern := "/collections"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCollectionServiceCreateCollection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteCollection Delete a collection; its movies are kept
+func (c *CollectionServiceHTTPClientImpl) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...http.CallOption) (*DeleteCollectionReply, error) {
+	var out DeleteCollectionReply
+	pattern := "/collections/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCollectionServiceDeleteCollection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCollection Get a collection with its movies in order and its aggregate rating
+func (c *CollectionServiceHTTPClientImpl) GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...http.CallOption) (*Collection, error) {
+	var out Collection
+	pattern := "/collections/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCollectionServiceGetCollection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListCollections List public collections and the caller's own, with name search and pagination
+func (c *CollectionServiceHTTPClientImpl) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...http.CallOption) (*ListCollectionsReply, error) {
+	var out ListCollectionsReply
+	pattern := "/collections"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCollectionServiceListCollections))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveCollectionItem Remove a movie from a collection
+func (c *CollectionServiceHTTPClientImpl) RemoveCollectionItem(ctx context.Context, in *RemoveCollectionItemRequest, opts ...http.CallOption) (*Collection, error) {
+	var out Collection
+	pattern := "/collections/{id}/items/{title}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCollectionServiceRemoveCollectionItem))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReorderCollectionItems Put the movies of a collection in a new order
+func (c *CollectionServiceHTTPClientImpl) ReorderCollectionItems(ctx context.Context, in *ReorderCollectionItemsRequest, opts ...http.CallOption) (*Collection, error) {
+	var out Collection
+	pattern := "/collections/{id}/items"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCollectionServiceReorderCollectionItems))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateCollection Update the given fields of a collection
+func (c *CollectionServiceHTTPClientImpl) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...http.CallOption) (*Collection, error) {
+	var out Collection
+	pattern := "/collections/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCollectionServiceUpdateCollection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PATCH", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
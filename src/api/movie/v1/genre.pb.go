@@ -0,0 +1,496 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
+// source: movie/v1/genre.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Genre struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // slug, e.g. science-fiction
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Aliases       []string               `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`                                                                                     // normalized keys, e.g. "sci fi"
+	Translations  map[string]string      `protobuf:"bytes,4,rep,name=translations,proto3" json:"translations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // localized names by locale
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Genre) Reset() {
+	*x = Genre{}
+	mi := &file_movie_v1_genre_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Genre) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Genre) ProtoMessage() {}
+
+func (x *Genre) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_genre_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Genre.ProtoReflect.Descriptor instead.
+func (*Genre) Descriptor() ([]byte, []int) {
+	return file_movie_v1_genre_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Genre) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Genre) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Genre) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Genre) GetTranslations() map[string]string {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+// Messages for ListGenres
+type ListGenresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGenresRequest) Reset() {
+	*x = ListGenresRequest{}
+	mi := &file_movie_v1_genre_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGenresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGenresRequest) ProtoMessage() {}
+
+func (x *ListGenresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_genre_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGenresRequest.ProtoReflect.Descriptor instead.
+func (*ListGenresRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_genre_proto_rawDescGZIP(), []int{1}
+}
+
+type ListGenresReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Genre               `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGenresReply) Reset() {
+	*x = ListGenresReply{}
+	mi := &file_movie_v1_genre_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGenresReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGenresReply) ProtoMessage() {}
+
+func (x *ListGenresReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_genre_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGenresReply.ProtoReflect.Descriptor instead.
+func (*ListGenresReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_genre_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListGenresReply) GetItems() []*Genre {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Messages for CreateGenre
+type CreateGenreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Aliases       []string               `protobuf:"bytes,2,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGenreRequest) Reset() {
+	*x = CreateGenreRequest{}
+	mi := &file_movie_v1_genre_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGenreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGenreRequest) ProtoMessage() {}
+
+func (x *CreateGenreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_genre_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGenreRequest.ProtoReflect.Descriptor instead.
+func (*CreateGenreRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_genre_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateGenreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGenreRequest) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+// Messages for AddGenreAlias
+type AddGenreAliasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // from path
+	Alias         string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGenreAliasRequest) Reset() {
+	*x = AddGenreAliasRequest{}
+	mi := &file_movie_v1_genre_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGenreAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGenreAliasRequest) ProtoMessage() {}
+
+func (x *AddGenreAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_genre_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGenreAliasRequest.ProtoReflect.Descriptor instead.
+func (*AddGenreAliasRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_genre_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddGenreAliasRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddGenreAliasRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+// Messages for SetGenreTranslation
+type SetGenreTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`         // from path
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"` // from path, BCP 47 tag, e.g. pt-BR
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGenreTranslationRequest) Reset() {
+	*x = SetGenreTranslationRequest{}
+	mi := &file_movie_v1_genre_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGenreTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGenreTranslationRequest) ProtoMessage() {}
+
+func (x *SetGenreTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_genre_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGenreTranslationRequest.ProtoReflect.Descriptor instead.
+func (*SetGenreTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_genre_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetGenreTranslationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetGenreTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SetGenreTranslationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Messages for DeleteGenreTranslation
+type DeleteGenreTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`         // from path
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"` // from path
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGenreTranslationRequest) Reset() {
+	*x = DeleteGenreTranslationRequest{}
+	mi := &file_movie_v1_genre_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGenreTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGenreTranslationRequest) ProtoMessage() {}
+
+func (x *DeleteGenreTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_genre_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGenreTranslationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGenreTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_genre_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteGenreTranslationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteGenreTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+var File_movie_v1_genre_proto protoreflect.FileDescriptor
+
+const file_movie_v1_genre_proto_rawDesc = "" +
+	"\n" +
+	"\x14movie/v1/genre.proto\x12\fapi.movie.v1\x1a\x1cgoogle/api/annotations.proto\"\xd1\x01\n" +
+	"\x05Genre\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaliases\x18\x03 \x03(\tR\aaliases\x12I\n" +
+	"\ftranslations\x18\x04 \x03(\v2%.api.movie.v1.Genre.TranslationsEntryR\ftranslations\x1a?\n" +
+	"\x11TranslationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x13\n" +
+	"\x11ListGenresRequest\"<\n" +
+	"\x0fListGenresReply\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.api.movie.v1.GenreR\x05items\"B\n" +
+	"\x12CreateGenreRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaliases\x18\x02 \x03(\tR\aaliases\"<\n" +
+	"\x14AddGenreAliasRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05alias\x18\x02 \x01(\tR\x05alias\"X\n" +
+	"\x1aSetGenreTranslationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"G\n" +
+	"\x1dDeleteGenreTranslationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale2\xc1\x04\n" +
+	"\fGenreService\x12]\n" +
+	"\n" +
+	"ListGenres\x12\x1f.api.movie.v1.ListGenresRequest\x1a\x1d.api.movie.v1.ListGenresReply\"\x0f\x82\xd3\xe4\x93\x02\t\x12\a/genres\x12X\n" +
+	"\vCreateGenre\x12 .api.movie.v1.CreateGenreRequest\x1a\x13.api.movie.v1.Genre\"\x12\x82\xd3\xe4\x93\x02\f:\x01*\"\a/genres\x12i\n" +
+	"\rAddGenreAlias\x12\".api.movie.v1.AddGenreAliasRequest\x1a\x13.api.movie.v1.Genre\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/genres/{id}/aliases\x12\x83\x01\n" +
+	"\x13SetGenreTranslation\x12(.api.movie.v1.SetGenreTranslationRequest\x1a\x13.api.movie.v1.Genre\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/genres/{id}/translations/{locale}\x12\x86\x01\n" +
+	"\x16DeleteGenreTranslation\x12+.api.movie.v1.DeleteGenreTranslationRequest\x1a\x13.api.movie.v1.Genre\"*\x82\xd3\xe4\x93\x02$*\"/genres/{id}/translations/{locale}B\x1cZ\x1aRobin-Camp/api/movie/v1;v1b\x06proto3"
+
+var (
+	file_movie_v1_genre_proto_rawDescOnce sync.Once
+	file_movie_v1_genre_proto_rawDescData []byte
+)
+
+func file_movie_v1_genre_proto_rawDescGZIP() []byte {
+	file_movie_v1_genre_proto_rawDescOnce.Do(func() {
+		file_movie_v1_genre_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_movie_v1_genre_proto_rawDesc), len(file_movie_v1_genre_proto_rawDesc)))
+	})
+	return file_movie_v1_genre_proto_rawDescData
+}
+
+var file_movie_v1_genre_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_movie_v1_genre_proto_goTypes = []any{
+	(*Genre)(nil),                         // 0: api.movie.v1.Genre
+	(*ListGenresRequest)(nil),             // 1: api.movie.v1.ListGenresRequest
+	(*ListGenresReply)(nil),               // 2: api.movie.v1.ListGenresReply
+	(*CreateGenreRequest)(nil),            // 3: api.movie.v1.CreateGenreRequest
+	(*AddGenreAliasRequest)(nil),          // 4: api.movie.v1.AddGenreAliasRequest
+	(*SetGenreTranslationRequest)(nil),    // 5: api.movie.v1.SetGenreTranslationRequest
+	(*DeleteGenreTranslationRequest)(nil), // 6: api.movie.v1.DeleteGenreTranslationRequest
+	nil,                                   // 7: api.movie.v1.Genre.TranslationsEntry
+}
+var file_movie_v1_genre_proto_depIdxs = []int32{
+	7, // 0: api.movie.v1.Genre.translations:type_name -> api.movie.v1.Genre.TranslationsEntry
+	0, // 1: api.movie.v1.ListGenresReply.items:type_name -> api.movie.v1.Genre
+	1, // 2: api.movie.v1.GenreService.ListGenres:input_type -> api.movie.v1.ListGenresRequest
+	3, // 3: api.movie.v1.GenreService.CreateGenre:input_type -> api.movie.v1.CreateGenreRequest
+	4, // 4: api.movie.v1.GenreService.AddGenreAlias:input_type -> api.movie.v1.AddGenreAliasRequest
+	5, // 5: api.movie.v1.GenreService.SetGenreTranslation:input_type -> api.movie.v1.SetGenreTranslationRequest
+	6, // 6: api.movie.v1.GenreService.DeleteGenreTranslation:input_type -> api.movie.v1.DeleteGenreTranslationRequest
+	2, // 7: api.movie.v1.GenreService.ListGenres:output_type -> api.movie.v1.ListGenresReply
+	0, // 8: api.movie.v1.GenreService.CreateGenre:output_type -> api.movie.v1.Genre
+	0, // 9: api.movie.v1.GenreService.AddGenreAlias:output_type -> api.movie.v1.Genre
+	0, // 10: api.movie.v1.GenreService.SetGenreTranslation:output_type -> api.movie.v1.Genre
+	0, // 11: api.movie.v1.GenreService.DeleteGenreTranslation:output_type -> api.movie.v1.Genre
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_movie_v1_genre_proto_init() }
+func file_movie_v1_genre_proto_init() {
+	if File_movie_v1_genre_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_movie_v1_genre_proto_rawDesc), len(file_movie_v1_genre_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_movie_v1_genre_proto_goTypes,
+		DependencyIndexes: file_movie_v1_genre_proto_depIdxs,
+		MessageInfos:      file_movie_v1_genre_proto_msgTypes,
+	}.Build()
+	File_movie_v1_genre_proto = out.File
+	file_movie_v1_genre_proto_goTypes = nil
+	file_movie_v1_genre_proto_depIdxs = nil
+}
@@ -0,0 +1,801 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: movie/v1/genre.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Genre with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Genre) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Genre with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GenreMultiError, or nil if none found.
+func (m *Genre) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Genre) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Translations
+
+	if len(errors) > 0 {
+		return GenreMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenreMultiError is an error wrapping multiple validation errors returned by
+// Genre.ValidateAll() if the designated constraints aren't met.
+type GenreMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenreMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenreMultiError) AllErrors() []error { return m }
+
+// GenreValidationError is the validation error returned by Genre.Validate if
+// the designated constraints aren't met.
+type GenreValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenreValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenreValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenreValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenreValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenreValidationError) ErrorName() string { return "GenreValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GenreValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenre.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenreValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenreValidationError{}
+
+// Validate checks the field values on ListGenresRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListGenresRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListGenresRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListGenresRequestMultiError, or nil if none found.
+func (m *ListGenresRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListGenresRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListGenresRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListGenresRequestMultiError is an error wrapping multiple validation errors
+// returned by ListGenresRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListGenresRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListGenresRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListGenresRequestMultiError) AllErrors() []error { return m }
+
+// ListGenresRequestValidationError is the validation error returned by
+// ListGenresRequest.Validate if the designated constraints aren't met.
+type ListGenresRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListGenresRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListGenresRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListGenresRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListGenresRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListGenresRequestValidationError) ErrorName() string {
+	return "ListGenresRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGenresRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGenresRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListGenresRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListGenresRequestValidationError{}
+
+// Validate checks the field values on ListGenresReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListGenresReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListGenresReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListGenresReplyMultiError, or nil if none found.
+func (m *ListGenresReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListGenresReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListGenresReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListGenresReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListGenresReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListGenresReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListGenresReplyMultiError is an error wrapping multiple validation errors
+// returned by ListGenresReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListGenresReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListGenresReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListGenresReplyMultiError) AllErrors() []error { return m }
+
+// ListGenresReplyValidationError is the validation error returned by
+// ListGenresReply.Validate if the designated constraints aren't met.
+type ListGenresReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListGenresReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListGenresReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListGenresReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListGenresReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListGenresReplyValidationError) ErrorName() string { return "ListGenresReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListGenresReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGenresReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListGenresReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListGenresReplyValidationError{}
+
+// Validate checks the field values on CreateGenreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateGenreRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGenreRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGenreRequestMultiError, or nil if none found.
+func (m *CreateGenreRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGenreRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return CreateGenreRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGenreRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateGenreRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateGenreRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGenreRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGenreRequestMultiError) AllErrors() []error { return m }
+
+// CreateGenreRequestValidationError is the validation error returned by
+// CreateGenreRequest.Validate if the designated constraints aren't met.
+type CreateGenreRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGenreRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGenreRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGenreRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGenreRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGenreRequestValidationError) ErrorName() string {
+	return "CreateGenreRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGenreRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGenreRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGenreRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGenreRequestValidationError{}
+
+// Validate checks the field values on AddGenreAliasRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddGenreAliasRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddGenreAliasRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddGenreAliasRequestMultiError, or nil if none found.
+func (m *AddGenreAliasRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddGenreAliasRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Alias
+
+	if len(errors) > 0 {
+		return AddGenreAliasRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddGenreAliasRequestMultiError is an error wrapping multiple validation
+// errors returned by AddGenreAliasRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddGenreAliasRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddGenreAliasRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddGenreAliasRequestMultiError) AllErrors() []error { return m }
+
+// AddGenreAliasRequestValidationError is the validation error returned by
+// AddGenreAliasRequest.Validate if the designated constraints aren't met.
+type AddGenreAliasRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddGenreAliasRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddGenreAliasRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddGenreAliasRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddGenreAliasRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddGenreAliasRequestValidationError) ErrorName() string {
+	return "AddGenreAliasRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddGenreAliasRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddGenreAliasRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddGenreAliasRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddGenreAliasRequestValidationError{}
+
+// Validate checks the field values on SetGenreTranslationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetGenreTranslationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetGenreTranslationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetGenreTranslationRequestMultiError, or nil if none found.
+func (m *SetGenreTranslationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetGenreTranslationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Locale
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return SetGenreTranslationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetGenreTranslationRequestMultiError is an error wrapping multiple
+// validation errors returned by SetGenreTranslationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetGenreTranslationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetGenreTranslationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetGenreTranslationRequestMultiError) AllErrors() []error { return m }
+
+// SetGenreTranslationRequestValidationError is the validation error returned
+// by SetGenreTranslationRequest.Validate if the designated constraints aren't met.
+type SetGenreTranslationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetGenreTranslationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetGenreTranslationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetGenreTranslationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetGenreTranslationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetGenreTranslationRequestValidationError) ErrorName() string {
+	return "SetGenreTranslationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetGenreTranslationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetGenreTranslationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetGenreTranslationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetGenreTranslationRequestValidationError{}
+
+// Validate checks the field values on DeleteGenreTranslationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteGenreTranslationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteGenreTranslationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteGenreTranslationRequestMultiError, or nil if none found.
+func (m *DeleteGenreTranslationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteGenreTranslationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Locale
+
+	if len(errors) > 0 {
+		return DeleteGenreTranslationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteGenreTranslationRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteGenreTranslationRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteGenreTranslationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteGenreTranslationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteGenreTranslationRequestMultiError) AllErrors() []error { return m }
+
+// DeleteGenreTranslationRequestValidationError is the validation error
+// returned by DeleteGenreTranslationRequest.Validate if the designated
+// constraints aren't met.
+type DeleteGenreTranslationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteGenreTranslationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteGenreTranslationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteGenreTranslationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteGenreTranslationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteGenreTranslationRequestValidationError) ErrorName() string {
+	return "DeleteGenreTranslationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteGenreTranslationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteGenreTranslationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteGenreTranslationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteGenreTranslationRequestValidationError{}
@@ -0,0 +1,252 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v6.32.1
+// source: movie/v1/genre.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationGenreServiceAddGenreAlias = "/api.movie.v1.GenreService/AddGenreAlias"
+const OperationGenreServiceCreateGenre = "/api.movie.v1.GenreService/CreateGenre"
+const OperationGenreServiceDeleteGenreTranslation = "/api.movie.v1.GenreService/DeleteGenreTranslation"
+const OperationGenreServiceListGenres = "/api.movie.v1.GenreService/ListGenres"
+const OperationGenreServiceSetGenreTranslation = "/api.movie.v1.GenreService/SetGenreTranslation"
+
+type GenreServiceHTTPServer interface {
+	// AddGenreAlias Make another spelling resolve to a genre
+	AddGenreAlias(context.Context, *AddGenreAliasRequest) (*Genre, error)
+	// CreateGenre Add a genre to the taxonomy
+	CreateGenre(context.Context, *CreateGenreRequest) (*Genre, error)
+	// DeleteGenreTranslation Remove the name of a genre in a locale
+	DeleteGenreTranslation(context.Context, *DeleteGenreTranslationRequest) (*Genre, error)
+	// ListGenres List every genre with its aliases
+	ListGenres(context.Context, *ListGenresRequest) (*ListGenresReply, error)
+	// SetGenreTranslation Create or replace the name of a genre in a locale
+	SetGenreTranslation(context.Context, *SetGenreTranslationRequest) (*Genre, error)
+}
+
+func RegisterGenreServiceHTTPServer(s *http.Server, srv GenreServiceHTTPServer) {
+	r := s.Route("/")
+	r.GET("/genres", _GenreService_ListGenres0_HTTP_Handler(srv))
+	r.POST("/genres", _GenreService_CreateGenre0_HTTP_Handler(srv))
+	r.POST("/genres/{id}/aliases", _GenreService_AddGenreAlias0_HTTP_Handler(srv))
+	r.PUT("/genres/{id}/translations/{locale}", _GenreService_SetGenreTranslation0_HTTP_Handler(srv))
+	r.DELETE("/genres/{id}/translations/{locale}", _GenreService_DeleteGenreTranslation0_HTTP_Handler(srv))
+}
+
+func _GenreService_ListGenres0_HTTP_Handler(srv GenreServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListGenresRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGenreServiceListGenres)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListGenres(ctx, req.(*ListGenresRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListGenresReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _GenreService_CreateGenre0_HTTP_Handler(srv GenreServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateGenreRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGenreServiceCreateGenre)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateGenre(ctx, req.(*CreateGenreRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Genre)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _GenreService_AddGenreAlias0_HTTP_Handler(srv GenreServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddGenreAliasRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGenreServiceAddGenreAlias)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddGenreAlias(ctx, req.(*AddGenreAliasRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Genre)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _GenreService_SetGenreTranslation0_HTTP_Handler(srv GenreServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetGenreTranslationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGenreServiceSetGenreTranslation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetGenreTranslation(ctx, req.(*SetGenreTranslationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Genre)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _GenreService_DeleteGenreTranslation0_HTTP_Handler(srv GenreServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteGenreTranslationRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGenreServiceDeleteGenreTranslation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteGenreTranslation(ctx, req.(*DeleteGenreTranslationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*Genre)
+		return ctx.Result(200, reply)
+	}
+}
+
+type GenreServiceHTTPClient interface {
+	// AddGenreAlias Make another spelling resolve to a genre
+	AddGenreAlias(ctx context.Context, req *AddGenreAliasRequest, opts ...http.CallOption) (rsp *Genre, err error)
+	// CreateGenre Add a genre to the taxonomy
+	CreateGenre(ctx context.Context, req *CreateGenreRequest, opts ...http.CallOption) (rsp *Genre, err error)
+	// DeleteGenreTranslation Remove the name of a genre in a locale
+	DeleteGenreTranslation(ctx context.Context, req *DeleteGenreTranslationRequest, opts ...http.CallOption) (rsp *Genre, err error)
+	// ListGenres List every genre with its aliases
+	ListGenres(ctx context.Context, req *ListGenresRequest, opts ...http.CallOption) (rsp *ListGenresReply, err error)
+	// SetGenreTranslation Create or replace the name of a genre in a locale
+	SetGenreTranslation(ctx context.Context, req *SetGenreTranslationRequest, opts ...http.CallOption) (rsp *Genre, err error)
+}
+
+type GenreServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewGenreServiceHTTPClient(client *http.Client) GenreServiceHTTPClient {
+	return &GenreServiceHTTPClientImpl{client}
+}
+
+// AddGenreAlias Make another spelling resolve to a genre
+func (c *GenreServiceHTTPClientImpl) AddGenreAlias(ctx context.Context, in *AddGenreAliasRequest, opts ...http.CallOption) (*Genre, error) {
+	var out Genre
+	pattern := "/genres/{id}/aliases"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGenreServiceAddGenreAlias))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGenre Add a genre to the taxonomy
+func (c *GenreServiceHTTPClientImpl) CreateGenre(ctx context.Context, in *CreateGenreRequest, opts ...http.CallOption) (*Genre, error) {
+	var out Genre
+	pattern := "/genres"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGenreServiceCreateGenre))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGenreTranslation Remove the name of a genre in a locale
+func (c *GenreServiceHTTPClientImpl) DeleteGenreTranslation(ctx context.Context, in *DeleteGenreTranslationRequest, opts ...http.CallOption) (*Genre, error) {
+	var out Genre
+	pattern := "/genres/{id}/translations/{locale}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGenreServiceDeleteGenreTranslation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGenres List every genre with its aliases
+func (c *GenreServiceHTTPClientImpl) ListGenres(ctx context.Context, in *ListGenresRequest, opts ...http.CallOption) (*ListGenresReply, error) {
+	var out ListGenresReply
+	pattern := "/genres"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGenreServiceListGenres))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetGenreTranslation Create or replace the name of a genre in a locale
+func (c *GenreServiceHTTPClientImpl) SetGenreTranslation(ctx context.Context, in *SetGenreTranslationRequest, opts ...http.CallOption) (*Genre, error) {
+	var out Genre
+	pattern := "/genres/{id}/translations/{locale}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGenreServiceSetGenreTranslation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
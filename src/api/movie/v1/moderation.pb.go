@@ -0,0 +1,606 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
+// source: movie/v1/moderation.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReviewItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MovieTitle       string                 `protobuf:"bytes,2,opt,name=movie_title,json=movieTitle,proto3" json:"movie_title,omitempty"`
+	RaterId          string                 `protobuf:"bytes,3,opt,name=rater_id,json=raterId,proto3" json:"rater_id,omitempty"`
+	Rating           float64                `protobuf:"fixed64,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	Review           *string                `protobuf:"bytes,5,opt,name=review,proto3,oneof" json:"review,omitempty"`
+	ModerationStatus string                 `protobuf:"bytes,6,opt,name=moderation_status,json=moderationStatus,proto3" json:"moderation_status,omitempty"` // none, pending, approved or hidden
+	ModerationReason *string                `protobuf:"bytes,7,opt,name=moderation_reason,json=moderationReason,proto3,oneof" json:"moderation_reason,omitempty"`
+	ReportCount      int32                  `protobuf:"varint,8,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version          int64                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"` // counts the writes to the rating
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReviewItem) Reset() {
+	*x = ReviewItem{}
+	mi := &file_movie_v1_moderation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewItem) ProtoMessage() {}
+
+func (x *ReviewItem) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_moderation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewItem.ProtoReflect.Descriptor instead.
+func (*ReviewItem) Descriptor() ([]byte, []int) {
+	return file_movie_v1_moderation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReviewItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewItem) GetMovieTitle() string {
+	if x != nil {
+		return x.MovieTitle
+	}
+	return ""
+}
+
+func (x *ReviewItem) GetRaterId() string {
+	if x != nil {
+		return x.RaterId
+	}
+	return ""
+}
+
+func (x *ReviewItem) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ReviewItem) GetReview() string {
+	if x != nil && x.Review != nil {
+		return *x.Review
+	}
+	return ""
+}
+
+func (x *ReviewItem) GetModerationStatus() string {
+	if x != nil {
+		return x.ModerationStatus
+	}
+	return ""
+}
+
+func (x *ReviewItem) GetModerationReason() string {
+	if x != nil && x.ModerationReason != nil {
+		return *x.ModerationReason
+	}
+	return ""
+}
+
+func (x *ReviewItem) GetReportCount() int32 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+func (x *ReviewItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ReviewItem) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Messages for ReportReview
+type ReportReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`        // from path
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // from body
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportReviewRequest) Reset() {
+	*x = ReportReviewRequest{}
+	mi := &file_movie_v1_moderation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportReviewRequest) ProtoMessage() {}
+
+func (x *ReportReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_moderation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReportReviewRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_moderation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReportReviewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReportReviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReportReviewReply struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ModerationStatus string                 `protobuf:"bytes,2,opt,name=moderation_status,json=moderationStatus,proto3" json:"moderation_status,omitempty"`
+	ReportCount      int32                  `protobuf:"varint,3,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReportReviewReply) Reset() {
+	*x = ReportReviewReply{}
+	mi := &file_movie_v1_moderation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportReviewReply) ProtoMessage() {}
+
+func (x *ReportReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_moderation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportReviewReply.ProtoReflect.Descriptor instead.
+func (*ReportReviewReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_moderation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReportReviewReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReportReviewReply) GetModerationStatus() string {
+	if x != nil {
+		return x.ModerationStatus
+	}
+	return ""
+}
+
+func (x *ReportReviewReply) GetReportCount() int32 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+// Messages for ListModerationQueue
+type ListModerationQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *string                `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"` // defaults to pending
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationQueueRequest) Reset() {
+	*x = ListModerationQueueRequest{}
+	mi := &file_movie_v1_moderation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueRequest) ProtoMessage() {}
+
+func (x *ListModerationQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_moderation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_moderation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListModerationQueueRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListModerationQueueRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListModerationQueueRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type ListModerationQueueReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ReviewItem          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationQueueReply) Reset() {
+	*x = ListModerationQueueReply{}
+	mi := &file_movie_v1_moderation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationQueueReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationQueueReply) ProtoMessage() {}
+
+func (x *ListModerationQueueReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_moderation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationQueueReply.ProtoReflect.Descriptor instead.
+func (*ListModerationQueueReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_moderation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListModerationQueueReply) GetItems() []*ReviewItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListModerationQueueReply) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+// Messages for ModerateReview
+type ModerateReviewRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`        // from path
+	Action string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // approve or hide
+	Reason *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// the version of the review as reviewed; the action fails with 409 if it
+	// has changed since
+	Version       *int64 `protobuf:"varint,4,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateReviewRequest) Reset() {
+	*x = ModerateReviewRequest{}
+	mi := &file_movie_v1_moderation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateReviewRequest) ProtoMessage() {}
+
+func (x *ModerateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_moderation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateReviewRequest.ProtoReflect.Descriptor instead.
+func (*ModerateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_moderation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ModerateReviewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerateReviewRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ModerateReviewRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ModerateReviewRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type ModerateReviewReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *ReviewItem            `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateReviewReply) Reset() {
+	*x = ModerateReviewReply{}
+	mi := &file_movie_v1_moderation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateReviewReply) ProtoMessage() {}
+
+func (x *ModerateReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_moderation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateReviewReply.ProtoReflect.Descriptor instead.
+func (*ModerateReviewReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_moderation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ModerateReviewReply) GetItem() *ReviewItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+var File_movie_v1_moderation_proto protoreflect.FileDescriptor
+
+const file_movie_v1_moderation_proto_rawDesc = "" +
+	"\n" +
+	"\x19movie/v1/moderation.proto\x12\fapi.movie.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\x85\x03\n" +
+	"\n" +
+	"ReviewItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vmovie_title\x18\x02 \x01(\tR\n" +
+	"movieTitle\x12\x19\n" +
+	"\brater_id\x18\x03 \x01(\tR\araterId\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x01R\x06rating\x12\x1b\n" +
+	"\x06review\x18\x05 \x01(\tH\x00R\x06review\x88\x01\x01\x12+\n" +
+	"\x11moderation_status\x18\x06 \x01(\tR\x10moderationStatus\x120\n" +
+	"\x11moderation_reason\x18\a \x01(\tH\x01R\x10moderationReason\x88\x01\x01\x12!\n" +
+	"\freport_count\x18\b \x01(\x05R\vreportCount\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x03R\aversionB\t\n" +
+	"\a_reviewB\x14\n" +
+	"\x12_moderation_reason\"=\n" +
+	"\x13ReportReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"s\n" +
+	"\x11ReportReviewReply\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12+\n" +
+	"\x11moderation_status\x18\x02 \x01(\tR\x10moderationStatus\x12!\n" +
+	"\freport_count\x18\x03 \x01(\x05R\vreportCount\"\x91\x01\n" +
+	"\x1aListModerationQueueRequest\x12\x1b\n" +
+	"\x06status\x18\x01 \x01(\tH\x00R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x05H\x01R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x02R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursor\"\x80\x01\n" +
+	"\x18ListModerationQueueReply\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.api.movie.v1.ReviewItemR\x05items\x12$\n" +
+	"\vnext_cursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\"\x9b\x01\n" +
+	"\x15ModerateReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1b\n" +
+	"\x06reason\x18\x03 \x01(\tH\x00R\x06reason\x88\x01\x01\x12&\n" +
+	"\aversion\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02 \x00H\x01R\aversion\x88\x01\x01B\t\n" +
+	"\a_reasonB\n" +
+	"\n" +
+	"\b_version\"C\n" +
+	"\x13ModerateReviewReply\x12,\n" +
+	"\x04item\x18\x01 \x01(\v2\x18.api.movie.v1.ReviewItemR\x04item2\x99\x03\n" +
+	"\x11ModerationService\x12s\n" +
+	"\fReportReview\x12!.api.movie.v1.ReportReviewRequest\x1a\x1f.api.movie.v1.ReportReviewReply\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/reviews/{id}/report\x12\x88\x01\n" +
+	"\x13ListModerationQueue\x12(.api.movie.v1.ListModerationQueueRequest\x1a&.api.movie.v1.ListModerationQueueReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/admin/moderation/queue\x12\x83\x01\n" +
+	"\x0eModerateReview\x12#.api.movie.v1.ModerateReviewRequest\x1a!.api.movie.v1.ModerateReviewReply\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/admin/moderation/reviews/{id}B\x1cZ\x1aRobin-Camp/api/movie/v1;v1b\x06proto3"
+
+var (
+	file_movie_v1_moderation_proto_rawDescOnce sync.Once
+	file_movie_v1_moderation_proto_rawDescData []byte
+)
+
+func file_movie_v1_moderation_proto_rawDescGZIP() []byte {
+	file_movie_v1_moderation_proto_rawDescOnce.Do(func() {
+		file_movie_v1_moderation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_movie_v1_moderation_proto_rawDesc), len(file_movie_v1_moderation_proto_rawDesc)))
+	})
+	return file_movie_v1_moderation_proto_rawDescData
+}
+
+var file_movie_v1_moderation_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_movie_v1_moderation_proto_goTypes = []any{
+	(*ReviewItem)(nil),                 // 0: api.movie.v1.ReviewItem
+	(*ReportReviewRequest)(nil),        // 1: api.movie.v1.ReportReviewRequest
+	(*ReportReviewReply)(nil),          // 2: api.movie.v1.ReportReviewReply
+	(*ListModerationQueueRequest)(nil), // 3: api.movie.v1.ListModerationQueueRequest
+	(*ListModerationQueueReply)(nil),   // 4: api.movie.v1.ListModerationQueueReply
+	(*ModerateReviewRequest)(nil),      // 5: api.movie.v1.ModerateReviewRequest
+	(*ModerateReviewReply)(nil),        // 6: api.movie.v1.ModerateReviewReply
+	(*timestamppb.Timestamp)(nil),      // 7: google.protobuf.Timestamp
+}
+var file_movie_v1_moderation_proto_depIdxs = []int32{
+	7, // 0: api.movie.v1.ReviewItem.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 1: api.movie.v1.ListModerationQueueReply.items:type_name -> api.movie.v1.ReviewItem
+	0, // 2: api.movie.v1.ModerateReviewReply.item:type_name -> api.movie.v1.ReviewItem
+	1, // 3: api.movie.v1.ModerationService.ReportReview:input_type -> api.movie.v1.ReportReviewRequest
+	3, // 4: api.movie.v1.ModerationService.ListModerationQueue:input_type -> api.movie.v1.ListModerationQueueRequest
+	5, // 5: api.movie.v1.ModerationService.ModerateReview:input_type -> api.movie.v1.ModerateReviewRequest
+	2, // 6: api.movie.v1.ModerationService.ReportReview:output_type -> api.movie.v1.ReportReviewReply
+	4, // 7: api.movie.v1.ModerationService.ListModerationQueue:output_type -> api.movie.v1.ListModerationQueueReply
+	6, // 8: api.movie.v1.ModerationService.ModerateReview:output_type -> api.movie.v1.ModerateReviewReply
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_movie_v1_moderation_proto_init() }
+func file_movie_v1_moderation_proto_init() {
+	if File_movie_v1_moderation_proto != nil {
+		return
+	}
+	file_movie_v1_moderation_proto_msgTypes[0].OneofWrappers = []any{}
+	file_movie_v1_moderation_proto_msgTypes[3].OneofWrappers = []any{}
+	file_movie_v1_moderation_proto_msgTypes[4].OneofWrappers = []any{}
+	file_movie_v1_moderation_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_movie_v1_moderation_proto_rawDesc), len(file_movie_v1_moderation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_movie_v1_moderation_proto_goTypes,
+		DependencyIndexes: file_movie_v1_moderation_proto_depIdxs,
+		MessageInfos:      file_movie_v1_moderation_proto_msgTypes,
+	}.Build()
+	File_movie_v1_moderation_proto = out.File
+	file_movie_v1_moderation_proto_goTypes = nil
+	file_movie_v1_moderation_proto_depIdxs = nil
+}
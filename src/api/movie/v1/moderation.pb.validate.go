@@ -0,0 +1,910 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: movie/v1/moderation.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ReviewItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReviewItemMultiError, or
+// nil if none found.
+func (m *ReviewItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MovieTitle
+
+	// no validation rules for RaterId
+
+	// no validation rules for Rating
+
+	// no validation rules for ModerationStatus
+
+	// no validation rules for ReportCount
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewItemValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewItemValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewItemValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	if m.Review != nil {
+		// no validation rules for Review
+	}
+
+	if m.ModerationReason != nil {
+		// no validation rules for ModerationReason
+	}
+
+	if len(errors) > 0 {
+		return ReviewItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewItemMultiError is an error wrapping multiple validation errors
+// returned by ReviewItem.ValidateAll() if the designated constraints aren't met.
+type ReviewItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewItemMultiError) AllErrors() []error { return m }
+
+// ReviewItemValidationError is the validation error returned by
+// ReviewItem.Validate if the designated constraints aren't met.
+type ReviewItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewItemValidationError) ErrorName() string { return "ReviewItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewItemValidationError{}
+
+// Validate checks the field values on ReportReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportReviewRequestMultiError, or nil if none found.
+func (m *ReportReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return ReportReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReportReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportReviewRequestMultiError) AllErrors() []error { return m }
+
+// ReportReviewRequestValidationError is the validation error returned by
+// ReportReviewRequest.Validate if the designated constraints aren't met.
+type ReportReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportReviewRequestValidationError) ErrorName() string {
+	return "ReportReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportReviewRequestValidationError{}
+
+// Validate checks the field values on ReportReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReportReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportReviewReplyMultiError, or nil if none found.
+func (m *ReportReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ModerationStatus
+
+	// no validation rules for ReportCount
+
+	if len(errors) > 0 {
+		return ReportReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by ReportReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type ReportReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportReviewReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportReviewReplyMultiError) AllErrors() []error { return m }
+
+// ReportReviewReplyValidationError is the validation error returned by
+// ReportReviewReply.Validate if the designated constraints aren't met.
+type ReportReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportReviewReplyValidationError) ErrorName() string {
+	return "ReportReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportReviewReplyValidationError{}
+
+// Validate checks the field values on ListModerationQueueRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListModerationQueueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListModerationQueueRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListModerationQueueRequestMultiError, or nil if none found.
+func (m *ListModerationQueueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListModerationQueueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.Limit != nil {
+		// no validation rules for Limit
+	}
+
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if len(errors) > 0 {
+		return ListModerationQueueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListModerationQueueRequestMultiError is an error wrapping multiple
+// validation errors returned by ListModerationQueueRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListModerationQueueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListModerationQueueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListModerationQueueRequestMultiError) AllErrors() []error { return m }
+
+// ListModerationQueueRequestValidationError is the validation error returned
+// by ListModerationQueueRequest.Validate if the designated constraints aren't met.
+type ListModerationQueueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListModerationQueueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListModerationQueueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListModerationQueueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListModerationQueueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListModerationQueueRequestValidationError) ErrorName() string {
+	return "ListModerationQueueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListModerationQueueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListModerationQueueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListModerationQueueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListModerationQueueRequestValidationError{}
+
+// Validate checks the field values on ListModerationQueueReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListModerationQueueReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListModerationQueueReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListModerationQueueReplyMultiError, or nil if none found.
+func (m *ListModerationQueueReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListModerationQueueReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListModerationQueueReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListModerationQueueReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListModerationQueueReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.NextCursor != nil {
+		// no validation rules for NextCursor
+	}
+
+	if len(errors) > 0 {
+		return ListModerationQueueReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListModerationQueueReplyMultiError is an error wrapping multiple validation
+// errors returned by ListModerationQueueReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListModerationQueueReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListModerationQueueReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListModerationQueueReplyMultiError) AllErrors() []error { return m }
+
+// ListModerationQueueReplyValidationError is the validation error returned by
+// ListModerationQueueReply.Validate if the designated constraints aren't met.
+type ListModerationQueueReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListModerationQueueReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListModerationQueueReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListModerationQueueReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListModerationQueueReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListModerationQueueReplyValidationError) ErrorName() string {
+	return "ListModerationQueueReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListModerationQueueReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListModerationQueueReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListModerationQueueReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListModerationQueueReplyValidationError{}
+
+// Validate checks the field values on ModerateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModerateReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModerateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModerateReviewRequestMultiError, or nil if none found.
+func (m *ModerateReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModerateReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Action
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
+	if m.Version != nil {
+
+		if m.GetVersion() <= 0 {
+			err := ModerateReviewRequestValidationError{
+				field:  "Version",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ModerateReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModerateReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by ModerateReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ModerateReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModerateReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModerateReviewRequestMultiError) AllErrors() []error { return m }
+
+// ModerateReviewRequestValidationError is the validation error returned by
+// ModerateReviewRequest.Validate if the designated constraints aren't met.
+type ModerateReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModerateReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModerateReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModerateReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModerateReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModerateReviewRequestValidationError) ErrorName() string {
+	return "ModerateReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModerateReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModerateReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModerateReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModerateReviewRequestValidationError{}
+
+// Validate checks the field values on ModerateReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModerateReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModerateReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModerateReviewReplyMultiError, or nil if none found.
+func (m *ModerateReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModerateReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModerateReviewReplyValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModerateReviewReplyValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModerateReviewReplyValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ModerateReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModerateReviewReplyMultiError is an error wrapping multiple validation
+// errors returned by ModerateReviewReply.ValidateAll() if the designated
+// constraints aren't met.
+type ModerateReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModerateReviewReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModerateReviewReplyMultiError) AllErrors() []error { return m }
+
+// ModerateReviewReplyValidationError is the validation error returned by
+// ModerateReviewReply.Validate if the designated constraints aren't met.
+type ModerateReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModerateReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModerateReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModerateReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModerateReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModerateReviewReplyValidationError) ErrorName() string {
+	return "ModerateReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModerateReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModerateReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModerateReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModerateReviewReplyValidationError{}
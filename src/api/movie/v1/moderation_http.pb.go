@@ -0,0 +1,168 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v6.32.1
+// source: movie/v1/moderation.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationModerationServiceListModerationQueue = "/api.movie.v1.ModerationService/ListModerationQueue"
+const OperationModerationServiceModerateReview = "/api.movie.v1.ModerationService/ModerateReview"
+const OperationModerationServiceReportReview = "/api.movie.v1.ModerationService/ReportReview"
+
+type ModerationServiceHTTPServer interface {
+	// ListModerationQueue List the moderation queue (admin)
+	ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueReply, error)
+	// ModerateReview Approve or hide a review (admin)
+	ModerateReview(context.Context, *ModerateReviewRequest) (*ModerateReviewReply, error)
+	// ReportReview Report an abusive review or rating
+	ReportReview(context.Context, *ReportReviewRequest) (*ReportReviewReply, error)
+}
+
+func RegisterModerationServiceHTTPServer(s *http.Server, srv ModerationServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/reviews/{id}/report", _ModerationService_ReportReview0_HTTP_Handler(srv))
+	r.GET("/admin/moderation/queue", _ModerationService_ListModerationQueue0_HTTP_Handler(srv))
+	r.POST("/admin/moderation/reviews/{id}", _ModerationService_ModerateReview0_HTTP_Handler(srv))
+}
+
+func _ModerationService_ReportReview0_HTTP_Handler(srv ModerationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReportReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationModerationServiceReportReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReportReview(ctx, req.(*ReportReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReportReviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ModerationService_ListModerationQueue0_HTTP_Handler(srv ModerationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListModerationQueueRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationModerationServiceListModerationQueue)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListModerationQueue(ctx, req.(*ListModerationQueueRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListModerationQueueReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ModerationService_ModerateReview0_HTTP_Handler(srv ModerationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ModerateReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationModerationServiceModerateReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ModerateReview(ctx, req.(*ModerateReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ModerateReviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ModerationServiceHTTPClient interface {
+	// ListModerationQueue List the moderation queue (admin)
+	ListModerationQueue(ctx context.Context, req *ListModerationQueueRequest, opts ...http.CallOption) (rsp *ListModerationQueueReply, err error)
+	// ModerateReview Approve or hide a review (admin)
+	ModerateReview(ctx context.Context, req *ModerateReviewRequest, opts ...http.CallOption) (rsp *ModerateReviewReply, err error)
+	// ReportReview Report an abusive review or rating
+	ReportReview(ctx context.Context, req *ReportReviewRequest, opts ...http.CallOption) (rsp *ReportReviewReply, err error)
+}
+
+type ModerationServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewModerationServiceHTTPClient(client *http.Client) ModerationServiceHTTPClient {
+	return &ModerationServiceHTTPClientImpl{client}
+}
+
+// ListModerationQueue List the moderation queue (admin)
+func (c *ModerationServiceHTTPClientImpl) ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...http.CallOption) (*ListModerationQueueReply, error) {
+	var out ListModerationQueueReply
+	pattern := "/admin/moderation/queue"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationModerationServiceListModerationQueue))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ModerateReview Approve or hide a review (admin)
+func (c *ModerationServiceHTTPClientImpl) ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...http.CallOption) (*ModerateReviewReply, error) {
+	var out ModerateReviewReply
+	pattern := "/admin/moderation/reviews/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationModerationServiceModerateReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReportReview Report an abusive review or rating
+func (c *ModerationServiceHTTPClientImpl) ReportReview(ctx context.Context, in *ReportReviewRequest, opts ...http.CallOption) (*ReportReviewReply, error) {
+	var out ReportReviewReply
+	pattern := "/reviews/{id}/report"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationModerationServiceReportReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -7,6 +7,7 @@
 package v1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -25,13 +26,18 @@ const (
 
 // Messages for CreateMovie
 type CreateMovieRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Genre         string                 `protobuf:"bytes,2,opt,name=genre,proto3" json:"genre,omitempty"`
-	ReleaseDate   string                 `protobuf:"bytes,3,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"` // YYYY-MM-DD format
-	Distributor   *string                `protobuf:"bytes,4,opt,name=distributor,proto3,oneof" json:"distributor,omitempty"`
-	Budget        *int64                 `protobuf:"varint,5,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
-	MpaRating     *string                `protobuf:"bytes,6,opt,name=mpa_rating,json=mpaRating,proto3,oneof" json:"mpa_rating,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Title string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// A genre name or alias, which needs a letter or digit
+	Genre         string            `protobuf:"bytes,2,opt,name=genre,proto3" json:"genre,omitempty"`
+	ReleaseDate   string            `protobuf:"bytes,3,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"` // YYYY-MM-DD format
+	Distributor   *string           `protobuf:"bytes,4,opt,name=distributor,proto3,oneof" json:"distributor,omitempty"`
+	Budget        *int64            `protobuf:"varint,5,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
+	MpaRating     *string           `protobuf:"bytes,6,opt,name=mpa_rating,json=mpaRating,proto3,oneof" json:"mpa_rating,omitempty"`
+	Credits       []*CreditInput    `protobuf:"bytes,7,rep,name=credits,proto3" json:"credits,omitempty"`
+	Genres        []string          `protobuf:"bytes,8,rep,name=genres,proto3" json:"genres,omitempty"` // additional genres besides genre
+	Tags          []string          `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	ExternalIds   map[string]string `protobuf:"bytes,10,rep,name=external_ids,json=externalIds,proto3" json:"external_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // ID by scheme: imdb, tmdb, eidr
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -108,16 +114,58 @@ func (x *CreateMovieRequest) GetMpaRating() string {
 	return ""
 }
 
+func (x *CreateMovieRequest) GetCredits() []*CreditInput {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *CreateMovieRequest) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *CreateMovieRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateMovieRequest) GetExternalIds() map[string]string {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
 type CreateMovieReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	ReleaseDate   string                 `protobuf:"bytes,3,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
-	Genre         string                 `protobuf:"bytes,4,opt,name=genre,proto3" json:"genre,omitempty"`
-	Distributor   *string                `protobuf:"bytes,5,opt,name=distributor,proto3,oneof" json:"distributor,omitempty"`
-	Budget        *int64                 `protobuf:"varint,6,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
-	MpaRating     *string                `protobuf:"bytes,7,opt,name=mpa_rating,json=mpaRating,proto3,oneof" json:"mpa_rating,omitempty"`
-	BoxOffice     *BoxOffice             `protobuf:"bytes,8,opt,name=box_office,json=boxOffice,proto3,oneof" json:"box_office,omitempty"`
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ReleaseDate     string                 `protobuf:"bytes,3,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	Genre           string                 `protobuf:"bytes,4,opt,name=genre,proto3" json:"genre,omitempty"`
+	Distributor     *string                `protobuf:"bytes,5,opt,name=distributor,proto3,oneof" json:"distributor,omitempty"`
+	Budget          *int64                 `protobuf:"varint,6,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
+	MpaRating       *string                `protobuf:"bytes,7,opt,name=mpa_rating,json=mpaRating,proto3,oneof" json:"mpa_rating,omitempty"`
+	BoxOffice       *BoxOffice             `protobuf:"bytes,8,opt,name=box_office,json=boxOffice,proto3,oneof" json:"box_office,omitempty"`
+	Credits         []*Credit              `protobuf:"bytes,9,rep,name=credits,proto3" json:"credits,omitempty"`
+	Genres          []string               `protobuf:"bytes,10,rep,name=genres,proto3" json:"genres,omitempty"` // canonical genre names, primary first
+	Tags            []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	AlternateTitles []*AlternateTitle      `protobuf:"bytes,12,rep,name=alternate_titles,json=alternateTitles,proto3" json:"alternate_titles,omitempty"`
+	// Localized by Accept-Language, falling back to less specific locales and then en
+	Locale          string            `protobuf:"bytes,13,opt,name=locale,proto3" json:"locale,omitempty"` // locale of synopsis and tagline
+	Synopsis        *string           `protobuf:"bytes,14,opt,name=synopsis,proto3,oneof" json:"synopsis,omitempty"`
+	Tagline         *string           `protobuf:"bytes,15,opt,name=tagline,proto3,oneof" json:"tagline,omitempty"`
+	LocalizedGenres []string          `protobuf:"bytes,16,rep,name=localized_genres,json=localizedGenres,proto3" json:"localized_genres,omitempty"`                                                               // names of genres, in the same order
+	Images          []*MovieImage     `protobuf:"bytes,17,rep,name=images,proto3" json:"images,omitempty"`                                                                                                        // posters first, then stills
+	ExternalIds     map[string]string `protobuf:"bytes,18,rep,name=external_ids,json=externalIds,proto3" json:"external_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // ID by scheme, in canonical form
+	// Counts the writes to the movie; send it back as version to make a write
+	// fail with 409 VERSION_CONFLICT if the movie has changed since
+	Version       int64 `protobuf:"varint,19,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -208,6 +256,228 @@ func (x *CreateMovieReply) GetBoxOffice() *BoxOffice {
 	return nil
 }
 
+func (x *CreateMovieReply) GetCredits() []*Credit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *CreateMovieReply) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *CreateMovieReply) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateMovieReply) GetAlternateTitles() []*AlternateTitle {
+	if x != nil {
+		return x.AlternateTitles
+	}
+	return nil
+}
+
+func (x *CreateMovieReply) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *CreateMovieReply) GetSynopsis() string {
+	if x != nil && x.Synopsis != nil {
+		return *x.Synopsis
+	}
+	return ""
+}
+
+func (x *CreateMovieReply) GetTagline() string {
+	if x != nil && x.Tagline != nil {
+		return *x.Tagline
+	}
+	return ""
+}
+
+func (x *CreateMovieReply) GetLocalizedGenres() []string {
+	if x != nil {
+		return x.LocalizedGenres
+	}
+	return nil
+}
+
+func (x *CreateMovieReply) GetImages() []*MovieImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *CreateMovieReply) GetExternalIds() map[string]string {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+func (x *CreateMovieReply) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// CreditInput credits an existing person on a movie
+type CreditInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PersonId      string                 `protobuf:"bytes,1,opt,name=person_id,json=personId,proto3" json:"person_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`                                              // director, writer or actor
+	CharacterName *string                `protobuf:"bytes,3,opt,name=character_name,json=characterName,proto3,oneof" json:"character_name,omitempty"` // actors only
+	BillingOrder  *int32                 `protobuf:"varint,4,opt,name=billing_order,json=billingOrder,proto3,oneof" json:"billing_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditInput) Reset() {
+	*x = CreditInput{}
+	mi := &file_movie_v1_movie_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditInput) ProtoMessage() {}
+
+func (x *CreditInput) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditInput.ProtoReflect.Descriptor instead.
+func (*CreditInput) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreditInput) GetPersonId() string {
+	if x != nil {
+		return x.PersonId
+	}
+	return ""
+}
+
+func (x *CreditInput) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CreditInput) GetCharacterName() string {
+	if x != nil && x.CharacterName != nil {
+		return *x.CharacterName
+	}
+	return ""
+}
+
+func (x *CreditInput) GetBillingOrder() int32 {
+	if x != nil && x.BillingOrder != nil {
+		return *x.BillingOrder
+	}
+	return 0
+}
+
+type Credit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PersonId      string                 `protobuf:"bytes,1,opt,name=person_id,json=personId,proto3" json:"person_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	CharacterName *string                `protobuf:"bytes,4,opt,name=character_name,json=characterName,proto3,oneof" json:"character_name,omitempty"`
+	BillingOrder  *int32                 `protobuf:"varint,5,opt,name=billing_order,json=billingOrder,proto3,oneof" json:"billing_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Credit) Reset() {
+	*x = Credit{}
+	mi := &file_movie_v1_movie_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Credit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Credit) ProtoMessage() {}
+
+func (x *Credit) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Credit.ProtoReflect.Descriptor instead.
+func (*Credit) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Credit) GetPersonId() string {
+	if x != nil {
+		return x.PersonId
+	}
+	return ""
+}
+
+func (x *Credit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Credit) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Credit) GetCharacterName() string {
+	if x != nil && x.CharacterName != nil {
+		return *x.CharacterName
+	}
+	return ""
+}
+
+func (x *Credit) GetBillingOrder() int32 {
+	if x != nil && x.BillingOrder != nil {
+		return *x.BillingOrder
+	}
+	return 0
+}
+
 type BoxOffice struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Revenue       *Revenue               `protobuf:"bytes,1,opt,name=revenue,proto3" json:"revenue,omitempty"`
@@ -220,7 +490,7 @@ type BoxOffice struct {
 
 func (x *BoxOffice) Reset() {
 	*x = BoxOffice{}
-	mi := &file_movie_v1_movie_proto_msgTypes[2]
+	mi := &file_movie_v1_movie_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -232,7 +502,7 @@ func (x *BoxOffice) String() string {
 func (*BoxOffice) ProtoMessage() {}
 
 func (x *BoxOffice) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_v1_movie_proto_msgTypes[2]
+	mi := &file_movie_v1_movie_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,7 +515,7 @@ func (x *BoxOffice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoxOffice.ProtoReflect.Descriptor instead.
 func (*BoxOffice) Descriptor() ([]byte, []int) {
-	return file_movie_v1_movie_proto_rawDescGZIP(), []int{2}
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BoxOffice) GetRevenue() *Revenue {
@@ -286,7 +556,7 @@ type Revenue struct {
 
 func (x *Revenue) Reset() {
 	*x = Revenue{}
-	mi := &file_movie_v1_movie_proto_msgTypes[3]
+	mi := &file_movie_v1_movie_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -298,7 +568,7 @@ func (x *Revenue) String() string {
 func (*Revenue) ProtoMessage() {}
 
 func (x *Revenue) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_v1_movie_proto_msgTypes[3]
+	mi := &file_movie_v1_movie_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,7 +581,7 @@ func (x *Revenue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Revenue.ProtoReflect.Descriptor instead.
 func (*Revenue) Descriptor() ([]byte, []int) {
-	return file_movie_v1_movie_proto_rawDescGZIP(), []int{3}
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Revenue) GetWorldwide() int64 {
@@ -339,13 +609,16 @@ type ListMoviesRequest struct {
 	MpaRating     *string                `protobuf:"bytes,6,opt,name=mpa_rating,json=mpaRating,proto3,oneof" json:"mpa_rating,omitempty"`
 	Limit         *int32                 `protobuf:"varint,7,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
 	Cursor        *string                `protobuf:"bytes,8,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Director      *string                `protobuf:"bytes,9,opt,name=director,proto3,oneof" json:"director,omitempty"` // person name, case-insensitive
+	Actor         *string                `protobuf:"bytes,10,opt,name=actor,proto3,oneof" json:"actor,omitempty"`      // person name, case-insensitive
+	Tag           *string                `protobuf:"bytes,11,opt,name=tag,proto3,oneof" json:"tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListMoviesRequest) Reset() {
 	*x = ListMoviesRequest{}
-	mi := &file_movie_v1_movie_proto_msgTypes[4]
+	mi := &file_movie_v1_movie_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -357,7 +630,7 @@ func (x *ListMoviesRequest) String() string {
 func (*ListMoviesRequest) ProtoMessage() {}
 
 func (x *ListMoviesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_v1_movie_proto_msgTypes[4]
+	mi := &file_movie_v1_movie_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,7 +643,7 @@ func (x *ListMoviesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMoviesRequest.ProtoReflect.Descriptor instead.
 func (*ListMoviesRequest) Descriptor() ([]byte, []int) {
-	return file_movie_v1_movie_proto_rawDescGZIP(), []int{4}
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListMoviesRequest) GetQ() string {
@@ -429,6 +702,27 @@ func (x *ListMoviesRequest) GetCursor() string {
 	return ""
 }
 
+func (x *ListMoviesRequest) GetDirector() string {
+	if x != nil && x.Director != nil {
+		return *x.Director
+	}
+	return ""
+}
+
+func (x *ListMoviesRequest) GetActor() string {
+	if x != nil && x.Actor != nil {
+		return *x.Actor
+	}
+	return ""
+}
+
+func (x *ListMoviesRequest) GetTag() string {
+	if x != nil && x.Tag != nil {
+		return *x.Tag
+	}
+	return ""
+}
+
 type ListMoviesReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*MovieItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -439,7 +733,7 @@ type ListMoviesReply struct {
 
 func (x *ListMoviesReply) Reset() {
 	*x = ListMoviesReply{}
-	mi := &file_movie_v1_movie_proto_msgTypes[5]
+	mi := &file_movie_v1_movie_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -451,7 +745,7 @@ func (x *ListMoviesReply) String() string {
 func (*ListMoviesReply) ProtoMessage() {}
 
 func (x *ListMoviesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_v1_movie_proto_msgTypes[5]
+	mi := &file_movie_v1_movie_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -464,7 +758,7 @@ func (x *ListMoviesReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMoviesReply.ProtoReflect.Descriptor instead.
 func (*ListMoviesReply) Descriptor() ([]byte, []int) {
-	return file_movie_v1_movie_proto_rawDescGZIP(), []int{5}
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListMoviesReply) GetItems() []*MovieItem {
@@ -481,35 +775,30 @@ func (x *ListMoviesReply) GetNextCursor() string {
 	return ""
 }
 
-type MovieItem struct {
+// Messages for BatchGetMovies
+type BatchGetMoviesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	ReleaseDate   string                 `protobuf:"bytes,3,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
-	Genre         string                 `protobuf:"bytes,4,opt,name=genre,proto3" json:"genre,omitempty"`
-	Distributor   *string                `protobuf:"bytes,5,opt,name=distributor,proto3,oneof" json:"distributor,omitempty"`
-	Budget        *int64                 `protobuf:"varint,6,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
-	MpaRating     *string                `protobuf:"bytes,7,opt,name=mpa_rating,json=mpaRating,proto3,oneof" json:"mpa_rating,omitempty"`
-	BoxOffice     *BoxOffice             `protobuf:"bytes,8,opt,name=box_office,json=boxOffice,proto3,oneof" json:"box_office,omitempty"`
+	Titles        []string               `protobuf:"bytes,1,rep,name=titles,proto3" json:"titles,omitempty"` // resolved like /movies/{title}
+	Ids           []string               `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MovieItem) Reset() {
-	*x = MovieItem{}
-	mi := &file_movie_v1_movie_proto_msgTypes[6]
+func (x *BatchGetMoviesRequest) Reset() {
+	*x = BatchGetMoviesRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MovieItem) String() string {
+func (x *BatchGetMoviesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MovieItem) ProtoMessage() {}
+func (*BatchGetMoviesRequest) ProtoMessage() {}
 
-func (x *MovieItem) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_v1_movie_proto_msgTypes[6]
+func (x *BatchGetMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,91 +809,2261 @@ func (x *MovieItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MovieItem.ProtoReflect.Descriptor instead.
-func (*MovieItem) Descriptor() ([]byte, []int) {
-	return file_movie_v1_movie_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use BatchGetMoviesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *MovieItem) GetId() string {
+func (x *BatchGetMoviesRequest) GetTitles() []string {
 	if x != nil {
-		return x.Id
+		return x.Titles
 	}
-	return ""
+	return nil
 }
 
-func (x *MovieItem) GetTitle() string {
+func (x *BatchGetMoviesRequest) GetIds() []string {
 	if x != nil {
-		return x.Title
+		return x.Ids
 	}
-	return ""
+	return nil
 }
 
-func (x *MovieItem) GetReleaseDate() string {
-	if x != nil {
-		return x.ReleaseDate
-	}
-	return ""
+type BatchGetMoviesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchMovieResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // titles first, then IDs, in request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MovieItem) GetGenre() string {
-	if x != nil {
-		return x.Genre
-	}
+func (x *BatchGetMoviesReply) Reset() {
+	*x = BatchGetMoviesReply{}
+	mi := &file_movie_v1_movie_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetMoviesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetMoviesReply) ProtoMessage() {}
+
+func (x *BatchGetMoviesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetMoviesReply.ProtoReflect.Descriptor instead.
+func (*BatchGetMoviesReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchGetMoviesReply) GetResults() []*BatchMovieResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchMovieResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`       // the requested title, for a lookup by title
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`             // the requested ID, for a lookup by ID
+	Movie         *MovieItem             `protobuf:"bytes,3,opt,name=movie,proto3,oneof" json:"movie,omitempty"` // unset when the lookup failed
+	Error         *LookupError           `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchMovieResult) Reset() {
+	*x = BatchMovieResult{}
+	mi := &file_movie_v1_movie_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchMovieResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMovieResult) ProtoMessage() {}
+
+func (x *BatchMovieResult) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMovieResult.ProtoReflect.Descriptor instead.
+func (*BatchMovieResult) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchMovieResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BatchMovieResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchMovieResult) GetMovie() *MovieItem {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+func (x *BatchMovieResult) GetError() *LookupError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// Why one item of a batch failed, as the single-item call would report it
+type LookupError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`    // 404, or 300 for an ambiguous title
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // NOT_FOUND or AMBIGUOUS_TITLE
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // for AMBIGUOUS_TITLE, the movie ID by disambiguated title
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupError) Reset() {
+	*x = LookupError{}
+	mi := &file_movie_v1_movie_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupError) ProtoMessage() {}
+
+func (x *LookupError) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupError.ProtoReflect.Descriptor instead.
+func (*LookupError) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LookupError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *LookupError) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LookupError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LookupError) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Messages for ExportMovies
+type ExportMoviesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Q             *string                `protobuf:"bytes,2,opt,name=q,proto3,oneof" json:"q,omitempty"`
+	Year          *int32                 `protobuf:"varint,3,opt,name=year,proto3,oneof" json:"year,omitempty"`
+	Genre         *string                `protobuf:"bytes,4,opt,name=genre,proto3,oneof" json:"genre,omitempty"`
+	Distributor   *string                `protobuf:"bytes,5,opt,name=distributor,proto3,oneof" json:"distributor,omitempty"`
+	Budget        *int64                 `protobuf:"varint,6,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
+	MpaRating     *string                `protobuf:"bytes,7,opt,name=mpa_rating,json=mpaRating,proto3,oneof" json:"mpa_rating,omitempty"`
+	Director      *string                `protobuf:"bytes,8,opt,name=director,proto3,oneof" json:"director,omitempty"` // person name, case-insensitive
+	Actor         *string                `protobuf:"bytes,9,opt,name=actor,proto3,oneof" json:"actor,omitempty"`       // person name, case-insensitive
+	Tag           *string                `protobuf:"bytes,10,opt,name=tag,proto3,oneof" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMoviesRequest) Reset() {
+	*x = ExportMoviesRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMoviesRequest) ProtoMessage() {}
+
+func (x *ExportMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMoviesRequest.ProtoReflect.Descriptor instead.
+func (*ExportMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExportMoviesRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportMoviesRequest) GetQ() string {
+	if x != nil && x.Q != nil {
+		return *x.Q
+	}
+	return ""
+}
+
+func (x *ExportMoviesRequest) GetYear() int32 {
+	if x != nil && x.Year != nil {
+		return *x.Year
+	}
+	return 0
+}
+
+func (x *ExportMoviesRequest) GetGenre() string {
+	if x != nil && x.Genre != nil {
+		return *x.Genre
+	}
+	return ""
+}
+
+func (x *ExportMoviesRequest) GetDistributor() string {
+	if x != nil && x.Distributor != nil {
+		return *x.Distributor
+	}
+	return ""
+}
+
+func (x *ExportMoviesRequest) GetBudget() int64 {
+	if x != nil && x.Budget != nil {
+		return *x.Budget
+	}
+	return 0
+}
+
+func (x *ExportMoviesRequest) GetMpaRating() string {
+	if x != nil && x.MpaRating != nil {
+		return *x.MpaRating
+	}
+	return ""
+}
+
+func (x *ExportMoviesRequest) GetDirector() string {
+	if x != nil && x.Director != nil {
+		return *x.Director
+	}
+	return ""
+}
+
+func (x *ExportMoviesRequest) GetActor() string {
+	if x != nil && x.Actor != nil {
+		return *x.Actor
+	}
+	return ""
+}
+
+func (x *ExportMoviesRequest) GetTag() string {
+	if x != nil && x.Tag != nil {
+		return *x.Tag
+	}
+	return ""
+}
+
+// A piece of the export file; concatenated in order they form the file
+type ExportMoviesChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMoviesChunk) Reset() {
+	*x = ExportMoviesChunk{}
+	mi := &file_movie_v1_movie_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMoviesChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMoviesChunk) ProtoMessage() {}
+
+func (x *ExportMoviesChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMoviesChunk.ProtoReflect.Descriptor instead.
+func (*ExportMoviesChunk) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportMoviesChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type MovieItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ReleaseDate     string                 `protobuf:"bytes,3,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	Genre           string                 `protobuf:"bytes,4,opt,name=genre,proto3" json:"genre,omitempty"`
+	Distributor     *string                `protobuf:"bytes,5,opt,name=distributor,proto3,oneof" json:"distributor,omitempty"`
+	Budget          *int64                 `protobuf:"varint,6,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
+	MpaRating       *string                `protobuf:"bytes,7,opt,name=mpa_rating,json=mpaRating,proto3,oneof" json:"mpa_rating,omitempty"`
+	BoxOffice       *BoxOffice             `protobuf:"bytes,8,opt,name=box_office,json=boxOffice,proto3,oneof" json:"box_office,omitempty"`
+	Credits         []*Credit              `protobuf:"bytes,9,rep,name=credits,proto3" json:"credits,omitempty"`
+	Genres          []string               `protobuf:"bytes,10,rep,name=genres,proto3" json:"genres,omitempty"` // canonical genre names, primary first
+	Tags            []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	AlternateTitles []*AlternateTitle      `protobuf:"bytes,12,rep,name=alternate_titles,json=alternateTitles,proto3" json:"alternate_titles,omitempty"`
+	// Localized by Accept-Language, falling back to less specific locales and then en
+	Locale          string            `protobuf:"bytes,13,opt,name=locale,proto3" json:"locale,omitempty"` // locale of synopsis and tagline
+	Synopsis        *string           `protobuf:"bytes,14,opt,name=synopsis,proto3,oneof" json:"synopsis,omitempty"`
+	Tagline         *string           `protobuf:"bytes,15,opt,name=tagline,proto3,oneof" json:"tagline,omitempty"`
+	LocalizedGenres []string          `protobuf:"bytes,16,rep,name=localized_genres,json=localizedGenres,proto3" json:"localized_genres,omitempty"`                                                               // names of genres, in the same order
+	Images          []*MovieImage     `protobuf:"bytes,17,rep,name=images,proto3" json:"images,omitempty"`                                                                                                        // posters first, then stills
+	ExternalIds     map[string]string `protobuf:"bytes,18,rep,name=external_ids,json=externalIds,proto3" json:"external_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // ID by scheme, in canonical form
+	// Counts the writes to the movie; send it back as version to make a write
+	// fail with 409 VERSION_CONFLICT if the movie has changed since
+	Version       int64 `protobuf:"varint,19,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieItem) Reset() {
+	*x = MovieItem{}
+	mi := &file_movie_v1_movie_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieItem) ProtoMessage() {}
+
+func (x *MovieItem) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieItem.ProtoReflect.Descriptor instead.
+func (*MovieItem) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MovieItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MovieItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MovieItem) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+func (x *MovieItem) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+func (x *MovieItem) GetDistributor() string {
+	if x != nil && x.Distributor != nil {
+		return *x.Distributor
+	}
+	return ""
+}
+
+func (x *MovieItem) GetBudget() int64 {
+	if x != nil && x.Budget != nil {
+		return *x.Budget
+	}
+	return 0
+}
+
+func (x *MovieItem) GetMpaRating() string {
+	if x != nil && x.MpaRating != nil {
+		return *x.MpaRating
+	}
+	return ""
+}
+
+func (x *MovieItem) GetBoxOffice() *BoxOffice {
+	if x != nil {
+		return x.BoxOffice
+	}
+	return nil
+}
+
+func (x *MovieItem) GetCredits() []*Credit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *MovieItem) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *MovieItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *MovieItem) GetAlternateTitles() []*AlternateTitle {
+	if x != nil {
+		return x.AlternateTitles
+	}
+	return nil
+}
+
+func (x *MovieItem) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *MovieItem) GetSynopsis() string {
+	if x != nil && x.Synopsis != nil {
+		return *x.Synopsis
+	}
+	return ""
+}
+
+func (x *MovieItem) GetTagline() string {
+	if x != nil && x.Tagline != nil {
+		return *x.Tagline
+	}
+	return ""
+}
+
+func (x *MovieItem) GetLocalizedGenres() []string {
+	if x != nil {
+		return x.LocalizedGenres
+	}
+	return nil
+}
+
+func (x *MovieItem) GetImages() []*MovieImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *MovieItem) GetExternalIds() map[string]string {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+func (x *MovieItem) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Messages for SetMovieCredits
+type SetMovieCreditsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"` // from path
+	Credits       []*CreditInput         `protobuf:"bytes,2,rep,name=credits,proto3" json:"credits,omitempty"`
+	Version       *int64                 `protobuf:"varint,3,opt,name=version,proto3,oneof" json:"version,omitempty"` // the movie's version as last read
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMovieCreditsRequest) Reset() {
+	*x = SetMovieCreditsRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMovieCreditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMovieCreditsRequest) ProtoMessage() {}
+
+func (x *SetMovieCreditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMovieCreditsRequest.ProtoReflect.Descriptor instead.
+func (*SetMovieCreditsRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetMovieCreditsRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SetMovieCreditsRequest) GetCredits() []*CreditInput {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *SetMovieCreditsRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+// Messages for SetMovieGenres
+type SetMovieGenresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"` // from path
+	Genres        []string               `protobuf:"bytes,2,rep,name=genres,proto3" json:"genres,omitempty"`
+	Version       *int64                 `protobuf:"varint,3,opt,name=version,proto3,oneof" json:"version,omitempty"` // the movie's version as last read
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMovieGenresRequest) Reset() {
+	*x = SetMovieGenresRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMovieGenresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMovieGenresRequest) ProtoMessage() {}
+
+func (x *SetMovieGenresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMovieGenresRequest.ProtoReflect.Descriptor instead.
+func (*SetMovieGenresRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetMovieGenresRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SetMovieGenresRequest) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *SetMovieGenresRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+// Messages for SetMovieTags
+type SetMovieTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"` // from path
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Version       *int64                 `protobuf:"varint,3,opt,name=version,proto3,oneof" json:"version,omitempty"` // the movie's version as last read
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMovieTagsRequest) Reset() {
+	*x = SetMovieTagsRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMovieTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMovieTagsRequest) ProtoMessage() {}
+
+func (x *SetMovieTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMovieTagsRequest.ProtoReflect.Descriptor instead.
+func (*SetMovieTagsRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetMovieTagsRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SetMovieTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SetMovieTagsRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type AlternateTitle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Language      *string                `protobuf:"bytes,3,opt,name=language,proto3,oneof" json:"language,omitempty"` // BCP 47 tag, e.g. zh-Hans
+	Region        *string                `protobuf:"bytes,4,opt,name=region,proto3,oneof" json:"region,omitempty"`     // ISO 3166-1 alpha-2 or UN M49 code, e.g. CN
+	Kind          string                 `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`               // original, localized, working or alternative
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlternateTitle) Reset() {
+	*x = AlternateTitle{}
+	mi := &file_movie_v1_movie_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlternateTitle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlternateTitle) ProtoMessage() {}
+
+func (x *AlternateTitle) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlternateTitle.ProtoReflect.Descriptor instead.
+func (*AlternateTitle) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AlternateTitle) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AlternateTitle) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AlternateTitle) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+func (x *AlternateTitle) GetRegion() string {
+	if x != nil && x.Region != nil {
+		return *x.Region
+	}
+	return ""
+}
+
+func (x *AlternateTitle) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+// Messages for AddAlternateTitle
+type AddAlternateTitleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"` // from path
+	AlternateTitle string                 `protobuf:"bytes,2,opt,name=alternate_title,json=alternateTitle,proto3" json:"alternate_title,omitempty"`
+	Language       *string                `protobuf:"bytes,3,opt,name=language,proto3,oneof" json:"language,omitempty"`
+	Region         *string                `protobuf:"bytes,4,opt,name=region,proto3,oneof" json:"region,omitempty"`
+	Kind           *string                `protobuf:"bytes,5,opt,name=kind,proto3,oneof" json:"kind,omitempty"`        // defaults to alternative
+	Version        *int64                 `protobuf:"varint,6,opt,name=version,proto3,oneof" json:"version,omitempty"` // the movie's version as last read
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddAlternateTitleRequest) Reset() {
+	*x = AddAlternateTitleRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAlternateTitleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAlternateTitleRequest) ProtoMessage() {}
+
+func (x *AddAlternateTitleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAlternateTitleRequest.ProtoReflect.Descriptor instead.
+func (*AddAlternateTitleRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AddAlternateTitleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AddAlternateTitleRequest) GetAlternateTitle() string {
+	if x != nil {
+		return x.AlternateTitle
+	}
+	return ""
+}
+
+func (x *AddAlternateTitleRequest) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
+func (x *AddAlternateTitleRequest) GetRegion() string {
+	if x != nil && x.Region != nil {
+		return *x.Region
+	}
+	return ""
+}
+
+func (x *AddAlternateTitleRequest) GetKind() string {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return ""
+}
+
+func (x *AddAlternateTitleRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+// Messages for RemoveAlternateTitle
+type RemoveAlternateTitleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`            // from path
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`                 // from path
+	Version       *int64                 `protobuf:"varint,3,opt,name=version,proto3,oneof" json:"version,omitempty"` // the movie's version as last read
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAlternateTitleRequest) Reset() {
+	*x = RemoveAlternateTitleRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAlternateTitleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAlternateTitleRequest) ProtoMessage() {}
+
+func (x *RemoveAlternateTitleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAlternateTitleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAlternateTitleRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RemoveAlternateTitleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RemoveAlternateTitleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveAlternateTitleRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+// Messages for SetMovieTranslation
+type SetMovieTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`   // from path
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"` // from path, BCP 47 tag, e.g. pt-BR
+	Synopsis      *string                `protobuf:"bytes,3,opt,name=synopsis,proto3,oneof" json:"synopsis,omitempty"`
+	Tagline       *string                `protobuf:"bytes,4,opt,name=tagline,proto3,oneof" json:"tagline,omitempty"`
+	Version       *int64                 `protobuf:"varint,5,opt,name=version,proto3,oneof" json:"version,omitempty"` // the movie's version as last read
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMovieTranslationRequest) Reset() {
+	*x = SetMovieTranslationRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMovieTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMovieTranslationRequest) ProtoMessage() {}
+
+func (x *SetMovieTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMovieTranslationRequest.ProtoReflect.Descriptor instead.
+func (*SetMovieTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetMovieTranslationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SetMovieTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SetMovieTranslationRequest) GetSynopsis() string {
+	if x != nil && x.Synopsis != nil {
+		return *x.Synopsis
+	}
+	return ""
+}
+
+func (x *SetMovieTranslationRequest) GetTagline() string {
+	if x != nil && x.Tagline != nil {
+		return *x.Tagline
+	}
+	return ""
+}
+
+func (x *SetMovieTranslationRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+// Messages for BulkImportMovies
+type BulkImportMoviesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// csv or jsonl. CSV files have a header row with the columns title, genre,
+	// release_date and optionally distributor, budget, mpa_rating, genres, tags
+	// (both "|"-separated), imdb_id, tmdb_id and eidr. JSONL lines are objects
+	// with the same keys, genres and tags as arrays, and optional credits.
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Data   string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"` // file contents
+	// What happens to rows whose movie (same title and release year) exists:
+	// skip (default) or upsert
+	Mode          string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	DryRun        bool   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                // validate and report without writing anything
+	BoxOffice     bool   `protobuf:"varint,5,opt,name=box_office,json=boxOffice,proto3" json:"box_office,omitempty"`       // fetch box office data for created and updated movies, throttled
+	BatchSize     *int32 `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3,oneof" json:"batch_size,omitempty"` // rows per transaction, default 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkImportMoviesRequest) Reset() {
+	*x = BulkImportMoviesRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkImportMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkImportMoviesRequest) ProtoMessage() {}
+
+func (x *BulkImportMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkImportMoviesRequest.ProtoReflect.Descriptor instead.
+func (*BulkImportMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BulkImportMoviesRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *BulkImportMoviesRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *BulkImportMoviesRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *BulkImportMoviesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BulkImportMoviesRequest) GetBoxOffice() bool {
+	if x != nil {
+		return x.BoxOffice
+	}
+	return false
+}
+
+func (x *BulkImportMoviesRequest) GetBatchSize() int32 {
+	if x != nil && x.BatchSize != nil {
+		return *x.BatchSize
+	}
+	return 0
+}
+
+type BulkImportMoviesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Skipped       int32                  `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Rows          []*ImportRowResult     `protobuf:"bytes,6,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkImportMoviesReply) Reset() {
+	*x = BulkImportMoviesReply{}
+	mi := &file_movie_v1_movie_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkImportMoviesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkImportMoviesReply) ProtoMessage() {}
+
+func (x *BulkImportMoviesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkImportMoviesReply.ProtoReflect.Descriptor instead.
+func (*BulkImportMoviesReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BulkImportMoviesReply) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BulkImportMoviesReply) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *BulkImportMoviesReply) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *BulkImportMoviesReply) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *BulkImportMoviesReply) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkImportMoviesReply) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type ImportRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // line of the file the row starts on
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // created, updated, skipped or failed; what would happen in a dry run
+	MovieId       string                 `protobuf:"bytes,4,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
+	Error         *string                `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_movie_v1_movie_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportRowResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetMovieId() string {
+	if x != nil {
+		return x.MovieId
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+// Messages for GetMovieByExternalId
+type GetMovieByExternalIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scheme        string                 `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"` // from path: imdb, tmdb or eidr
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`         // from path
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMovieByExternalIdRequest) Reset() {
+	*x = GetMovieByExternalIdRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMovieByExternalIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMovieByExternalIdRequest) ProtoMessage() {}
+
+func (x *GetMovieByExternalIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMovieByExternalIdRequest.ProtoReflect.Descriptor instead.
+func (*GetMovieByExternalIdRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetMovieByExternalIdRequest) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *GetMovieByExternalIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Messages for SetMovieExternalId
+type SetMovieExternalIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`   // from path
+	Scheme        string                 `protobuf:"bytes,2,opt,name=scheme,proto3" json:"scheme,omitempty"` // from path
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Version       *int64                 `protobuf:"varint,4,opt,name=version,proto3,oneof" json:"version,omitempty"` // the movie's version as last read
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMovieExternalIdRequest) Reset() {
+	*x = SetMovieExternalIdRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMovieExternalIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMovieExternalIdRequest) ProtoMessage() {}
+
+func (x *SetMovieExternalIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMovieExternalIdRequest.ProtoReflect.Descriptor instead.
+func (*SetMovieExternalIdRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetMovieExternalIdRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SetMovieExternalIdRequest) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *SetMovieExternalIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetMovieExternalIdRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+// Messages for DeleteMovieExternalId
+type DeleteMovieExternalIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`            // from path
+	Scheme        string                 `protobuf:"bytes,2,opt,name=scheme,proto3" json:"scheme,omitempty"`          // from path
+	Version       *int64                 `protobuf:"varint,3,opt,name=version,proto3,oneof" json:"version,omitempty"` // the movie's version as last read
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMovieExternalIdRequest) Reset() {
+	*x = DeleteMovieExternalIdRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMovieExternalIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMovieExternalIdRequest) ProtoMessage() {}
+
+func (x *DeleteMovieExternalIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMovieExternalIdRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMovieExternalIdRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteMovieExternalIdRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DeleteMovieExternalIdRequest) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *DeleteMovieExternalIdRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+// Messages for DeleteMovieTranslation
+type DeleteMovieTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`            // from path
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`          // from path
+	Version       *int64                 `protobuf:"varint,3,opt,name=version,proto3,oneof" json:"version,omitempty"` // the movie's version as last read
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMovieTranslationRequest) Reset() {
+	*x = DeleteMovieTranslationRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMovieTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMovieTranslationRequest) ProtoMessage() {}
+
+func (x *DeleteMovieTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMovieTranslationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMovieTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteMovieTranslationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DeleteMovieTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *DeleteMovieTranslationRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type MovieImage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // poster or still
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Width         int32                  `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,7,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Thumbnails    []*Thumbnail           `protobuf:"bytes,8,rep,name=thumbnails,proto3" json:"thumbnails,omitempty"` // narrowest first, only widths below the original
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovieImage) Reset() {
+	*x = MovieImage{}
+	mi := &file_movie_v1_movie_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovieImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovieImage) ProtoMessage() {}
+
+func (x *MovieImage) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovieImage.ProtoReflect.Descriptor instead.
+func (*MovieImage) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MovieImage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MovieImage) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *MovieImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MovieImage) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *MovieImage) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *MovieImage) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *MovieImage) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *MovieImage) GetThumbnails() []*Thumbnail {
+	if x != nil {
+		return x.Thumbnails
+	}
+	return nil
+}
+
+func (x *MovieImage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Thumbnail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Width         int32                  `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Thumbnail) Reset() {
+	*x = Thumbnail{}
+	mi := &file_movie_v1_movie_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Thumbnail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Thumbnail) ProtoMessage() {}
+
+func (x *Thumbnail) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Thumbnail.ProtoReflect.Descriptor instead.
+func (*Thumbnail) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Thumbnail) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Thumbnail) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Thumbnail) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// Messages for UploadMovieImage
+type UploadMovieImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`     // movie ID
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // poster or still, defaults to still
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadMovieImageRequest) Reset() {
+	*x = UploadMovieImageRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadMovieImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMovieImageRequest) ProtoMessage() {}
+
+func (x *UploadMovieImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMovieImageRequest.ProtoReflect.Descriptor instead.
+func (*UploadMovieImageRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UploadMovieImageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UploadMovieImageRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *UploadMovieImageRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Messages for DeleteMovieImage
+type DeleteMovieImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                          // from path, movie ID
+	ImageId       string                 `protobuf:"bytes,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"` // from path
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMovieImageRequest) Reset() {
+	*x = DeleteMovieImageRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMovieImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMovieImageRequest) ProtoMessage() {}
+
+func (x *DeleteMovieImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMovieImageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMovieImageRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteMovieImageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteMovieImageRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+type DeleteMovieImageReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMovieImageReply) Reset() {
+	*x = DeleteMovieImageReply{}
+	mi := &file_movie_v1_movie_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMovieImageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMovieImageReply) ProtoMessage() {}
+
+func (x *DeleteMovieImageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMovieImageReply.ProtoReflect.Descriptor instead.
+func (*DeleteMovieImageReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{33}
+}
+
+// Messages for SubmitRating
+type SubmitRatingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Title string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"` // from path
+	// from body, 0.5 to 5.0 in steps of 0.5
+	Rating float64 `protobuf:"fixed64,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Review *string `protobuf:"bytes,3,opt,name=review,proto3,oneof" json:"review,omitempty"` // from body, free-text review
+	// from body, the version of the rater's rating as last read; the rating
+	// then fails with 409 if it has changed since
+	Version       *int64 `protobuf:"varint,4,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitRatingRequest) Reset() {
+	*x = SubmitRatingRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRatingRequest) ProtoMessage() {}
+
+func (x *SubmitRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRatingRequest.ProtoReflect.Descriptor instead.
+func (*SubmitRatingRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SubmitRatingRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SubmitRatingRequest) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *SubmitRatingRequest) GetReview() string {
+	if x != nil && x.Review != nil {
+		return *x.Review
+	}
+	return ""
+}
+
+func (x *SubmitRatingRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+type SubmitRatingReply struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MovieTitle       string                 `protobuf:"bytes,1,opt,name=movie_title,json=movieTitle,proto3" json:"movie_title,omitempty"`
+	RaterId          string                 `protobuf:"bytes,2,opt,name=rater_id,json=raterId,proto3" json:"rater_id,omitempty"`
+	Rating           float64                `protobuf:"fixed64,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	Id               int64                  `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	Review           *string                `protobuf:"bytes,5,opt,name=review,proto3,oneof" json:"review,omitempty"`
+	ModerationStatus string                 `protobuf:"bytes,6,opt,name=moderation_status,json=moderationStatus,proto3" json:"moderation_status,omitempty"`
+	Version          int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SubmitRatingReply) Reset() {
+	*x = SubmitRatingReply{}
+	mi := &file_movie_v1_movie_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRatingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRatingReply) ProtoMessage() {}
+
+func (x *SubmitRatingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRatingReply.ProtoReflect.Descriptor instead.
+func (*SubmitRatingReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SubmitRatingReply) GetMovieTitle() string {
+	if x != nil {
+		return x.MovieTitle
+	}
+	return ""
+}
+
+func (x *SubmitRatingReply) GetRaterId() string {
+	if x != nil {
+		return x.RaterId
+	}
+	return ""
+}
+
+func (x *SubmitRatingReply) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *SubmitRatingReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SubmitRatingReply) GetReview() string {
+	if x != nil && x.Review != nil {
+		return *x.Review
+	}
+	return ""
+}
+
+func (x *SubmitRatingReply) GetModerationStatus() string {
+	if x != nil {
+		return x.ModerationStatus
+	}
+	return ""
+}
+
+func (x *SubmitRatingReply) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Messages for GetRating
+type GetRatingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Window        *string                `protobuf:"bytes,2,opt,name=window,proto3,oneof" json:"window,omitempty"` // 7d, 30d, 365d or all (default)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingRequest) Reset() {
+	*x = GetRatingRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingRequest) ProtoMessage() {}
+
+func (x *GetRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingRequest.ProtoReflect.Descriptor instead.
+func (*GetRatingRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetRatingRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetRatingRequest) GetWindow() string {
+	if x != nil && x.Window != nil {
+		return *x.Window
+	}
 	return ""
 }
 
-func (x *MovieItem) GetDistributor() string {
-	if x != nil && x.Distributor != nil {
-		return *x.Distributor
+type GetRatingReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Average       float64                `protobuf:"fixed64,1,opt,name=average,proto3" json:"average,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	UnderReview   bool                   `protobuf:"varint,3,opt,name=under_review,json=underReview,proto3" json:"under_review,omitempty"` // set while a rating anomaly is investigated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingReply) Reset() {
+	*x = GetRatingReply{}
+	mi := &file_movie_v1_movie_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingReply) ProtoMessage() {}
+
+func (x *GetRatingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingReply.ProtoReflect.Descriptor instead.
+func (*GetRatingReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetRatingReply) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *GetRatingReply) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetRatingReply) GetUnderReview() bool {
+	if x != nil {
+		return x.UnderReview
 	}
-	return ""
+	return false
 }
 
-func (x *MovieItem) GetBudget() int64 {
-	if x != nil && x.Budget != nil {
-		return *x.Budget
+// Messages for BatchGetRatings
+type BatchGetRatingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Titles        []string               `protobuf:"bytes,1,rep,name=titles,proto3" json:"titles,omitempty"`
+	Ids           []string               `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	Window        *string                `protobuf:"bytes,3,opt,name=window,proto3,oneof" json:"window,omitempty"` // 7d, 30d, 365d or all (default)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetRatingsRequest) Reset() {
+	*x = BatchGetRatingsRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetRatingsRequest) ProtoMessage() {}
+
+func (x *BatchGetRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *MovieItem) GetMpaRating() string {
-	if x != nil && x.MpaRating != nil {
-		return *x.MpaRating
+// Deprecated: Use BatchGetRatingsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BatchGetRatingsRequest) GetTitles() []string {
+	if x != nil {
+		return x.Titles
+	}
+	return nil
+}
+
+func (x *BatchGetRatingsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchGetRatingsRequest) GetWindow() string {
+	if x != nil && x.Window != nil {
+		return *x.Window
 	}
 	return ""
 }
 
-func (x *MovieItem) GetBoxOffice() *BoxOffice {
+type BatchGetRatingsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchRatingResult   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // titles first, then IDs, in request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetRatingsReply) Reset() {
+	*x = BatchGetRatingsReply{}
+	mi := &file_movie_v1_movie_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetRatingsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetRatingsReply) ProtoMessage() {}
+
+func (x *BatchGetRatingsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[39]
 	if x != nil {
-		return x.BoxOffice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetRatingsReply.ProtoReflect.Descriptor instead.
+func (*BatchGetRatingsReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BatchGetRatingsReply) GetResults() []*BatchRatingResult {
+	if x != nil {
+		return x.Results
 	}
 	return nil
 }
 
-// Messages for SubmitRating
-type SubmitRatingRequest struct {
+type BatchRatingResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`     // from path
-	Rating        float64                `protobuf:"fixed64,2,opt,name=rating,proto3" json:"rating,omitempty"` // from body
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`         // the requested title, for a lookup by title
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`               // the requested ID, for a lookup by ID
+	Rating        *GetRatingReply        `protobuf:"bytes,3,opt,name=rating,proto3,oneof" json:"rating,omitempty"` // unset when the lookup failed
+	Error         *LookupError           `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitRatingRequest) Reset() {
-	*x = SubmitRatingRequest{}
-	mi := &file_movie_v1_movie_proto_msgTypes[7]
+func (x *BatchRatingResult) Reset() {
+	*x = BatchRatingResult{}
+	mi := &file_movie_v1_movie_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitRatingRequest) String() string {
+func (x *BatchRatingResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitRatingRequest) ProtoMessage() {}
+func (*BatchRatingResult) ProtoMessage() {}
 
-func (x *SubmitRatingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_v1_movie_proto_msgTypes[7]
+func (x *BatchRatingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -615,49 +3074,67 @@ func (x *SubmitRatingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitRatingRequest.ProtoReflect.Descriptor instead.
-func (*SubmitRatingRequest) Descriptor() ([]byte, []int) {
-	return file_movie_v1_movie_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BatchRatingResult.ProtoReflect.Descriptor instead.
+func (*BatchRatingResult) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *SubmitRatingRequest) GetTitle() string {
+func (x *BatchRatingResult) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *SubmitRatingRequest) GetRating() float64 {
+func (x *BatchRatingResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchRatingResult) GetRating() *GetRatingReply {
 	if x != nil {
 		return x.Rating
 	}
-	return 0
+	return nil
 }
 
-type SubmitRatingReply struct {
+func (x *BatchRatingResult) GetError() *LookupError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// Messages for GetRatingHistory
+type GetRatingHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MovieTitle    string                 `protobuf:"bytes,1,opt,name=movie_title,json=movieTitle,proto3" json:"movie_title,omitempty"`
-	RaterId       string                 `protobuf:"bytes,2,opt,name=rater_id,json=raterId,proto3" json:"rater_id,omitempty"`
-	Rating        float64                `protobuf:"fixed64,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"` // from path
+	RaterId       *string                `protobuf:"bytes,2,opt,name=rater_id,json=raterId,proto3,oneof" json:"rater_id,omitempty"`
+	Since         *string                `protobuf:"bytes,3,opt,name=since,proto3,oneof" json:"since,omitempty"` // RFC 3339 timestamp, inclusive
+	Until         *string                `protobuf:"bytes,4,opt,name=until,proto3,oneof" json:"until,omitempty"` // RFC 3339 timestamp, exclusive
+	Limit         *int32                 `protobuf:"varint,5,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,6,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitRatingReply) Reset() {
-	*x = SubmitRatingReply{}
-	mi := &file_movie_v1_movie_proto_msgTypes[8]
+func (x *GetRatingHistoryRequest) Reset() {
+	*x = GetRatingHistoryRequest{}
+	mi := &file_movie_v1_movie_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitRatingReply) String() string {
+func (x *GetRatingHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitRatingReply) ProtoMessage() {}
+func (*GetRatingHistoryRequest) ProtoMessage() {}
 
-func (x *SubmitRatingReply) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_v1_movie_proto_msgTypes[8]
+func (x *GetRatingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,55 +3145,76 @@ func (x *SubmitRatingReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitRatingReply.ProtoReflect.Descriptor instead.
-func (*SubmitRatingReply) Descriptor() ([]byte, []int) {
-	return file_movie_v1_movie_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetRatingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetRatingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *SubmitRatingReply) GetMovieTitle() string {
+func (x *GetRatingHistoryRequest) GetTitle() string {
 	if x != nil {
-		return x.MovieTitle
+		return x.Title
 	}
 	return ""
 }
 
-func (x *SubmitRatingReply) GetRaterId() string {
-	if x != nil {
-		return x.RaterId
+func (x *GetRatingHistoryRequest) GetRaterId() string {
+	if x != nil && x.RaterId != nil {
+		return *x.RaterId
 	}
 	return ""
 }
 
-func (x *SubmitRatingReply) GetRating() float64 {
-	if x != nil {
-		return x.Rating
+func (x *GetRatingHistoryRequest) GetSince() string {
+	if x != nil && x.Since != nil {
+		return *x.Since
+	}
+	return ""
+}
+
+func (x *GetRatingHistoryRequest) GetUntil() string {
+	if x != nil && x.Until != nil {
+		return *x.Until
+	}
+	return ""
+}
+
+func (x *GetRatingHistoryRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
 	}
 	return 0
 }
 
-// Messages for GetRating
-type GetRatingRequest struct {
+func (x *GetRatingHistoryRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type GetRatingHistoryReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Items         []*RatingEvent         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRatingRequest) Reset() {
-	*x = GetRatingRequest{}
-	mi := &file_movie_v1_movie_proto_msgTypes[9]
+func (x *GetRatingHistoryReply) Reset() {
+	*x = GetRatingHistoryReply{}
+	mi := &file_movie_v1_movie_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRatingRequest) String() string {
+func (x *GetRatingHistoryReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRatingRequest) ProtoMessage() {}
+func (*GetRatingHistoryReply) ProtoMessage() {}
 
-func (x *GetRatingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_v1_movie_proto_msgTypes[9]
+func (x *GetRatingHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,41 +3225,55 @@ func (x *GetRatingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRatingRequest.ProtoReflect.Descriptor instead.
-func (*GetRatingRequest) Descriptor() ([]byte, []int) {
-	return file_movie_v1_movie_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetRatingHistoryReply.ProtoReflect.Descriptor instead.
+func (*GetRatingHistoryReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetRatingRequest) GetTitle() string {
+func (x *GetRatingHistoryReply) GetItems() []*RatingEvent {
 	if x != nil {
-		return x.Title
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetRatingHistoryReply) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
 	}
 	return ""
 }
 
-type GetRatingReply struct {
+type RatingEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Average       float64                `protobuf:"fixed64,1,opt,name=average,proto3" json:"average,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MovieTitle    string                 `protobuf:"bytes,2,opt,name=movie_title,json=movieTitle,proto3" json:"movie_title,omitempty"`
+	RaterId       string                 `protobuf:"bytes,3,opt,name=rater_id,json=raterId,proto3" json:"rater_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // created or updated
+	OldRating     *float64               `protobuf:"fixed64,5,opt,name=old_rating,json=oldRating,proto3,oneof" json:"old_rating,omitempty"`
+	NewRating     float64                `protobuf:"fixed64,6,opt,name=new_rating,json=newRating,proto3" json:"new_rating,omitempty"`
+	ClientIp      *string                `protobuf:"bytes,7,opt,name=client_ip,json=clientIp,proto3,oneof" json:"client_ip,omitempty"`
+	UserAgent     *string                `protobuf:"bytes,8,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRatingReply) Reset() {
-	*x = GetRatingReply{}
-	mi := &file_movie_v1_movie_proto_msgTypes[10]
+func (x *RatingEvent) Reset() {
+	*x = RatingEvent{}
+	mi := &file_movie_v1_movie_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRatingReply) String() string {
+func (x *RatingEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRatingReply) ProtoMessage() {}
+func (*RatingEvent) ProtoMessage() {}
 
-func (x *GetRatingReply) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_v1_movie_proto_msgTypes[10]
+func (x *RatingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_movie_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -772,25 +3284,74 @@ func (x *GetRatingReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRatingReply.ProtoReflect.Descriptor instead.
-func (*GetRatingReply) Descriptor() ([]byte, []int) {
-	return file_movie_v1_movie_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use RatingEvent.ProtoReflect.Descriptor instead.
+func (*RatingEvent) Descriptor() ([]byte, []int) {
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetRatingReply) GetAverage() float64 {
+func (x *RatingEvent) GetId() int64 {
 	if x != nil {
-		return x.Average
+		return x.Id
 	}
 	return 0
 }
 
-func (x *GetRatingReply) GetCount() int32 {
+func (x *RatingEvent) GetMovieTitle() string {
 	if x != nil {
-		return x.Count
+		return x.MovieTitle
+	}
+	return ""
+}
+
+func (x *RatingEvent) GetRaterId() string {
+	if x != nil {
+		return x.RaterId
+	}
+	return ""
+}
+
+func (x *RatingEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *RatingEvent) GetOldRating() float64 {
+	if x != nil && x.OldRating != nil {
+		return *x.OldRating
+	}
+	return 0
+}
+
+func (x *RatingEvent) GetNewRating() float64 {
+	if x != nil {
+		return x.NewRating
 	}
 	return 0
 }
 
+func (x *RatingEvent) GetClientIp() string {
+	if x != nil && x.ClientIp != nil {
+		return *x.ClientIp
+	}
+	return ""
+}
+
+func (x *RatingEvent) GetUserAgent() string {
+	if x != nil && x.UserAgent != nil {
+		return *x.UserAgent
+	}
+	return ""
+}
+
+func (x *RatingEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 // Messages for HealthCheck
 type HealthCheckRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -800,7 +3361,7 @@ type HealthCheckRequest struct {
 
 func (x *HealthCheckRequest) Reset() {
 	*x = HealthCheckRequest{}
-	mi := &file_movie_v1_movie_proto_msgTypes[11]
+	mi := &file_movie_v1_movie_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -812,7 +3373,7 @@ func (x *HealthCheckRequest) String() string {
 func (*HealthCheckRequest) ProtoMessage() {}
 
 func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_v1_movie_proto_msgTypes[11]
+	mi := &file_movie_v1_movie_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -825,7 +3386,7 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
 func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_movie_v1_movie_proto_rawDescGZIP(), []int{11}
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{44}
 }
 
 type HealthCheckReply struct {
@@ -837,7 +3398,7 @@ type HealthCheckReply struct {
 
 func (x *HealthCheckReply) Reset() {
 	*x = HealthCheckReply{}
-	mi := &file_movie_v1_movie_proto_msgTypes[12]
+	mi := &file_movie_v1_movie_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -849,7 +3410,7 @@ func (x *HealthCheckReply) String() string {
 func (*HealthCheckReply) ProtoMessage() {}
 
 func (x *HealthCheckReply) ProtoReflect() protoreflect.Message {
-	mi := &file_movie_v1_movie_proto_msgTypes[12]
+	mi := &file_movie_v1_movie_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -862,7 +3423,7 @@ func (x *HealthCheckReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheckReply.ProtoReflect.Descriptor instead.
 func (*HealthCheckReply) Descriptor() ([]byte, []int) {
-	return file_movie_v1_movie_proto_rawDescGZIP(), []int{12}
+	return file_movie_v1_movie_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *HealthCheckReply) GetStatus() string {
@@ -876,18 +3437,27 @@ var File_movie_v1_movie_proto protoreflect.FileDescriptor
 
 const file_movie_v1_movie_proto_rawDesc = "" +
 	"\n" +
-	"\x14movie/v1/movie.proto\x12\fapi.movie.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf5\x01\n" +
-	"\x12CreateMovieRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12\x14\n" +
-	"\x05genre\x18\x02 \x01(\tR\x05genre\x12!\n" +
-	"\frelease_date\x18\x03 \x01(\tR\vreleaseDate\x12%\n" +
-	"\vdistributor\x18\x04 \x01(\tH\x00R\vdistributor\x88\x01\x01\x12\x1b\n" +
-	"\x06budget\x18\x05 \x01(\x03H\x01R\x06budget\x88\x01\x01\x12\"\n" +
+	"\x14movie/v1/movie.proto\x12\fapi.movie.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xdd\x04\n" +
+	"\x12CreateMovieRequest\x12 \n" +
+	"\x05title\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x05title\x12,\n" +
+	"\x05genre\x18\x02 \x01(\tB\x16\xfaB\x13r\x11\x10\x01\x18d2\v[A-Za-z0-9]R\x05genre\x12F\n" +
+	"\frelease_date\x18\x03 \x01(\tB#\xfaB r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$R\vreleaseDate\x12%\n" +
+	"\vdistributor\x18\x04 \x01(\tH\x00R\vdistributor\x88\x01\x01\x12$\n" +
+	"\x06budget\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02(\x00H\x01R\x06budget\x88\x01\x01\x12A\n" +
 	"\n" +
-	"mpa_rating\x18\x06 \x01(\tH\x02R\tmpaRating\x88\x01\x01B\x0e\n" +
+	"mpa_rating\x18\x06 \x01(\tB\x1d\xfaB\x1ar\x18R\x01GR\x02PGR\x05PG-13R\x01RR\x05NC-17H\x02R\tmpaRating\x88\x01\x01\x123\n" +
+	"\acredits\x18\a \x03(\v2\x19.api.movie.v1.CreditInputR\acredits\x12\x16\n" +
+	"\x06genres\x18\b \x03(\tR\x06genres\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12T\n" +
+	"\fexternal_ids\x18\n" +
+	" \x03(\v21.api.movie.v1.CreateMovieRequest.ExternalIdsEntryR\vexternalIds\x1a>\n" +
+	"\x10ExternalIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
 	"\f_distributorB\t\n" +
 	"\a_budgetB\r\n" +
-	"\v_mpa_rating\"\xcf\x02\n" +
+	"\v_mpa_rating\"\xf0\x06\n" +
 	"\x10CreateMovieReply\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12!\n" +
@@ -898,11 +3468,44 @@ const file_movie_v1_movie_proto_rawDesc = "" +
 	"\n" +
 	"mpa_rating\x18\a \x01(\tH\x02R\tmpaRating\x88\x01\x01\x12;\n" +
 	"\n" +
-	"box_office\x18\b \x01(\v2\x17.api.movie.v1.BoxOfficeH\x03R\tboxOffice\x88\x01\x01B\x0e\n" +
+	"box_office\x18\b \x01(\v2\x17.api.movie.v1.BoxOfficeH\x03R\tboxOffice\x88\x01\x01\x12.\n" +
+	"\acredits\x18\t \x03(\v2\x14.api.movie.v1.CreditR\acredits\x12\x16\n" +
+	"\x06genres\x18\n" +
+	" \x03(\tR\x06genres\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12G\n" +
+	"\x10alternate_titles\x18\f \x03(\v2\x1c.api.movie.v1.AlternateTitleR\x0falternateTitles\x12\x16\n" +
+	"\x06locale\x18\r \x01(\tR\x06locale\x12\x1f\n" +
+	"\bsynopsis\x18\x0e \x01(\tH\x04R\bsynopsis\x88\x01\x01\x12\x1d\n" +
+	"\atagline\x18\x0f \x01(\tH\x05R\atagline\x88\x01\x01\x12)\n" +
+	"\x10localized_genres\x18\x10 \x03(\tR\x0flocalizedGenres\x120\n" +
+	"\x06images\x18\x11 \x03(\v2\x18.api.movie.v1.MovieImageR\x06images\x12R\n" +
+	"\fexternal_ids\x18\x12 \x03(\v2/.api.movie.v1.CreateMovieReply.ExternalIdsEntryR\vexternalIds\x12\x18\n" +
+	"\aversion\x18\x13 \x01(\x03R\aversion\x1a>\n" +
+	"\x10ExternalIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
 	"\f_distributorB\t\n" +
 	"\a_budgetB\r\n" +
 	"\v_mpa_ratingB\r\n" +
-	"\v_box_office\"\xaf\x01\n" +
+	"\v_box_officeB\v\n" +
+	"\t_synopsisB\n" +
+	"\n" +
+	"\b_tagline\"\xb9\x01\n" +
+	"\vCreditInput\x12\x1b\n" +
+	"\tperson_id\x18\x01 \x01(\tR\bpersonId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12*\n" +
+	"\x0echaracter_name\x18\x03 \x01(\tH\x00R\rcharacterName\x88\x01\x01\x12(\n" +
+	"\rbilling_order\x18\x04 \x01(\x05H\x01R\fbillingOrder\x88\x01\x01B\x11\n" +
+	"\x0f_character_nameB\x10\n" +
+	"\x0e_billing_order\"\xc8\x01\n" +
+	"\x06Credit\x12\x1b\n" +
+	"\tperson_id\x18\x01 \x01(\tR\bpersonId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12*\n" +
+	"\x0echaracter_name\x18\x04 \x01(\tH\x00R\rcharacterName\x88\x01\x01\x12(\n" +
+	"\rbilling_order\x18\x05 \x01(\x05H\x01R\fbillingOrder\x88\x01\x01B\x11\n" +
+	"\x0f_character_nameB\x10\n" +
+	"\x0e_billing_order\"\xaf\x01\n" +
 	"\tBoxOffice\x12/\n" +
 	"\arevenue\x18\x01 \x01(\v2\x15.api.movie.v1.RevenueR\arevenue\x12\x1a\n" +
 	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x16\n" +
@@ -911,7 +3514,7 @@ const file_movie_v1_movie_proto_rawDesc = "" +
 	"\aRevenue\x12\x1c\n" +
 	"\tworldwide\x18\x01 \x01(\x03R\tworldwide\x123\n" +
 	"\x13opening_weekend_usa\x18\x02 \x01(\x03H\x00R\x11openingWeekendUsa\x88\x01\x01B\x16\n" +
-	"\x14_opening_weekend_usa\"\xd2\x02\n" +
+	"\x14_opening_weekend_usa\"\xcf\x03\n" +
 	"\x11ListMoviesRequest\x12\x11\n" +
 	"\x01q\x18\x01 \x01(\tH\x00R\x01q\x88\x01\x01\x12\x17\n" +
 	"\x04year\x18\x02 \x01(\x05H\x01R\x04year\x88\x01\x01\x12\x19\n" +
@@ -919,9 +3522,14 @@ const file_movie_v1_movie_proto_rawDesc = "" +
 	"\vdistributor\x18\x04 \x01(\tH\x03R\vdistributor\x88\x01\x01\x12\x1b\n" +
 	"\x06budget\x18\x05 \x01(\x03H\x04R\x06budget\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"mpa_rating\x18\x06 \x01(\tH\x05R\tmpaRating\x88\x01\x01\x12\x19\n" +
-	"\x05limit\x18\a \x01(\x05H\x06R\x05limit\x88\x01\x01\x12\x1b\n" +
-	"\x06cursor\x18\b \x01(\tH\aR\x06cursor\x88\x01\x01B\x04\n" +
+	"mpa_rating\x18\x06 \x01(\tH\x05R\tmpaRating\x88\x01\x01\x12$\n" +
+	"\x05limit\x18\a \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01H\x06R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\b \x01(\tH\aR\x06cursor\x88\x01\x01\x12\x1f\n" +
+	"\bdirector\x18\t \x01(\tH\bR\bdirector\x88\x01\x01\x12\x19\n" +
+	"\x05actor\x18\n" +
+	" \x01(\tH\tR\x05actor\x88\x01\x01\x12\x15\n" +
+	"\x03tag\x18\v \x01(\tH\n" +
+	"R\x03tag\x88\x01\x01B\x04\n" +
 	"\x02_qB\a\n" +
 	"\x05_yearB\b\n" +
 	"\x06_genreB\x0e\n" +
@@ -929,12 +3537,59 @@ const file_movie_v1_movie_proto_rawDesc = "" +
 	"\a_budgetB\r\n" +
 	"\v_mpa_ratingB\b\n" +
 	"\x06_limitB\t\n" +
-	"\a_cursor\"v\n" +
+	"\a_cursorB\v\n" +
+	"\t_directorB\b\n" +
+	"\x06_actorB\x06\n" +
+	"\x04_tag\"v\n" +
 	"\x0fListMoviesReply\x12-\n" +
 	"\x05items\x18\x01 \x03(\v2\x17.api.movie.v1.MovieItemR\x05items\x12$\n" +
 	"\vnext_cursor\x18\x02 \x01(\tH\x00R\n" +
 	"nextCursor\x88\x01\x01B\x0e\n" +
-	"\f_next_cursor\"\xc8\x02\n" +
+	"\f_next_cursor\"A\n" +
+	"\x15BatchGetMoviesRequest\x12\x16\n" +
+	"\x06titles\x18\x01 \x03(\tR\x06titles\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\tR\x03ids\"O\n" +
+	"\x13BatchGetMoviesReply\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.api.movie.v1.BatchMovieResultR\aresults\"\xb6\x01\n" +
+	"\x10BatchMovieResult\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x122\n" +
+	"\x05movie\x18\x03 \x01(\v2\x17.api.movie.v1.MovieItemH\x00R\x05movie\x88\x01\x01\x124\n" +
+	"\x05error\x18\x04 \x01(\v2\x19.api.movie.v1.LookupErrorH\x01R\x05error\x88\x01\x01B\b\n" +
+	"\x06_movieB\b\n" +
+	"\x06_error\"\xd5\x01\n" +
+	"\vLookupError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12C\n" +
+	"\bmetadata\x18\x04 \x03(\v2'.api.movie.v1.LookupError.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xad\x03\n" +
+	"\x13ExportMoviesRequest\x122\n" +
+	"\x06format\x18\x01 \x01(\tB\x1a\xfaB\x17r\x15R\x03csvR\x05jsonlR\aparquetR\x06format\x12\x11\n" +
+	"\x01q\x18\x02 \x01(\tH\x00R\x01q\x88\x01\x01\x12\x17\n" +
+	"\x04year\x18\x03 \x01(\x05H\x01R\x04year\x88\x01\x01\x12\x19\n" +
+	"\x05genre\x18\x04 \x01(\tH\x02R\x05genre\x88\x01\x01\x12%\n" +
+	"\vdistributor\x18\x05 \x01(\tH\x03R\vdistributor\x88\x01\x01\x12\x1b\n" +
+	"\x06budget\x18\x06 \x01(\x03H\x04R\x06budget\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"mpa_rating\x18\a \x01(\tH\x05R\tmpaRating\x88\x01\x01\x12\x1f\n" +
+	"\bdirector\x18\b \x01(\tH\x06R\bdirector\x88\x01\x01\x12\x19\n" +
+	"\x05actor\x18\t \x01(\tH\aR\x05actor\x88\x01\x01\x12\x15\n" +
+	"\x03tag\x18\n" +
+	" \x01(\tH\bR\x03tag\x88\x01\x01B\x04\n" +
+	"\x02_qB\a\n" +
+	"\x05_yearB\b\n" +
+	"\x06_genreB\x0e\n" +
+	"\f_distributorB\t\n" +
+	"\a_budgetB\r\n" +
+	"\v_mpa_ratingB\v\n" +
+	"\t_directorB\b\n" +
+	"\x06_actorB\x06\n" +
+	"\x04_tag\"'\n" +
+	"\x11ExportMoviesChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\xe2\x06\n" +
 	"\tMovieItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12!\n" +
@@ -945,33 +3600,261 @@ const file_movie_v1_movie_proto_rawDesc = "" +
 	"\n" +
 	"mpa_rating\x18\a \x01(\tH\x02R\tmpaRating\x88\x01\x01\x12;\n" +
 	"\n" +
-	"box_office\x18\b \x01(\v2\x17.api.movie.v1.BoxOfficeH\x03R\tboxOffice\x88\x01\x01B\x0e\n" +
+	"box_office\x18\b \x01(\v2\x17.api.movie.v1.BoxOfficeH\x03R\tboxOffice\x88\x01\x01\x12.\n" +
+	"\acredits\x18\t \x03(\v2\x14.api.movie.v1.CreditR\acredits\x12\x16\n" +
+	"\x06genres\x18\n" +
+	" \x03(\tR\x06genres\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12G\n" +
+	"\x10alternate_titles\x18\f \x03(\v2\x1c.api.movie.v1.AlternateTitleR\x0falternateTitles\x12\x16\n" +
+	"\x06locale\x18\r \x01(\tR\x06locale\x12\x1f\n" +
+	"\bsynopsis\x18\x0e \x01(\tH\x04R\bsynopsis\x88\x01\x01\x12\x1d\n" +
+	"\atagline\x18\x0f \x01(\tH\x05R\atagline\x88\x01\x01\x12)\n" +
+	"\x10localized_genres\x18\x10 \x03(\tR\x0flocalizedGenres\x120\n" +
+	"\x06images\x18\x11 \x03(\v2\x18.api.movie.v1.MovieImageR\x06images\x12K\n" +
+	"\fexternal_ids\x18\x12 \x03(\v2(.api.movie.v1.MovieItem.ExternalIdsEntryR\vexternalIds\x12\x18\n" +
+	"\aversion\x18\x13 \x01(\x03R\aversion\x1a>\n" +
+	"\x10ExternalIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
 	"\f_distributorB\t\n" +
 	"\a_budgetB\r\n" +
 	"\v_mpa_ratingB\r\n" +
-	"\v_box_office\"C\n" +
-	"\x13SubmitRatingRequest\x12\x14\n" +
+	"\v_box_officeB\v\n" +
+	"\t_synopsisB\n" +
+	"\n" +
+	"\b_tagline\"\x97\x01\n" +
+	"\x16SetMovieCreditsRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x123\n" +
+	"\acredits\x18\x02 \x03(\v2\x19.api.movie.v1.CreditInputR\acredits\x12&\n" +
+	"\aversion\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"y\n" +
+	"\x15SetMovieGenresRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x16\n" +
+	"\x06genres\x18\x02 \x03(\tR\x06genres\x12&\n" +
+	"\aversion\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"s\n" +
+	"\x13SetMovieTagsRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12&\n" +
+	"\aversion\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"\xa0\x01\n" +
+	"\x0eAlternateTitle\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1f\n" +
+	"\blanguage\x18\x03 \x01(\tH\x00R\blanguage\x88\x01\x01\x12\x1b\n" +
+	"\x06region\x18\x04 \x01(\tH\x01R\x06region\x88\x01\x01\x12\x12\n" +
+	"\x04kind\x18\x05 \x01(\tR\x04kindB\v\n" +
+	"\t_languageB\t\n" +
+	"\a_region\"\x85\x02\n" +
+	"\x18AddAlternateTitleRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12'\n" +
+	"\x0falternate_title\x18\x02 \x01(\tR\x0ealternateTitle\x12\x1f\n" +
+	"\blanguage\x18\x03 \x01(\tH\x00R\blanguage\x88\x01\x01\x12\x1b\n" +
+	"\x06region\x18\x04 \x01(\tH\x01R\x06region\x88\x01\x01\x12\x17\n" +
+	"\x04kind\x18\x05 \x01(\tH\x02R\x04kind\x88\x01\x01\x12&\n" +
+	"\aversion\x18\x06 \x01(\x03B\a\xfaB\x04\"\x02 \x00H\x03R\aversion\x88\x01\x01B\v\n" +
+	"\t_languageB\t\n" +
+	"\a_regionB\a\n" +
+	"\x05_kindB\n" +
+	"\n" +
+	"\b_version\"w\n" +
+	"\x1bRemoveAlternateTitleRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12&\n" +
+	"\aversion\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"\xd7\x01\n" +
+	"\x1aSetMovieTranslationRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12\x1f\n" +
+	"\bsynopsis\x18\x03 \x01(\tH\x00R\bsynopsis\x88\x01\x01\x12\x1d\n" +
+	"\atagline\x18\x04 \x01(\tH\x01R\atagline\x88\x01\x01\x12&\n" +
+	"\aversion\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02 \x00H\x02R\aversion\x88\x01\x01B\v\n" +
+	"\t_synopsisB\n" +
+	"\n" +
+	"\b_taglineB\n" +
+	"\n" +
+	"\b_version\"\xfb\x01\n" +
+	"\x17BulkImportMoviesRequest\x12)\n" +
+	"\x06format\x18\x01 \x01(\tB\x11\xfaB\x0er\fR\x03csvR\x05jsonlR\x06format\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x12*\n" +
+	"\x04mode\x18\x03 \x01(\tB\x16\xfaB\x13r\x11R\x04skipR\x06upsert\xd0\x01\x01R\x04mode\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"box_office\x18\x05 \x01(\bR\tboxOffice\x12.\n" +
+	"\n" +
+	"batch_size\x18\x06 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\x88'(\x01H\x00R\tbatchSize\x88\x01\x01B\r\n" +
+	"\v_batch_size\"\xc9\x01\n" +
+	"\x15BulkImportMoviesReply\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x05R\aupdated\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\x05R\askipped\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x121\n" +
+	"\x04rows\x18\x06 \x03(\v2\x1d.api.movie.v1.ImportRowResultR\x04rows\"\x93\x01\n" +
+	"\x0fImportRowResult\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x19\n" +
+	"\bmovie_id\x18\x04 \x01(\tR\amovieId\x12\x19\n" +
+	"\x05error\x18\x05 \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error\"E\n" +
+	"\x1bGetMovieByExternalIdRequest\x12\x16\n" +
+	"\x06scheme\x18\x01 \x01(\tR\x06scheme\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x8d\x01\n" +
+	"\x19SetMovieExternalIdRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x16\n" +
+	"\x06scheme\x18\x02 \x01(\tR\x06scheme\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12&\n" +
+	"\aversion\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02 \x00H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"\x80\x01\n" +
+	"\x1cDeleteMovieExternalIdRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x16\n" +
+	"\x06scheme\x18\x02 \x01(\tR\x06scheme\x12&\n" +
+	"\aversion\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"\x81\x01\n" +
+	"\x1dDeleteMovieTranslationRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x16\n" +
-	"\x06rating\x18\x02 \x01(\x01R\x06rating\"g\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12&\n" +
+	"\aversion\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00H\x00R\aversion\x88\x01\x01B\n" +
+	"\n" +
+	"\b_version\"\xa6\x02\n" +
+	"\n" +
+	"MovieImage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x05R\x06height\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\a \x01(\x03R\tsizeBytes\x127\n" +
+	"\n" +
+	"thumbnails\x18\b \x03(\v2\x17.api.movie.v1.ThumbnailR\n" +
+	"thumbnails\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"K\n" +
+	"\tThumbnail\x12\x14\n" +
+	"\x05width\x18\x01 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x05R\x06height\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\"Q\n" +
+	"\x17UploadMovieImageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"D\n" +
+	"\x17DeleteMovieImageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bimage_id\x18\x02 \x01(\tR\aimageId\"\x17\n" +
+	"\x15DeleteMovieImageReply\"\x80\x02\n" +
+	"\x13SubmitRatingRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12w\n" +
+	"\x06rating\x18\x02 \x01(\x01B_\xfaB\\\x12Z1\x00\x00\x00\x00\x00\x00\xe0?1\x00\x00\x00\x00\x00\x00\xf0?1\x00\x00\x00\x00\x00\x00\xf8?1\x00\x00\x00\x00\x00\x00\x00@1\x00\x00\x00\x00\x00\x00\x04@1\x00\x00\x00\x00\x00\x00\b@1\x00\x00\x00\x00\x00\x00\f@1\x00\x00\x00\x00\x00\x00\x10@1\x00\x00\x00\x00\x00\x00\x12@1\x00\x00\x00\x00\x00\x00\x14@R\x06rating\x12\x1b\n" +
+	"\x06review\x18\x03 \x01(\tH\x00R\x06review\x88\x01\x01\x12&\n" +
+	"\aversion\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02 \x00H\x01R\aversion\x88\x01\x01B\t\n" +
+	"\a_reviewB\n" +
+	"\n" +
+	"\b_version\"\xe6\x01\n" +
 	"\x11SubmitRatingReply\x12\x1f\n" +
 	"\vmovie_title\x18\x01 \x01(\tR\n" +
 	"movieTitle\x12\x19\n" +
 	"\brater_id\x18\x02 \x01(\tR\araterId\x12\x16\n" +
-	"\x06rating\x18\x03 \x01(\x01R\x06rating\"(\n" +
+	"\x06rating\x18\x03 \x01(\x01R\x06rating\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\x03R\x02id\x12\x1b\n" +
+	"\x06review\x18\x05 \x01(\tH\x00R\x06review\x88\x01\x01\x12+\n" +
+	"\x11moderation_status\x18\x06 \x01(\tR\x10moderationStatus\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversionB\t\n" +
+	"\a_review\"n\n" +
 	"\x10GetRatingRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\"@\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x129\n" +
+	"\x06window\x18\x02 \x01(\tB\x1c\xfaB\x19r\x17R\x027dR\x0330dR\x04365dR\x03all\xd0\x01\x01H\x00R\x06window\x88\x01\x01B\t\n" +
+	"\a_window\"c\n" +
 	"\x0eGetRatingReply\x12\x18\n" +
 	"\aaverage\x18\x01 \x01(\x01R\aaverage\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count\"\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12!\n" +
+	"\funder_review\x18\x03 \x01(\bR\vunderReview\"\x88\x01\n" +
+	"\x16BatchGetRatingsRequest\x12\x16\n" +
+	"\x06titles\x18\x01 \x03(\tR\x06titles\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\tR\x03ids\x129\n" +
+	"\x06window\x18\x03 \x01(\tB\x1c\xfaB\x19r\x17R\x027dR\x0330dR\x04365dR\x03all\xd0\x01\x01H\x00R\x06window\x88\x01\x01B\t\n" +
+	"\a_window\"Q\n" +
+	"\x14BatchGetRatingsReply\x129\n" +
+	"\aresults\x18\x01 \x03(\v2\x1f.api.movie.v1.BatchRatingResultR\aresults\"\xbf\x01\n" +
+	"\x11BatchRatingResult\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x129\n" +
+	"\x06rating\x18\x03 \x01(\v2\x1c.api.movie.v1.GetRatingReplyH\x00R\x06rating\x88\x01\x01\x124\n" +
+	"\x05error\x18\x04 \x01(\v2\x19.api.movie.v1.LookupErrorH\x01R\x05error\x88\x01\x01B\t\n" +
+	"\a_ratingB\b\n" +
+	"\x06_error\"\xfe\x01\n" +
+	"\x17GetRatingHistoryRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1e\n" +
+	"\brater_id\x18\x02 \x01(\tH\x00R\araterId\x88\x01\x01\x12\x19\n" +
+	"\x05since\x18\x03 \x01(\tH\x01R\x05since\x88\x01\x01\x12\x19\n" +
+	"\x05until\x18\x04 \x01(\tH\x02R\x05until\x88\x01\x01\x12$\n" +
+	"\x05limit\x18\x05 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01H\x03R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x06 \x01(\tH\x04R\x06cursor\x88\x01\x01B\v\n" +
+	"\t_rater_idB\b\n" +
+	"\x06_sinceB\b\n" +
+	"\x06_untilB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursor\"~\n" +
+	"\x15GetRatingHistoryReply\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.api.movie.v1.RatingEventR\x05items\x12$\n" +
+	"\vnext_cursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\"\xe8\x02\n" +
+	"\vRatingEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vmovie_title\x18\x02 \x01(\tR\n" +
+	"movieTitle\x12\x19\n" +
+	"\brater_id\x18\x03 \x01(\tR\araterId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\"\n" +
+	"\n" +
+	"old_rating\x18\x05 \x01(\x01H\x00R\toldRating\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"new_rating\x18\x06 \x01(\x01R\tnewRating\x12 \n" +
+	"\tclient_ip\x18\a \x01(\tH\x01R\bclientIp\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"user_agent\x18\b \x01(\tH\x02R\tuserAgent\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\r\n" +
+	"\v_old_ratingB\f\n" +
+	"\n" +
+	"_client_ipB\r\n" +
+	"\v_user_agent\"\x14\n" +
 	"\x12HealthCheckRequest\"*\n" +
 	"\x10HealthCheckReply\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status2\x98\x04\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status2\xf1\x14\n" +
 	"\fMovieService\x12c\n" +
-	"\vCreateMovie\x12 .api.movie.v1.CreateMovieRequest\x1a\x1e.api.movie.v1.CreateMovieReply\"\x12\x82\xd3\xe4\x93\x02\f:\x01*\"\a/movies\x12]\n" +
+	"\vCreateMovie\x12 .api.movie.v1.CreateMovieRequest\x1a\x1e.api.movie.v1.CreateMovieReply\"\x12\x82\xd3\xe4\x93\x02\f:\x01*\"\a/movies\x12y\n" +
+	"\x10BulkImportMovies\x12%.api.movie.v1.BulkImportMoviesRequest\x1a#.api.movie.v1.BulkImportMoviesReply\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/movies/import\x12]\n" +
 	"\n" +
 	"ListMovies\x12\x1f.api.movie.v1.ListMoviesRequest\x1a\x1d.api.movie.v1.ListMoviesReply\"\x0f\x82\xd3\xe4\x93\x02\t\x12\a/movies\x12v\n" +
+	"\x0eBatchGetMovies\x12#.api.movie.v1.BatchGetMoviesRequest\x1a!.api.movie.v1.BatchGetMoviesReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/movies/batch-get\x12T\n" +
+	"\fExportMovies\x12!.api.movie.v1.ExportMoviesRequest\x1a\x1f.api.movie.v1.ExportMoviesChunk0\x01\x12\x85\x01\n" +
+	"\x14GetMovieByExternalId\x12).api.movie.v1.GetMovieByExternalIdRequest\x1a\x17.api.movie.v1.MovieItem\")\x82\xd3\xe4\x93\x02#\x12!/movies/by-external/{scheme}/{id}\x12\x88\x01\n" +
+	"\x12SetMovieExternalId\x12'.api.movie.v1.SetMovieExternalIdRequest\x1a\x17.api.movie.v1.MovieItem\"0\x82\xd3\xe4\x93\x02*:\x01*\x1a%/movies/{title}/external-ids/{scheme}\x12\x8b\x01\n" +
+	"\x15DeleteMovieExternalId\x12*.api.movie.v1.DeleteMovieExternalIdRequest\x1a\x17.api.movie.v1.MovieItem\"-\x82\xd3\xe4\x93\x02'*%/movies/{title}/external-ids/{scheme}\x12t\n" +
+	"\x0fSetMovieCredits\x12$.api.movie.v1.SetMovieCreditsRequest\x1a\x17.api.movie.v1.MovieItem\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/movies/{title}/credits\x12q\n" +
+	"\x0eSetMovieGenres\x12#.api.movie.v1.SetMovieGenresRequest\x1a\x17.api.movie.v1.MovieItem\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/movies/{title}/genres\x12k\n" +
+	"\fSetMovieTags\x12!.api.movie.v1.SetMovieTagsRequest\x1a\x17.api.movie.v1.MovieItem\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/movies/{title}/tags\x12\x81\x01\n" +
+	"\x11AddAlternateTitle\x12&.api.movie.v1.AddAlternateTitleRequest\x1a\x17.api.movie.v1.MovieItem\"+\x82\xd3\xe4\x93\x02%:\x01*\" /movies/{title}/alternate-titles\x12\x89\x01\n" +
+	"\x14RemoveAlternateTitle\x12).api.movie.v1.RemoveAlternateTitleRequest\x1a\x17.api.movie.v1.MovieItem\"-\x82\xd3\xe4\x93\x02'*%/movies/{title}/alternate-titles/{id}\x12\x8a\x01\n" +
+	"\x13SetMovieTranslation\x12(.api.movie.v1.SetMovieTranslationRequest\x1a\x17.api.movie.v1.MovieItem\"0\x82\xd3\xe4\x93\x02*:\x01*\x1a%/movies/{title}/translations/{locale}\x12\x8d\x01\n" +
+	"\x16DeleteMovieTranslation\x12+.api.movie.v1.DeleteMovieTranslationRequest\x1a\x17.api.movie.v1.MovieItem\"-\x82\xd3\xe4\x93\x02'*%/movies/{title}/translations/{locale}\x12S\n" +
+	"\x10UploadMovieImage\x12%.api.movie.v1.UploadMovieImageRequest\x1a\x18.api.movie.v1.MovieImage\x12\x86\x01\n" +
+	"\x10DeleteMovieImage\x12%.api.movie.v1.DeleteMovieImageRequest\x1a#.api.movie.v1.DeleteMovieImageReply\"&\x82\xd3\xe4\x93\x02 *\x1e/movies/{id}/images/{image_id}\x12v\n" +
 	"\fSubmitRating\x12!.api.movie.v1.SubmitRatingRequest\x1a\x1f.api.movie.v1.SubmitRatingReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/movies/{title}/ratings\x12i\n" +
-	"\tGetRating\x12\x1e.api.movie.v1.GetRatingRequest\x1a\x1c.api.movie.v1.GetRatingReply\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/movies/{title}/rating\x12a\n" +
+	"\tGetRating\x12\x1e.api.movie.v1.GetRatingRequest\x1a\x1c.api.movie.v1.GetRatingReply\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/movies/{title}/rating\x12\x81\x01\n" +
+	"\x0fBatchGetRatings\x12$.api.movie.v1.BatchGetRatingsRequest\x1a\".api.movie.v1.BatchGetRatingsReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/movies/ratings/batch-get\x12\x87\x01\n" +
+	"\x10GetRatingHistory\x12%.api.movie.v1.GetRatingHistoryRequest\x1a#.api.movie.v1.GetRatingHistoryReply\"'\x82\xd3\xe4\x93\x02!\x12\x1f/movies/{title}/ratings/history\x12a\n" +
 	"\vHealthCheck\x12 .api.movie.v1.HealthCheckRequest\x1a\x1e.api.movie.v1.HealthCheckReply\"\x10\x82\xd3\xe4\x93\x02\n" +
 	"\x12\b/healthzB\x1cZ\x1aRobin-Camp/api/movie/v1;v1b\x06proto3"
 
@@ -987,44 +3870,138 @@ func file_movie_v1_movie_proto_rawDescGZIP() []byte {
 	return file_movie_v1_movie_proto_rawDescData
 }
 
-var file_movie_v1_movie_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_movie_v1_movie_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_movie_v1_movie_proto_goTypes = []any{
-	(*CreateMovieRequest)(nil),    // 0: api.movie.v1.CreateMovieRequest
-	(*CreateMovieReply)(nil),      // 1: api.movie.v1.CreateMovieReply
-	(*BoxOffice)(nil),             // 2: api.movie.v1.BoxOffice
-	(*Revenue)(nil),               // 3: api.movie.v1.Revenue
-	(*ListMoviesRequest)(nil),     // 4: api.movie.v1.ListMoviesRequest
-	(*ListMoviesReply)(nil),       // 5: api.movie.v1.ListMoviesReply
-	(*MovieItem)(nil),             // 6: api.movie.v1.MovieItem
-	(*SubmitRatingRequest)(nil),   // 7: api.movie.v1.SubmitRatingRequest
-	(*SubmitRatingReply)(nil),     // 8: api.movie.v1.SubmitRatingReply
-	(*GetRatingRequest)(nil),      // 9: api.movie.v1.GetRatingRequest
-	(*GetRatingReply)(nil),        // 10: api.movie.v1.GetRatingReply
-	(*HealthCheckRequest)(nil),    // 11: api.movie.v1.HealthCheckRequest
-	(*HealthCheckReply)(nil),      // 12: api.movie.v1.HealthCheckReply
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
+	(*CreateMovieRequest)(nil),            // 0: api.movie.v1.CreateMovieRequest
+	(*CreateMovieReply)(nil),              // 1: api.movie.v1.CreateMovieReply
+	(*CreditInput)(nil),                   // 2: api.movie.v1.CreditInput
+	(*Credit)(nil),                        // 3: api.movie.v1.Credit
+	(*BoxOffice)(nil),                     // 4: api.movie.v1.BoxOffice
+	(*Revenue)(nil),                       // 5: api.movie.v1.Revenue
+	(*ListMoviesRequest)(nil),             // 6: api.movie.v1.ListMoviesRequest
+	(*ListMoviesReply)(nil),               // 7: api.movie.v1.ListMoviesReply
+	(*BatchGetMoviesRequest)(nil),         // 8: api.movie.v1.BatchGetMoviesRequest
+	(*BatchGetMoviesReply)(nil),           // 9: api.movie.v1.BatchGetMoviesReply
+	(*BatchMovieResult)(nil),              // 10: api.movie.v1.BatchMovieResult
+	(*LookupError)(nil),                   // 11: api.movie.v1.LookupError
+	(*ExportMoviesRequest)(nil),           // 12: api.movie.v1.ExportMoviesRequest
+	(*ExportMoviesChunk)(nil),             // 13: api.movie.v1.ExportMoviesChunk
+	(*MovieItem)(nil),                     // 14: api.movie.v1.MovieItem
+	(*SetMovieCreditsRequest)(nil),        // 15: api.movie.v1.SetMovieCreditsRequest
+	(*SetMovieGenresRequest)(nil),         // 16: api.movie.v1.SetMovieGenresRequest
+	(*SetMovieTagsRequest)(nil),           // 17: api.movie.v1.SetMovieTagsRequest
+	(*AlternateTitle)(nil),                // 18: api.movie.v1.AlternateTitle
+	(*AddAlternateTitleRequest)(nil),      // 19: api.movie.v1.AddAlternateTitleRequest
+	(*RemoveAlternateTitleRequest)(nil),   // 20: api.movie.v1.RemoveAlternateTitleRequest
+	(*SetMovieTranslationRequest)(nil),    // 21: api.movie.v1.SetMovieTranslationRequest
+	(*BulkImportMoviesRequest)(nil),       // 22: api.movie.v1.BulkImportMoviesRequest
+	(*BulkImportMoviesReply)(nil),         // 23: api.movie.v1.BulkImportMoviesReply
+	(*ImportRowResult)(nil),               // 24: api.movie.v1.ImportRowResult
+	(*GetMovieByExternalIdRequest)(nil),   // 25: api.movie.v1.GetMovieByExternalIdRequest
+	(*SetMovieExternalIdRequest)(nil),     // 26: api.movie.v1.SetMovieExternalIdRequest
+	(*DeleteMovieExternalIdRequest)(nil),  // 27: api.movie.v1.DeleteMovieExternalIdRequest
+	(*DeleteMovieTranslationRequest)(nil), // 28: api.movie.v1.DeleteMovieTranslationRequest
+	(*MovieImage)(nil),                    // 29: api.movie.v1.MovieImage
+	(*Thumbnail)(nil),                     // 30: api.movie.v1.Thumbnail
+	(*UploadMovieImageRequest)(nil),       // 31: api.movie.v1.UploadMovieImageRequest
+	(*DeleteMovieImageRequest)(nil),       // 32: api.movie.v1.DeleteMovieImageRequest
+	(*DeleteMovieImageReply)(nil),         // 33: api.movie.v1.DeleteMovieImageReply
+	(*SubmitRatingRequest)(nil),           // 34: api.movie.v1.SubmitRatingRequest
+	(*SubmitRatingReply)(nil),             // 35: api.movie.v1.SubmitRatingReply
+	(*GetRatingRequest)(nil),              // 36: api.movie.v1.GetRatingRequest
+	(*GetRatingReply)(nil),                // 37: api.movie.v1.GetRatingReply
+	(*BatchGetRatingsRequest)(nil),        // 38: api.movie.v1.BatchGetRatingsRequest
+	(*BatchGetRatingsReply)(nil),          // 39: api.movie.v1.BatchGetRatingsReply
+	(*BatchRatingResult)(nil),             // 40: api.movie.v1.BatchRatingResult
+	(*GetRatingHistoryRequest)(nil),       // 41: api.movie.v1.GetRatingHistoryRequest
+	(*GetRatingHistoryReply)(nil),         // 42: api.movie.v1.GetRatingHistoryReply
+	(*RatingEvent)(nil),                   // 43: api.movie.v1.RatingEvent
+	(*HealthCheckRequest)(nil),            // 44: api.movie.v1.HealthCheckRequest
+	(*HealthCheckReply)(nil),              // 45: api.movie.v1.HealthCheckReply
+	nil,                                   // 46: api.movie.v1.CreateMovieRequest.ExternalIdsEntry
+	nil,                                   // 47: api.movie.v1.CreateMovieReply.ExternalIdsEntry
+	nil,                                   // 48: api.movie.v1.LookupError.MetadataEntry
+	nil,                                   // 49: api.movie.v1.MovieItem.ExternalIdsEntry
+	(*timestamppb.Timestamp)(nil),         // 50: google.protobuf.Timestamp
 }
 var file_movie_v1_movie_proto_depIdxs = []int32{
-	2,  // 0: api.movie.v1.CreateMovieReply.box_office:type_name -> api.movie.v1.BoxOffice
-	3,  // 1: api.movie.v1.BoxOffice.revenue:type_name -> api.movie.v1.Revenue
-	13, // 2: api.movie.v1.BoxOffice.last_updated:type_name -> google.protobuf.Timestamp
-	6,  // 3: api.movie.v1.ListMoviesReply.items:type_name -> api.movie.v1.MovieItem
-	2,  // 4: api.movie.v1.MovieItem.box_office:type_name -> api.movie.v1.BoxOffice
-	0,  // 5: api.movie.v1.MovieService.CreateMovie:input_type -> api.movie.v1.CreateMovieRequest
-	4,  // 6: api.movie.v1.MovieService.ListMovies:input_type -> api.movie.v1.ListMoviesRequest
-	7,  // 7: api.movie.v1.MovieService.SubmitRating:input_type -> api.movie.v1.SubmitRatingRequest
-	9,  // 8: api.movie.v1.MovieService.GetRating:input_type -> api.movie.v1.GetRatingRequest
-	11, // 9: api.movie.v1.MovieService.HealthCheck:input_type -> api.movie.v1.HealthCheckRequest
-	1,  // 10: api.movie.v1.MovieService.CreateMovie:output_type -> api.movie.v1.CreateMovieReply
-	5,  // 11: api.movie.v1.MovieService.ListMovies:output_type -> api.movie.v1.ListMoviesReply
-	8,  // 12: api.movie.v1.MovieService.SubmitRating:output_type -> api.movie.v1.SubmitRatingReply
-	10, // 13: api.movie.v1.MovieService.GetRating:output_type -> api.movie.v1.GetRatingReply
-	12, // 14: api.movie.v1.MovieService.HealthCheck:output_type -> api.movie.v1.HealthCheckReply
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	2,  // 0: api.movie.v1.CreateMovieRequest.credits:type_name -> api.movie.v1.CreditInput
+	46, // 1: api.movie.v1.CreateMovieRequest.external_ids:type_name -> api.movie.v1.CreateMovieRequest.ExternalIdsEntry
+	4,  // 2: api.movie.v1.CreateMovieReply.box_office:type_name -> api.movie.v1.BoxOffice
+	3,  // 3: api.movie.v1.CreateMovieReply.credits:type_name -> api.movie.v1.Credit
+	18, // 4: api.movie.v1.CreateMovieReply.alternate_titles:type_name -> api.movie.v1.AlternateTitle
+	29, // 5: api.movie.v1.CreateMovieReply.images:type_name -> api.movie.v1.MovieImage
+	47, // 6: api.movie.v1.CreateMovieReply.external_ids:type_name -> api.movie.v1.CreateMovieReply.ExternalIdsEntry
+	5,  // 7: api.movie.v1.BoxOffice.revenue:type_name -> api.movie.v1.Revenue
+	50, // 8: api.movie.v1.BoxOffice.last_updated:type_name -> google.protobuf.Timestamp
+	14, // 9: api.movie.v1.ListMoviesReply.items:type_name -> api.movie.v1.MovieItem
+	10, // 10: api.movie.v1.BatchGetMoviesReply.results:type_name -> api.movie.v1.BatchMovieResult
+	14, // 11: api.movie.v1.BatchMovieResult.movie:type_name -> api.movie.v1.MovieItem
+	11, // 12: api.movie.v1.BatchMovieResult.error:type_name -> api.movie.v1.LookupError
+	48, // 13: api.movie.v1.LookupError.metadata:type_name -> api.movie.v1.LookupError.MetadataEntry
+	4,  // 14: api.movie.v1.MovieItem.box_office:type_name -> api.movie.v1.BoxOffice
+	3,  // 15: api.movie.v1.MovieItem.credits:type_name -> api.movie.v1.Credit
+	18, // 16: api.movie.v1.MovieItem.alternate_titles:type_name -> api.movie.v1.AlternateTitle
+	29, // 17: api.movie.v1.MovieItem.images:type_name -> api.movie.v1.MovieImage
+	49, // 18: api.movie.v1.MovieItem.external_ids:type_name -> api.movie.v1.MovieItem.ExternalIdsEntry
+	2,  // 19: api.movie.v1.SetMovieCreditsRequest.credits:type_name -> api.movie.v1.CreditInput
+	24, // 20: api.movie.v1.BulkImportMoviesReply.rows:type_name -> api.movie.v1.ImportRowResult
+	30, // 21: api.movie.v1.MovieImage.thumbnails:type_name -> api.movie.v1.Thumbnail
+	50, // 22: api.movie.v1.MovieImage.created_at:type_name -> google.protobuf.Timestamp
+	40, // 23: api.movie.v1.BatchGetRatingsReply.results:type_name -> api.movie.v1.BatchRatingResult
+	37, // 24: api.movie.v1.BatchRatingResult.rating:type_name -> api.movie.v1.GetRatingReply
+	11, // 25: api.movie.v1.BatchRatingResult.error:type_name -> api.movie.v1.LookupError
+	43, // 26: api.movie.v1.GetRatingHistoryReply.items:type_name -> api.movie.v1.RatingEvent
+	50, // 27: api.movie.v1.RatingEvent.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 28: api.movie.v1.MovieService.CreateMovie:input_type -> api.movie.v1.CreateMovieRequest
+	22, // 29: api.movie.v1.MovieService.BulkImportMovies:input_type -> api.movie.v1.BulkImportMoviesRequest
+	6,  // 30: api.movie.v1.MovieService.ListMovies:input_type -> api.movie.v1.ListMoviesRequest
+	8,  // 31: api.movie.v1.MovieService.BatchGetMovies:input_type -> api.movie.v1.BatchGetMoviesRequest
+	12, // 32: api.movie.v1.MovieService.ExportMovies:input_type -> api.movie.v1.ExportMoviesRequest
+	25, // 33: api.movie.v1.MovieService.GetMovieByExternalId:input_type -> api.movie.v1.GetMovieByExternalIdRequest
+	26, // 34: api.movie.v1.MovieService.SetMovieExternalId:input_type -> api.movie.v1.SetMovieExternalIdRequest
+	27, // 35: api.movie.v1.MovieService.DeleteMovieExternalId:input_type -> api.movie.v1.DeleteMovieExternalIdRequest
+	15, // 36: api.movie.v1.MovieService.SetMovieCredits:input_type -> api.movie.v1.SetMovieCreditsRequest
+	16, // 37: api.movie.v1.MovieService.SetMovieGenres:input_type -> api.movie.v1.SetMovieGenresRequest
+	17, // 38: api.movie.v1.MovieService.SetMovieTags:input_type -> api.movie.v1.SetMovieTagsRequest
+	19, // 39: api.movie.v1.MovieService.AddAlternateTitle:input_type -> api.movie.v1.AddAlternateTitleRequest
+	20, // 40: api.movie.v1.MovieService.RemoveAlternateTitle:input_type -> api.movie.v1.RemoveAlternateTitleRequest
+	21, // 41: api.movie.v1.MovieService.SetMovieTranslation:input_type -> api.movie.v1.SetMovieTranslationRequest
+	28, // 42: api.movie.v1.MovieService.DeleteMovieTranslation:input_type -> api.movie.v1.DeleteMovieTranslationRequest
+	31, // 43: api.movie.v1.MovieService.UploadMovieImage:input_type -> api.movie.v1.UploadMovieImageRequest
+	32, // 44: api.movie.v1.MovieService.DeleteMovieImage:input_type -> api.movie.v1.DeleteMovieImageRequest
+	34, // 45: api.movie.v1.MovieService.SubmitRating:input_type -> api.movie.v1.SubmitRatingRequest
+	36, // 46: api.movie.v1.MovieService.GetRating:input_type -> api.movie.v1.GetRatingRequest
+	38, // 47: api.movie.v1.MovieService.BatchGetRatings:input_type -> api.movie.v1.BatchGetRatingsRequest
+	41, // 48: api.movie.v1.MovieService.GetRatingHistory:input_type -> api.movie.v1.GetRatingHistoryRequest
+	44, // 49: api.movie.v1.MovieService.HealthCheck:input_type -> api.movie.v1.HealthCheckRequest
+	1,  // 50: api.movie.v1.MovieService.CreateMovie:output_type -> api.movie.v1.CreateMovieReply
+	23, // 51: api.movie.v1.MovieService.BulkImportMovies:output_type -> api.movie.v1.BulkImportMoviesReply
+	7,  // 52: api.movie.v1.MovieService.ListMovies:output_type -> api.movie.v1.ListMoviesReply
+	9,  // 53: api.movie.v1.MovieService.BatchGetMovies:output_type -> api.movie.v1.BatchGetMoviesReply
+	13, // 54: api.movie.v1.MovieService.ExportMovies:output_type -> api.movie.v1.ExportMoviesChunk
+	14, // 55: api.movie.v1.MovieService.GetMovieByExternalId:output_type -> api.movie.v1.MovieItem
+	14, // 56: api.movie.v1.MovieService.SetMovieExternalId:output_type -> api.movie.v1.MovieItem
+	14, // 57: api.movie.v1.MovieService.DeleteMovieExternalId:output_type -> api.movie.v1.MovieItem
+	14, // 58: api.movie.v1.MovieService.SetMovieCredits:output_type -> api.movie.v1.MovieItem
+	14, // 59: api.movie.v1.MovieService.SetMovieGenres:output_type -> api.movie.v1.MovieItem
+	14, // 60: api.movie.v1.MovieService.SetMovieTags:output_type -> api.movie.v1.MovieItem
+	14, // 61: api.movie.v1.MovieService.AddAlternateTitle:output_type -> api.movie.v1.MovieItem
+	14, // 62: api.movie.v1.MovieService.RemoveAlternateTitle:output_type -> api.movie.v1.MovieItem
+	14, // 63: api.movie.v1.MovieService.SetMovieTranslation:output_type -> api.movie.v1.MovieItem
+	14, // 64: api.movie.v1.MovieService.DeleteMovieTranslation:output_type -> api.movie.v1.MovieItem
+	29, // 65: api.movie.v1.MovieService.UploadMovieImage:output_type -> api.movie.v1.MovieImage
+	33, // 66: api.movie.v1.MovieService.DeleteMovieImage:output_type -> api.movie.v1.DeleteMovieImageReply
+	35, // 67: api.movie.v1.MovieService.SubmitRating:output_type -> api.movie.v1.SubmitRatingReply
+	37, // 68: api.movie.v1.MovieService.GetRating:output_type -> api.movie.v1.GetRatingReply
+	39, // 69: api.movie.v1.MovieService.BatchGetRatings:output_type -> api.movie.v1.BatchGetRatingsReply
+	42, // 70: api.movie.v1.MovieService.GetRatingHistory:output_type -> api.movie.v1.GetRatingHistoryReply
+	45, // 71: api.movie.v1.MovieService.HealthCheck:output_type -> api.movie.v1.HealthCheckReply
+	50, // [50:72] is the sub-list for method output_type
+	28, // [28:50] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_movie_v1_movie_proto_init() }
@@ -1034,17 +4011,41 @@ func file_movie_v1_movie_proto_init() {
 	}
 	file_movie_v1_movie_proto_msgTypes[0].OneofWrappers = []any{}
 	file_movie_v1_movie_proto_msgTypes[1].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[2].OneofWrappers = []any{}
 	file_movie_v1_movie_proto_msgTypes[3].OneofWrappers = []any{}
-	file_movie_v1_movie_proto_msgTypes[4].OneofWrappers = []any{}
 	file_movie_v1_movie_proto_msgTypes[5].OneofWrappers = []any{}
 	file_movie_v1_movie_proto_msgTypes[6].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[7].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[10].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[12].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[14].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[15].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[16].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[17].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[18].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[19].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[20].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[21].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[22].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[24].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[26].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[27].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[28].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[34].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[35].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[36].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[38].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[40].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[41].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[42].OneofWrappers = []any{}
+	file_movie_v1_movie_proto_msgTypes[43].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_movie_v1_movie_proto_rawDesc), len(file_movie_v1_movie_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,6299 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: movie/v1/movie.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreateMovieRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMovieRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMovieRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMovieRequestMultiError, or nil if none found.
+func (m *CreateMovieRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMovieRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 255 {
+		err := CreateMovieRequestValidationError{
+			field:  "Title",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetGenre()); l < 1 || l > 100 {
+		err := CreateMovieRequestValidationError{
+			field:  "Genre",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateMovieRequest_Genre_Pattern.MatchString(m.GetGenre()) {
+		err := CreateMovieRequestValidationError{
+			field:  "Genre",
+			reason: "value does not match regex pattern \"[A-Za-z0-9]\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateMovieRequest_ReleaseDate_Pattern.MatchString(m.GetReleaseDate()) {
+		err := CreateMovieRequestValidationError{
+			field:  "ReleaseDate",
+			reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetCredits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateMovieRequestValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateMovieRequestValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateMovieRequestValidationError{
+					field:  fmt.Sprintf("Credits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ExternalIds
+
+	if m.Distributor != nil {
+		// no validation rules for Distributor
+	}
+
+	if m.Budget != nil {
+
+		if m.GetBudget() < 0 {
+			err := CreateMovieRequestValidationError{
+				field:  "Budget",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.MpaRating != nil {
+
+		if _, ok := _CreateMovieRequest_MpaRating_InLookup[m.GetMpaRating()]; !ok {
+			err := CreateMovieRequestValidationError{
+				field:  "MpaRating",
+				reason: "value must be in list [G PG PG-13 R NC-17]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateMovieRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMovieRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateMovieRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateMovieRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMovieRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMovieRequestMultiError) AllErrors() []error { return m }
+
+// CreateMovieRequestValidationError is the validation error returned by
+// CreateMovieRequest.Validate if the designated constraints aren't met.
+type CreateMovieRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMovieRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMovieRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMovieRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMovieRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMovieRequestValidationError) ErrorName() string {
+	return "CreateMovieRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMovieRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMovieRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMovieRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMovieRequestValidationError{}
+
+var _CreateMovieRequest_Genre_Pattern = regexp.MustCompile("[A-Za-z0-9]")
+
+var _CreateMovieRequest_ReleaseDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _CreateMovieRequest_MpaRating_InLookup = map[string]struct{}{
+	"G":     {},
+	"PG":    {},
+	"PG-13": {},
+	"R":     {},
+	"NC-17": {},
+}
+
+// Validate checks the field values on CreateMovieReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateMovieReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMovieReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMovieReplyMultiError, or nil if none found.
+func (m *CreateMovieReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMovieReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	// no validation rules for ReleaseDate
+
+	// no validation rules for Genre
+
+	for idx, item := range m.GetCredits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateMovieReplyValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateMovieReplyValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateMovieReplyValidationError{
+					field:  fmt.Sprintf("Credits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAlternateTitles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateMovieReplyValidationError{
+						field:  fmt.Sprintf("AlternateTitles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateMovieReplyValidationError{
+						field:  fmt.Sprintf("AlternateTitles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateMovieReplyValidationError{
+					field:  fmt.Sprintf("AlternateTitles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Locale
+
+	for idx, item := range m.GetImages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateMovieReplyValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateMovieReplyValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateMovieReplyValidationError{
+					field:  fmt.Sprintf("Images[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ExternalIds
+
+	// no validation rules for Version
+
+	if m.Distributor != nil {
+		// no validation rules for Distributor
+	}
+
+	if m.Budget != nil {
+		// no validation rules for Budget
+	}
+
+	if m.MpaRating != nil {
+		// no validation rules for MpaRating
+	}
+
+	if m.BoxOffice != nil {
+
+		if all {
+			switch v := interface{}(m.GetBoxOffice()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateMovieReplyValidationError{
+						field:  "BoxOffice",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateMovieReplyValidationError{
+						field:  "BoxOffice",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBoxOffice()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateMovieReplyValidationError{
+					field:  "BoxOffice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Synopsis != nil {
+		// no validation rules for Synopsis
+	}
+
+	if m.Tagline != nil {
+		// no validation rules for Tagline
+	}
+
+	if len(errors) > 0 {
+		return CreateMovieReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMovieReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateMovieReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateMovieReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMovieReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMovieReplyMultiError) AllErrors() []error { return m }
+
+// CreateMovieReplyValidationError is the validation error returned by
+// CreateMovieReply.Validate if the designated constraints aren't met.
+type CreateMovieReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMovieReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMovieReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMovieReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMovieReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMovieReplyValidationError) ErrorName() string { return "CreateMovieReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateMovieReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMovieReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMovieReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMovieReplyValidationError{}
+
+// Validate checks the field values on CreditInput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreditInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreditInput with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreditInputMultiError, or
+// nil if none found.
+func (m *CreditInput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreditInput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PersonId
+
+	// no validation rules for Role
+
+	if m.CharacterName != nil {
+		// no validation rules for CharacterName
+	}
+
+	if m.BillingOrder != nil {
+		// no validation rules for BillingOrder
+	}
+
+	if len(errors) > 0 {
+		return CreditInputMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreditInputMultiError is an error wrapping multiple validation errors
+// returned by CreditInput.ValidateAll() if the designated constraints aren't met.
+type CreditInputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreditInputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreditInputMultiError) AllErrors() []error { return m }
+
+// CreditInputValidationError is the validation error returned by
+// CreditInput.Validate if the designated constraints aren't met.
+type CreditInputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreditInputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreditInputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreditInputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreditInputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreditInputValidationError) ErrorName() string { return "CreditInputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreditInputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreditInput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreditInputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreditInputValidationError{}
+
+// Validate checks the field values on Credit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Credit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Credit with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CreditMultiError, or nil if none found.
+func (m *Credit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Credit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PersonId
+
+	// no validation rules for Name
+
+	// no validation rules for Role
+
+	if m.CharacterName != nil {
+		// no validation rules for CharacterName
+	}
+
+	if m.BillingOrder != nil {
+		// no validation rules for BillingOrder
+	}
+
+	if len(errors) > 0 {
+		return CreditMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreditMultiError is an error wrapping multiple validation errors returned by
+// Credit.ValidateAll() if the designated constraints aren't met.
+type CreditMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreditMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreditMultiError) AllErrors() []error { return m }
+
+// CreditValidationError is the validation error returned by Credit.Validate if
+// the designated constraints aren't met.
+type CreditValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreditValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreditValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreditValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreditValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreditValidationError) ErrorName() string { return "CreditValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreditValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCredit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreditValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreditValidationError{}
+
+// Validate checks the field values on BoxOffice with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BoxOffice) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BoxOffice with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BoxOfficeMultiError, or nil
+// if none found.
+func (m *BoxOffice) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BoxOffice) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRevenue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BoxOfficeValidationError{
+					field:  "Revenue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BoxOfficeValidationError{
+					field:  "Revenue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevenue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BoxOfficeValidationError{
+				field:  "Revenue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Currency
+
+	// no validation rules for Source
+
+	if all {
+		switch v := interface{}(m.GetLastUpdated()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BoxOfficeValidationError{
+					field:  "LastUpdated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BoxOfficeValidationError{
+					field:  "LastUpdated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUpdated()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BoxOfficeValidationError{
+				field:  "LastUpdated",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BoxOfficeMultiError(errors)
+	}
+
+	return nil
+}
+
+// BoxOfficeMultiError is an error wrapping multiple validation errors returned
+// by BoxOffice.ValidateAll() if the designated constraints aren't met.
+type BoxOfficeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BoxOfficeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BoxOfficeMultiError) AllErrors() []error { return m }
+
+// BoxOfficeValidationError is the validation error returned by
+// BoxOffice.Validate if the designated constraints aren't met.
+type BoxOfficeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BoxOfficeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BoxOfficeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BoxOfficeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BoxOfficeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BoxOfficeValidationError) ErrorName() string { return "BoxOfficeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BoxOfficeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBoxOffice.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BoxOfficeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BoxOfficeValidationError{}
+
+// Validate checks the field values on Revenue with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Revenue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Revenue with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RevenueMultiError, or nil if none found.
+func (m *Revenue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Revenue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Worldwide
+
+	if m.OpeningWeekendUsa != nil {
+		// no validation rules for OpeningWeekendUsa
+	}
+
+	if len(errors) > 0 {
+		return RevenueMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevenueMultiError is an error wrapping multiple validation errors returned
+// by Revenue.ValidateAll() if the designated constraints aren't met.
+type RevenueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevenueMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevenueMultiError) AllErrors() []error { return m }
+
+// RevenueValidationError is the validation error returned by Revenue.Validate
+// if the designated constraints aren't met.
+type RevenueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevenueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevenueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevenueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevenueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevenueValidationError) ErrorName() string { return "RevenueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevenueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevenue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevenueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevenueValidationError{}
+
+// Validate checks the field values on ListMoviesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListMoviesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMoviesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMoviesRequestMultiError, or nil if none found.
+func (m *ListMoviesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMoviesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Q != nil {
+		// no validation rules for Q
+	}
+
+	if m.Year != nil {
+		// no validation rules for Year
+	}
+
+	if m.Genre != nil {
+		// no validation rules for Genre
+	}
+
+	if m.Distributor != nil {
+		// no validation rules for Distributor
+	}
+
+	if m.Budget != nil {
+		// no validation rules for Budget
+	}
+
+	if m.MpaRating != nil {
+		// no validation rules for MpaRating
+	}
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val < 1 || val > 100 {
+			err := ListMoviesRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if m.Director != nil {
+		// no validation rules for Director
+	}
+
+	if m.Actor != nil {
+		// no validation rules for Actor
+	}
+
+	if m.Tag != nil {
+		// no validation rules for Tag
+	}
+
+	if len(errors) > 0 {
+		return ListMoviesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMoviesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListMoviesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListMoviesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMoviesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMoviesRequestMultiError) AllErrors() []error { return m }
+
+// ListMoviesRequestValidationError is the validation error returned by
+// ListMoviesRequest.Validate if the designated constraints aren't met.
+type ListMoviesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMoviesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMoviesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMoviesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMoviesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMoviesRequestValidationError) ErrorName() string {
+	return "ListMoviesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMoviesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMoviesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMoviesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMoviesRequestValidationError{}
+
+// Validate checks the field values on ListMoviesReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListMoviesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMoviesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMoviesReplyMultiError, or nil if none found.
+func (m *ListMoviesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMoviesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMoviesReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMoviesReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMoviesReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.NextCursor != nil {
+		// no validation rules for NextCursor
+	}
+
+	if len(errors) > 0 {
+		return ListMoviesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMoviesReplyMultiError is an error wrapping multiple validation errors
+// returned by ListMoviesReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListMoviesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMoviesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMoviesReplyMultiError) AllErrors() []error { return m }
+
+// ListMoviesReplyValidationError is the validation error returned by
+// ListMoviesReply.Validate if the designated constraints aren't met.
+type ListMoviesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMoviesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMoviesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMoviesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMoviesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMoviesReplyValidationError) ErrorName() string { return "ListMoviesReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListMoviesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMoviesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMoviesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMoviesReplyValidationError{}
+
+// Validate checks the field values on BatchGetMoviesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetMoviesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetMoviesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetMoviesRequestMultiError, or nil if none found.
+func (m *BatchGetMoviesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetMoviesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return BatchGetMoviesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetMoviesRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetMoviesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetMoviesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetMoviesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetMoviesRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetMoviesRequestValidationError is the validation error returned by
+// BatchGetMoviesRequest.Validate if the designated constraints aren't met.
+type BatchGetMoviesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetMoviesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetMoviesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetMoviesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetMoviesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetMoviesRequestValidationError) ErrorName() string {
+	return "BatchGetMoviesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetMoviesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetMoviesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetMoviesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetMoviesRequestValidationError{}
+
+// Validate checks the field values on BatchGetMoviesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetMoviesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetMoviesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetMoviesReplyMultiError, or nil if none found.
+func (m *BatchGetMoviesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetMoviesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetMoviesReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetMoviesReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetMoviesReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetMoviesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetMoviesReplyMultiError is an error wrapping multiple validation
+// errors returned by BatchGetMoviesReply.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetMoviesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetMoviesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetMoviesReplyMultiError) AllErrors() []error { return m }
+
+// BatchGetMoviesReplyValidationError is the validation error returned by
+// BatchGetMoviesReply.Validate if the designated constraints aren't met.
+type BatchGetMoviesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetMoviesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetMoviesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetMoviesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetMoviesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetMoviesReplyValidationError) ErrorName() string {
+	return "BatchGetMoviesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetMoviesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetMoviesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetMoviesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetMoviesReplyValidationError{}
+
+// Validate checks the field values on BatchMovieResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchMovieResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchMovieResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchMovieResultMultiError, or nil if none found.
+func (m *BatchMovieResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchMovieResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Id
+
+	if m.Movie != nil {
+
+		if all {
+			switch v := interface{}(m.GetMovie()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchMovieResultValidationError{
+						field:  "Movie",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchMovieResultValidationError{
+						field:  "Movie",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMovie()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchMovieResultValidationError{
+					field:  "Movie",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Error != nil {
+
+		if all {
+			switch v := interface{}(m.GetError()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchMovieResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchMovieResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchMovieResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchMovieResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchMovieResultMultiError is an error wrapping multiple validation errors
+// returned by BatchMovieResult.ValidateAll() if the designated constraints
+// aren't met.
+type BatchMovieResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchMovieResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchMovieResultMultiError) AllErrors() []error { return m }
+
+// BatchMovieResultValidationError is the validation error returned by
+// BatchMovieResult.Validate if the designated constraints aren't met.
+type BatchMovieResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchMovieResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchMovieResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchMovieResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchMovieResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchMovieResultValidationError) ErrorName() string { return "BatchMovieResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchMovieResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchMovieResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchMovieResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchMovieResultValidationError{}
+
+// Validate checks the field values on LookupError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LookupError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LookupError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LookupErrorMultiError, or
+// nil if none found.
+func (m *LookupError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LookupError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
+
+	// no validation rules for Metadata
+
+	if len(errors) > 0 {
+		return LookupErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// LookupErrorMultiError is an error wrapping multiple validation errors
+// returned by LookupError.ValidateAll() if the designated constraints aren't met.
+type LookupErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LookupErrorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LookupErrorMultiError) AllErrors() []error { return m }
+
+// LookupErrorValidationError is the validation error returned by
+// LookupError.Validate if the designated constraints aren't met.
+type LookupErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LookupErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LookupErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LookupErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LookupErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LookupErrorValidationError) ErrorName() string { return "LookupErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LookupErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLookupError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LookupErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LookupErrorValidationError{}
+
+// Validate checks the field values on ExportMoviesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportMoviesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportMoviesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportMoviesRequestMultiError, or nil if none found.
+func (m *ExportMoviesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportMoviesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ExportMoviesRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := ExportMoviesRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [csv jsonl parquet]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Q != nil {
+		// no validation rules for Q
+	}
+
+	if m.Year != nil {
+		// no validation rules for Year
+	}
+
+	if m.Genre != nil {
+		// no validation rules for Genre
+	}
+
+	if m.Distributor != nil {
+		// no validation rules for Distributor
+	}
+
+	if m.Budget != nil {
+		// no validation rules for Budget
+	}
+
+	if m.MpaRating != nil {
+		// no validation rules for MpaRating
+	}
+
+	if m.Director != nil {
+		// no validation rules for Director
+	}
+
+	if m.Actor != nil {
+		// no validation rules for Actor
+	}
+
+	if m.Tag != nil {
+		// no validation rules for Tag
+	}
+
+	if len(errors) > 0 {
+		return ExportMoviesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportMoviesRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportMoviesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportMoviesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportMoviesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportMoviesRequestMultiError) AllErrors() []error { return m }
+
+// ExportMoviesRequestValidationError is the validation error returned by
+// ExportMoviesRequest.Validate if the designated constraints aren't met.
+type ExportMoviesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportMoviesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportMoviesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportMoviesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportMoviesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportMoviesRequestValidationError) ErrorName() string {
+	return "ExportMoviesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportMoviesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportMoviesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportMoviesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportMoviesRequestValidationError{}
+
+var _ExportMoviesRequest_Format_InLookup = map[string]struct{}{
+	"csv":     {},
+	"jsonl":   {},
+	"parquet": {},
+}
+
+// Validate checks the field values on ExportMoviesChunk with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExportMoviesChunk) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportMoviesChunk with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportMoviesChunkMultiError, or nil if none found.
+func (m *ExportMoviesChunk) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportMoviesChunk) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return ExportMoviesChunkMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportMoviesChunkMultiError is an error wrapping multiple validation errors
+// returned by ExportMoviesChunk.ValidateAll() if the designated constraints
+// aren't met.
+type ExportMoviesChunkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportMoviesChunkMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportMoviesChunkMultiError) AllErrors() []error { return m }
+
+// ExportMoviesChunkValidationError is the validation error returned by
+// ExportMoviesChunk.Validate if the designated constraints aren't met.
+type ExportMoviesChunkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportMoviesChunkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportMoviesChunkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportMoviesChunkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportMoviesChunkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportMoviesChunkValidationError) ErrorName() string {
+	return "ExportMoviesChunkValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportMoviesChunkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportMoviesChunk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportMoviesChunkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportMoviesChunkValidationError{}
+
+// Validate checks the field values on MovieItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MovieItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MovieItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MovieItemMultiError, or nil
+// if none found.
+func (m *MovieItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MovieItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	// no validation rules for ReleaseDate
+
+	// no validation rules for Genre
+
+	for idx, item := range m.GetCredits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MovieItemValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MovieItemValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MovieItemValidationError{
+					field:  fmt.Sprintf("Credits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAlternateTitles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MovieItemValidationError{
+						field:  fmt.Sprintf("AlternateTitles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MovieItemValidationError{
+						field:  fmt.Sprintf("AlternateTitles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MovieItemValidationError{
+					field:  fmt.Sprintf("AlternateTitles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Locale
+
+	for idx, item := range m.GetImages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MovieItemValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MovieItemValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MovieItemValidationError{
+					field:  fmt.Sprintf("Images[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ExternalIds
+
+	// no validation rules for Version
+
+	if m.Distributor != nil {
+		// no validation rules for Distributor
+	}
+
+	if m.Budget != nil {
+		// no validation rules for Budget
+	}
+
+	if m.MpaRating != nil {
+		// no validation rules for MpaRating
+	}
+
+	if m.BoxOffice != nil {
+
+		if all {
+			switch v := interface{}(m.GetBoxOffice()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MovieItemValidationError{
+						field:  "BoxOffice",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MovieItemValidationError{
+						field:  "BoxOffice",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBoxOffice()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MovieItemValidationError{
+					field:  "BoxOffice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Synopsis != nil {
+		// no validation rules for Synopsis
+	}
+
+	if m.Tagline != nil {
+		// no validation rules for Tagline
+	}
+
+	if len(errors) > 0 {
+		return MovieItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// MovieItemMultiError is an error wrapping multiple validation errors returned
+// by MovieItem.ValidateAll() if the designated constraints aren't met.
+type MovieItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MovieItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MovieItemMultiError) AllErrors() []error { return m }
+
+// MovieItemValidationError is the validation error returned by
+// MovieItem.Validate if the designated constraints aren't met.
+type MovieItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MovieItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MovieItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MovieItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MovieItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MovieItemValidationError) ErrorName() string { return "MovieItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MovieItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMovieItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MovieItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MovieItemValidationError{}
+
+// Validate checks the field values on SetMovieCreditsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetMovieCreditsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMovieCreditsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetMovieCreditsRequestMultiError, or nil if none found.
+func (m *SetMovieCreditsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetMovieCreditsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	for idx, item := range m.GetCredits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetMovieCreditsRequestValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetMovieCreditsRequestValidationError{
+						field:  fmt.Sprintf("Credits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetMovieCreditsRequestValidationError{
+					field:  fmt.Sprintf("Credits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Version != nil {
+
+		if m.GetVersion() <= 0 {
+			err := SetMovieCreditsRequestValidationError{
+				field:  "Version",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetMovieCreditsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetMovieCreditsRequestMultiError is an error wrapping multiple validation
+// errors returned by SetMovieCreditsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetMovieCreditsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMovieCreditsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMovieCreditsRequestMultiError) AllErrors() []error { return m }
+
+// SetMovieCreditsRequestValidationError is the validation error returned by
+// SetMovieCreditsRequest.Validate if the designated constraints aren't met.
+type SetMovieCreditsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMovieCreditsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMovieCreditsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMovieCreditsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMovieCreditsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMovieCreditsRequestValidationError) ErrorName() string {
+	return "SetMovieCreditsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetMovieCreditsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMovieCreditsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMovieCreditsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMovieCreditsRequestValidationError{}
+
+// Validate checks the field values on SetMovieGenresRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetMovieGenresRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMovieGenresRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetMovieGenresRequestMultiError, or nil if none found.
+func (m *SetMovieGenresRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetMovieGenresRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	if m.Version != nil {
+
+		if m.GetVersion() <= 0 {
+			err := SetMovieGenresRequestValidationError{
+				field:  "Version",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetMovieGenresRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetMovieGenresRequestMultiError is an error wrapping multiple validation
+// errors returned by SetMovieGenresRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetMovieGenresRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMovieGenresRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMovieGenresRequestMultiError) AllErrors() []error { return m }
+
+// SetMovieGenresRequestValidationError is the validation error returned by
+// SetMovieGenresRequest.Validate if the designated constraints aren't met.
+type SetMovieGenresRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMovieGenresRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMovieGenresRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMovieGenresRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMovieGenresRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMovieGenresRequestValidationError) ErrorName() string {
+	return "SetMovieGenresRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetMovieGenresRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMovieGenresRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMovieGenresRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMovieGenresRequestValidationError{}
+
+// Validate checks the field values on SetMovieTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetMovieTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMovieTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetMovieTagsRequestMultiError, or nil if none found.
+func (m *SetMovieTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetMovieTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	if m.Version != nil {
+
+		if m.GetVersion() <= 0 {
+			err := SetMovieTagsRequestValidationError{
+				field:  "Version",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetMovieTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetMovieTagsRequestMultiError is an error wrapping multiple validation
+// errors returned by SetMovieTagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetMovieTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMovieTagsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMovieTagsRequestMultiError) AllErrors() []error { return m }
+
+// SetMovieTagsRequestValidationError is the validation error returned by
+// SetMovieTagsRequest.Validate if the designated constraints aren't met.
+type SetMovieTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMovieTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMovieTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMovieTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMovieTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMovieTagsRequestValidationError) ErrorName() string {
+	return "SetMovieTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetMovieTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMovieTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMovieTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMovieTagsRequestValidationError{}
+
+// Validate checks the field values on AlternateTitle with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AlternateTitle) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AlternateTitle with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AlternateTitleMultiError,
+// or nil if none found.
+func (m *AlternateTitle) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AlternateTitle) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	// no validation rules for Kind
+
+	if m.Language != nil {
+		// no validation rules for Language
+	}
+
+	if m.Region != nil {
+		// no validation rules for Region
+	}
+
+	if len(errors) > 0 {
+		return AlternateTitleMultiError(errors)
+	}
+
+	return nil
+}
+
+// AlternateTitleMultiError is an error wrapping multiple validation errors
+// returned by AlternateTitle.ValidateAll() if the designated constraints
+// aren't met.
+type AlternateTitleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlternateTitleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlternateTitleMultiError) AllErrors() []error { return m }
+
+// AlternateTitleValidationError is the validation error returned by
+// AlternateTitle.Validate if the designated constraints aren't met.
+type AlternateTitleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlternateTitleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlternateTitleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlternateTitleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlternateTitleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlternateTitleValidationError) ErrorName() string { return "AlternateTitleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlternateTitleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlternateTitle.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlternateTitleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlternateTitleValidationError{}
+
+// Validate checks the field values on AddAlternateTitleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddAlternateTitleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddAlternateTitleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddAlternateTitleRequestMultiError, or nil if none found.
+func (m *AddAlternateTitleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddAlternateTitleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for AlternateTitle
+
+	if m.Language != nil {
+		// no validation rules for Language
+	}
+
+	if m.Region != nil {
+		// no validation rules for Region
+	}
+
+	if m.Kind != nil {
+		// no validation rules for Kind
+	}
+
+	if m.Version != nil {
+
+		if m.GetVersion() <= 0 {
+			err := AddAlternateTitleRequestValidationError{
+				field:  "Version",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddAlternateTitleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddAlternateTitleRequestMultiError is an error wrapping multiple validation
+// errors returned by AddAlternateTitleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddAlternateTitleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddAlternateTitleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddAlternateTitleRequestMultiError) AllErrors() []error { return m }
+
+// AddAlternateTitleRequestValidationError is the validation error returned by
+// AddAlternateTitleRequest.Validate if the designated constraints aren't met.
+type AddAlternateTitleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddAlternateTitleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddAlternateTitleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddAlternateTitleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddAlternateTitleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddAlternateTitleRequestValidationError) ErrorName() string {
+	return "AddAlternateTitleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddAlternateTitleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddAlternateTitleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddAlternateTitleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddAlternateTitleRequestValidationError{}
+
+// Validate checks the field values on RemoveAlternateTitleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveAlternateTitleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveAlternateTitleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveAlternateTitleRequestMultiError, or nil if none found.
+func (m *RemoveAlternateTitleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveAlternateTitleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Id
+
+	if m.Version != nil {
+
+		if m.GetVersion() <= 0 {
+			err := RemoveAlternateTitleRequestValidationError{
+				field:  "Version",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RemoveAlternateTitleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveAlternateTitleRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveAlternateTitleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveAlternateTitleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveAlternateTitleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveAlternateTitleRequestMultiError) AllErrors() []error { return m }
+
+// RemoveAlternateTitleRequestValidationError is the validation error returned
+// by RemoveAlternateTitleRequest.Validate if the designated constraints
+// aren't met.
+type RemoveAlternateTitleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveAlternateTitleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveAlternateTitleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveAlternateTitleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveAlternateTitleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveAlternateTitleRequestValidationError) ErrorName() string {
+	return "RemoveAlternateTitleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveAlternateTitleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveAlternateTitleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveAlternateTitleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveAlternateTitleRequestValidationError{}
+
+// Validate checks the field values on SetMovieTranslationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetMovieTranslationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMovieTranslationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetMovieTranslationRequestMultiError, or nil if none found.
+func (m *SetMovieTranslationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetMovieTranslationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Locale
+
+	if m.Synopsis != nil {
+		// no validation rules for Synopsis
+	}
+
+	if m.Tagline != nil {
+		// no validation rules for Tagline
+	}
+
+	if m.Version != nil {
+
+		if m.GetVersion() <= 0 {
+			err := SetMovieTranslationRequestValidationError{
+				field:  "Version",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetMovieTranslationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetMovieTranslationRequestMultiError is an error wrapping multiple
+// validation errors returned by SetMovieTranslationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetMovieTranslationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMovieTranslationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMovieTranslationRequestMultiError) AllErrors() []error { return m }
+
+// SetMovieTranslationRequestValidationError is the validation error returned
+// by SetMovieTranslationRequest.Validate if the designated constraints aren't met.
+type SetMovieTranslationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMovieTranslationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMovieTranslationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMovieTranslationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMovieTranslationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMovieTranslationRequestValidationError) ErrorName() string {
+	return "SetMovieTranslationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetMovieTranslationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMovieTranslationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMovieTranslationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMovieTranslationRequestValidationError{}
+
+// Validate checks the field values on BulkImportMoviesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkImportMoviesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkImportMoviesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkImportMoviesRequestMultiError, or nil if none found.
+func (m *BulkImportMoviesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkImportMoviesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _BulkImportMoviesRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := BulkImportMoviesRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [csv jsonl]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Data
+
+	if m.GetMode() != "" {
+
+		if _, ok := _BulkImportMoviesRequest_Mode_InLookup[m.GetMode()]; !ok {
+			err := BulkImportMoviesRequestValidationError{
+				field:  "Mode",
+				reason: "value must be in list [skip upsert]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for DryRun
+
+	// no validation rules for BoxOffice
+
+	if m.BatchSize != nil {
+
+		if val := m.GetBatchSize(); val < 1 || val > 5000 {
+			err := BulkImportMoviesRequestValidationError{
+				field:  "BatchSize",
+				reason: "value must be inside range [1, 5000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BulkImportMoviesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkImportMoviesRequestMultiError is an error wrapping multiple validation
+// errors returned by BulkImportMoviesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BulkImportMoviesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkImportMoviesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkImportMoviesRequestMultiError) AllErrors() []error { return m }
+
+// BulkImportMoviesRequestValidationError is the validation error returned by
+// BulkImportMoviesRequest.Validate if the designated constraints aren't met.
+type BulkImportMoviesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkImportMoviesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkImportMoviesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkImportMoviesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkImportMoviesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkImportMoviesRequestValidationError) ErrorName() string {
+	return "BulkImportMoviesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkImportMoviesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkImportMoviesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkImportMoviesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkImportMoviesRequestValidationError{}
+
+var _BulkImportMoviesRequest_Format_InLookup = map[string]struct{}{
+	"csv":   {},
+	"jsonl": {},
+}
+
+var _BulkImportMoviesRequest_Mode_InLookup = map[string]struct{}{
+	"skip":   {},
+	"upsert": {},
+}
+
+// Validate checks the field values on BulkImportMoviesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkImportMoviesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkImportMoviesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkImportMoviesReplyMultiError, or nil if none found.
+func (m *BulkImportMoviesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkImportMoviesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	// no validation rules for Created
+
+	// no validation rules for Updated
+
+	// no validation rules for Skipped
+
+	// no validation rules for Failed
+
+	for idx, item := range m.GetRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BulkImportMoviesReplyValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BulkImportMoviesReplyValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BulkImportMoviesReplyValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BulkImportMoviesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkImportMoviesReplyMultiError is an error wrapping multiple validation
+// errors returned by BulkImportMoviesReply.ValidateAll() if the designated
+// constraints aren't met.
+type BulkImportMoviesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkImportMoviesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkImportMoviesReplyMultiError) AllErrors() []error { return m }
+
+// BulkImportMoviesReplyValidationError is the validation error returned by
+// BulkImportMoviesReply.Validate if the designated constraints aren't met.
+type BulkImportMoviesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkImportMoviesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkImportMoviesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkImportMoviesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkImportMoviesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkImportMoviesReplyValidationError) ErrorName() string {
+	return "BulkImportMoviesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkImportMoviesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkImportMoviesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkImportMoviesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkImportMoviesReplyValidationError{}
+
+// Validate checks the field values on ImportRowResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportRowResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportRowResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportRowResultMultiError, or nil if none found.
+func (m *ImportRowResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportRowResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Line
+
+	// no validation rules for Title
+
+	// no validation rules for Status
+
+	// no validation rules for MovieId
+
+	if m.Error != nil {
+		// no validation rules for Error
+	}
+
+	if len(errors) > 0 {
+		return ImportRowResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportRowResultMultiError is an error wrapping multiple validation errors
+// returned by ImportRowResult.ValidateAll() if the designated constraints
+// aren't met.
+type ImportRowResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportRowResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportRowResultMultiError) AllErrors() []error { return m }
+
+// ImportRowResultValidationError is the validation error returned by
+// ImportRowResult.Validate if the designated constraints aren't met.
+type ImportRowResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRowResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRowResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRowResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRowResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRowResultValidationError) ErrorName() string { return "ImportRowResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportRowResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRowResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRowResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRowResultValidationError{}
+
+// Validate checks the field values on GetMovieByExternalIdRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMovieByExternalIdRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMovieByExternalIdRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMovieByExternalIdRequestMultiError, or nil if none found.
+func (m *GetMovieByExternalIdRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMovieByExternalIdRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Scheme
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetMovieByExternalIdRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMovieByExternalIdRequestMultiError is an error wrapping multiple
+// validation errors returned by GetMovieByExternalIdRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetMovieByExternalIdRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMovieByExternalIdRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMovieByExternalIdRequestMultiError) AllErrors() []error { return m }
+
+// GetMovieByExternalIdRequestValidationError is the validation error returned
+// by GetMovieByExternalIdRequest.Validate if the designated constraints
+// aren't met.
+type GetMovieByExternalIdRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMovieByExternalIdRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMovieByExternalIdRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMovieByExternalIdRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMovieByExternalIdRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMovieByExternalIdRequestValidationError) ErrorName() string {
+	return "GetMovieByExternalIdRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMovieByExternalIdRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMovieByExternalIdRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMovieByExternalIdRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMovieByExternalIdRequestValidationError{}
+
+// Validate checks the field values on SetMovieExternalIdRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetMovieExternalIdRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMovieExternalIdRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetMovieExternalIdRequestMultiError, or nil if none found.
+func (m *SetMovieExternalIdRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetMovieExternalIdRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Scheme
+
+	// no validation rules for Id
+
+	if m.Version != nil {
+
+		if m.GetVersion() <= 0 {
+			err := SetMovieExternalIdRequestValidationError{
+				field:  "Version",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetMovieExternalIdRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetMovieExternalIdRequestMultiError is an error wrapping multiple validation
+// errors returned by SetMovieExternalIdRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SetMovieExternalIdRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMovieExternalIdRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMovieExternalIdRequestMultiError) AllErrors() []error { return m }
+
+// SetMovieExternalIdRequestValidationError is the validation error returned by
+// SetMovieExternalIdRequest.Validate if the designated constraints aren't met.
+type SetMovieExternalIdRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMovieExternalIdRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMovieExternalIdRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMovieExternalIdRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMovieExternalIdRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMovieExternalIdRequestValidationError) ErrorName() string {
+	return "SetMovieExternalIdRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetMovieExternalIdRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMovieExternalIdRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMovieExternalIdRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMovieExternalIdRequestValidationError{}
+
+// Validate checks the field values on DeleteMovieExternalIdRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMovieExternalIdRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMovieExternalIdRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMovieExternalIdRequestMultiError, or nil if none found.
+func (m *DeleteMovieExternalIdRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMovieExternalIdRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Scheme
+
+	if m.Version != nil {
+
+		if m.GetVersion() <= 0 {
+			err := DeleteMovieExternalIdRequestValidationError{
+				field:  "Version",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DeleteMovieExternalIdRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMovieExternalIdRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteMovieExternalIdRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteMovieExternalIdRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMovieExternalIdRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMovieExternalIdRequestMultiError) AllErrors() []error { return m }
+
+// DeleteMovieExternalIdRequestValidationError is the validation error returned
+// by DeleteMovieExternalIdRequest.Validate if the designated constraints
+// aren't met.
+type DeleteMovieExternalIdRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMovieExternalIdRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMovieExternalIdRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMovieExternalIdRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMovieExternalIdRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMovieExternalIdRequestValidationError) ErrorName() string {
+	return "DeleteMovieExternalIdRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMovieExternalIdRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMovieExternalIdRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMovieExternalIdRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMovieExternalIdRequestValidationError{}
+
+// Validate checks the field values on DeleteMovieTranslationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMovieTranslationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMovieTranslationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteMovieTranslationRequestMultiError, or nil if none found.
+func (m *DeleteMovieTranslationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMovieTranslationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Locale
+
+	if m.Version != nil {
+
+		if m.GetVersion() <= 0 {
+			err := DeleteMovieTranslationRequestValidationError{
+				field:  "Version",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DeleteMovieTranslationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMovieTranslationRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteMovieTranslationRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteMovieTranslationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMovieTranslationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMovieTranslationRequestMultiError) AllErrors() []error { return m }
+
+// DeleteMovieTranslationRequestValidationError is the validation error
+// returned by DeleteMovieTranslationRequest.Validate if the designated
+// constraints aren't met.
+type DeleteMovieTranslationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMovieTranslationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMovieTranslationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMovieTranslationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMovieTranslationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMovieTranslationRequestValidationError) ErrorName() string {
+	return "DeleteMovieTranslationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMovieTranslationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMovieTranslationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMovieTranslationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMovieTranslationRequestValidationError{}
+
+// Validate checks the field values on MovieImage with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MovieImage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MovieImage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MovieImageMultiError, or
+// nil if none found.
+func (m *MovieImage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MovieImage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Kind
+
+	// no validation rules for Url
+
+	// no validation rules for ContentType
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	// no validation rules for SizeBytes
+
+	for idx, item := range m.GetThumbnails() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MovieImageValidationError{
+						field:  fmt.Sprintf("Thumbnails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MovieImageValidationError{
+						field:  fmt.Sprintf("Thumbnails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MovieImageValidationError{
+					field:  fmt.Sprintf("Thumbnails[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MovieImageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MovieImageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MovieImageValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MovieImageMultiError(errors)
+	}
+
+	return nil
+}
+
+// MovieImageMultiError is an error wrapping multiple validation errors
+// returned by MovieImage.ValidateAll() if the designated constraints aren't met.
+type MovieImageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MovieImageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MovieImageMultiError) AllErrors() []error { return m }
+
+// MovieImageValidationError is the validation error returned by
+// MovieImage.Validate if the designated constraints aren't met.
+type MovieImageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MovieImageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MovieImageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MovieImageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MovieImageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MovieImageValidationError) ErrorName() string { return "MovieImageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MovieImageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMovieImage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MovieImageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MovieImageValidationError{}
+
+// Validate checks the field values on Thumbnail with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Thumbnail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Thumbnail with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ThumbnailMultiError, or nil
+// if none found.
+func (m *Thumbnail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Thumbnail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	// no validation rules for Url
+
+	if len(errors) > 0 {
+		return ThumbnailMultiError(errors)
+	}
+
+	return nil
+}
+
+// ThumbnailMultiError is an error wrapping multiple validation errors returned
+// by Thumbnail.ValidateAll() if the designated constraints aren't met.
+type ThumbnailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ThumbnailMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ThumbnailMultiError) AllErrors() []error { return m }
+
+// ThumbnailValidationError is the validation error returned by
+// Thumbnail.Validate if the designated constraints aren't met.
+type ThumbnailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ThumbnailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ThumbnailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ThumbnailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ThumbnailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ThumbnailValidationError) ErrorName() string { return "ThumbnailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ThumbnailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sThumbnail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ThumbnailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ThumbnailValidationError{}
+
+// Validate checks the field values on UploadMovieImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadMovieImageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadMovieImageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadMovieImageRequestMultiError, or nil if none found.
+func (m *UploadMovieImageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadMovieImageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Kind
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return UploadMovieImageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadMovieImageRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadMovieImageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadMovieImageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadMovieImageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadMovieImageRequestMultiError) AllErrors() []error { return m }
+
+// UploadMovieImageRequestValidationError is the validation error returned by
+// UploadMovieImageRequest.Validate if the designated constraints aren't met.
+type UploadMovieImageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadMovieImageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadMovieImageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadMovieImageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadMovieImageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadMovieImageRequestValidationError) ErrorName() string {
+	return "UploadMovieImageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadMovieImageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadMovieImageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadMovieImageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadMovieImageRequestValidationError{}
+
+// Validate checks the field values on DeleteMovieImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMovieImageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMovieImageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMovieImageRequestMultiError, or nil if none found.
+func (m *DeleteMovieImageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMovieImageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ImageId
+
+	if len(errors) > 0 {
+		return DeleteMovieImageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMovieImageRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteMovieImageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteMovieImageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMovieImageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMovieImageRequestMultiError) AllErrors() []error { return m }
+
+// DeleteMovieImageRequestValidationError is the validation error returned by
+// DeleteMovieImageRequest.Validate if the designated constraints aren't met.
+type DeleteMovieImageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMovieImageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMovieImageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMovieImageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMovieImageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMovieImageRequestValidationError) ErrorName() string {
+	return "DeleteMovieImageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMovieImageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMovieImageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMovieImageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMovieImageRequestValidationError{}
+
+// Validate checks the field values on DeleteMovieImageReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMovieImageReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMovieImageReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMovieImageReplyMultiError, or nil if none found.
+func (m *DeleteMovieImageReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMovieImageReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteMovieImageReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMovieImageReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteMovieImageReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteMovieImageReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMovieImageReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMovieImageReplyMultiError) AllErrors() []error { return m }
+
+// DeleteMovieImageReplyValidationError is the validation error returned by
+// DeleteMovieImageReply.Validate if the designated constraints aren't met.
+type DeleteMovieImageReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMovieImageReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMovieImageReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMovieImageReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMovieImageReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMovieImageReplyValidationError) ErrorName() string {
+	return "DeleteMovieImageReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMovieImageReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMovieImageReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMovieImageReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMovieImageReplyValidationError{}
+
+// Validate checks the field values on SubmitRatingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitRatingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitRatingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitRatingRequestMultiError, or nil if none found.
+func (m *SubmitRatingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitRatingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	if _, ok := _SubmitRatingRequest_Rating_InLookup[m.GetRating()]; !ok {
+		err := SubmitRatingRequestValidationError{
+			field:  "Rating",
+			reason: "value must be in list [0.5 1 1.5 2 2.5 3 3.5 4 4.5 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Review != nil {
+		// no validation rules for Review
+	}
+
+	if m.Version != nil {
+
+		if m.GetVersion() <= 0 {
+			err := SubmitRatingRequestValidationError{
+				field:  "Version",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubmitRatingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitRatingRequestMultiError is an error wrapping multiple validation
+// errors returned by SubmitRatingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitRatingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitRatingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitRatingRequestMultiError) AllErrors() []error { return m }
+
+// SubmitRatingRequestValidationError is the validation error returned by
+// SubmitRatingRequest.Validate if the designated constraints aren't met.
+type SubmitRatingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitRatingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitRatingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitRatingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitRatingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitRatingRequestValidationError) ErrorName() string {
+	return "SubmitRatingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitRatingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitRatingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitRatingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitRatingRequestValidationError{}
+
+var _SubmitRatingRequest_Rating_InLookup = map[float64]struct{}{
+	0.5: {},
+	1:   {},
+	1.5: {},
+	2:   {},
+	2.5: {},
+	3:   {},
+	3.5: {},
+	4:   {},
+	4.5: {},
+	5:   {},
+}
+
+// Validate checks the field values on SubmitRatingReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SubmitRatingReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitRatingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitRatingReplyMultiError, or nil if none found.
+func (m *SubmitRatingReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitRatingReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MovieTitle
+
+	// no validation rules for RaterId
+
+	// no validation rules for Rating
+
+	// no validation rules for Id
+
+	// no validation rules for ModerationStatus
+
+	// no validation rules for Version
+
+	if m.Review != nil {
+		// no validation rules for Review
+	}
+
+	if len(errors) > 0 {
+		return SubmitRatingReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitRatingReplyMultiError is an error wrapping multiple validation errors
+// returned by SubmitRatingReply.ValidateAll() if the designated constraints
+// aren't met.
+type SubmitRatingReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitRatingReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitRatingReplyMultiError) AllErrors() []error { return m }
+
+// SubmitRatingReplyValidationError is the validation error returned by
+// SubmitRatingReply.Validate if the designated constraints aren't met.
+type SubmitRatingReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitRatingReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitRatingReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitRatingReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitRatingReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitRatingReplyValidationError) ErrorName() string {
+	return "SubmitRatingReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitRatingReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitRatingReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitRatingReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitRatingReplyValidationError{}
+
+// Validate checks the field values on GetRatingRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetRatingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRatingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRatingRequestMultiError, or nil if none found.
+func (m *GetRatingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRatingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	if m.Window != nil {
+
+		if m.GetWindow() != "" {
+
+			if _, ok := _GetRatingRequest_Window_InLookup[m.GetWindow()]; !ok {
+				err := GetRatingRequestValidationError{
+					field:  "Window",
+					reason: "value must be in list [7d 30d 365d all]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetRatingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRatingRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRatingRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRatingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRatingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRatingRequestMultiError) AllErrors() []error { return m }
+
+// GetRatingRequestValidationError is the validation error returned by
+// GetRatingRequest.Validate if the designated constraints aren't met.
+type GetRatingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRatingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRatingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRatingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRatingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRatingRequestValidationError) ErrorName() string { return "GetRatingRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRatingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRatingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRatingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRatingRequestValidationError{}
+
+var _GetRatingRequest_Window_InLookup = map[string]struct{}{
+	"7d":   {},
+	"30d":  {},
+	"365d": {},
+	"all":  {},
+}
+
+// Validate checks the field values on GetRatingReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetRatingReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRatingReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRatingReplyMultiError,
+// or nil if none found.
+func (m *GetRatingReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRatingReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Average
+
+	// no validation rules for Count
+
+	// no validation rules for UnderReview
+
+	if len(errors) > 0 {
+		return GetRatingReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRatingReplyMultiError is an error wrapping multiple validation errors
+// returned by GetRatingReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetRatingReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRatingReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRatingReplyMultiError) AllErrors() []error { return m }
+
+// GetRatingReplyValidationError is the validation error returned by
+// GetRatingReply.Validate if the designated constraints aren't met.
+type GetRatingReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRatingReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRatingReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRatingReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRatingReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRatingReplyValidationError) ErrorName() string { return "GetRatingReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRatingReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRatingReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRatingReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRatingReplyValidationError{}
+
+// Validate checks the field values on BatchGetRatingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetRatingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetRatingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetRatingsRequestMultiError, or nil if none found.
+func (m *BatchGetRatingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetRatingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Window != nil {
+
+		if m.GetWindow() != "" {
+
+			if _, ok := _BatchGetRatingsRequest_Window_InLookup[m.GetWindow()]; !ok {
+				err := BatchGetRatingsRequestValidationError{
+					field:  "Window",
+					reason: "value must be in list [7d 30d 365d all]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetRatingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetRatingsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetRatingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetRatingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetRatingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetRatingsRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetRatingsRequestValidationError is the validation error returned by
+// BatchGetRatingsRequest.Validate if the designated constraints aren't met.
+type BatchGetRatingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetRatingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetRatingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetRatingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetRatingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetRatingsRequestValidationError) ErrorName() string {
+	return "BatchGetRatingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetRatingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetRatingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetRatingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetRatingsRequestValidationError{}
+
+var _BatchGetRatingsRequest_Window_InLookup = map[string]struct{}{
+	"7d":   {},
+	"30d":  {},
+	"365d": {},
+	"all":  {},
+}
+
+// Validate checks the field values on BatchGetRatingsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetRatingsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetRatingsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetRatingsReplyMultiError, or nil if none found.
+func (m *BatchGetRatingsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetRatingsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetRatingsReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetRatingsReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetRatingsReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetRatingsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetRatingsReplyMultiError is an error wrapping multiple validation
+// errors returned by BatchGetRatingsReply.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetRatingsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetRatingsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetRatingsReplyMultiError) AllErrors() []error { return m }
+
+// BatchGetRatingsReplyValidationError is the validation error returned by
+// BatchGetRatingsReply.Validate if the designated constraints aren't met.
+type BatchGetRatingsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetRatingsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetRatingsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetRatingsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetRatingsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetRatingsReplyValidationError) ErrorName() string {
+	return "BatchGetRatingsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetRatingsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetRatingsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetRatingsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetRatingsReplyValidationError{}
+
+// Validate checks the field values on BatchRatingResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchRatingResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchRatingResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchRatingResultMultiError, or nil if none found.
+func (m *BatchRatingResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchRatingResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Id
+
+	if m.Rating != nil {
+
+		if all {
+			switch v := interface{}(m.GetRating()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchRatingResultValidationError{
+						field:  "Rating",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchRatingResultValidationError{
+						field:  "Rating",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRating()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchRatingResultValidationError{
+					field:  "Rating",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Error != nil {
+
+		if all {
+			switch v := interface{}(m.GetError()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchRatingResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchRatingResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchRatingResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchRatingResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchRatingResultMultiError is an error wrapping multiple validation errors
+// returned by BatchRatingResult.ValidateAll() if the designated constraints
+// aren't met.
+type BatchRatingResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchRatingResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchRatingResultMultiError) AllErrors() []error { return m }
+
+// BatchRatingResultValidationError is the validation error returned by
+// BatchRatingResult.Validate if the designated constraints aren't met.
+type BatchRatingResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchRatingResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchRatingResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchRatingResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchRatingResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchRatingResultValidationError) ErrorName() string {
+	return "BatchRatingResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchRatingResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchRatingResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchRatingResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchRatingResultValidationError{}
+
+// Validate checks the field values on GetRatingHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRatingHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRatingHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRatingHistoryRequestMultiError, or nil if none found.
+func (m *GetRatingHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRatingHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	if m.RaterId != nil {
+		// no validation rules for RaterId
+	}
+
+	if m.Since != nil {
+		// no validation rules for Since
+	}
+
+	if m.Until != nil {
+		// no validation rules for Until
+	}
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val < 1 || val > 100 {
+			err := GetRatingHistoryRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if len(errors) > 0 {
+		return GetRatingHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRatingHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRatingHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRatingHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRatingHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRatingHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetRatingHistoryRequestValidationError is the validation error returned by
+// GetRatingHistoryRequest.Validate if the designated constraints aren't met.
+type GetRatingHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRatingHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRatingHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRatingHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRatingHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRatingHistoryRequestValidationError) ErrorName() string {
+	return "GetRatingHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRatingHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRatingHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRatingHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRatingHistoryRequestValidationError{}
+
+// Validate checks the field values on GetRatingHistoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRatingHistoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRatingHistoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRatingHistoryReplyMultiError, or nil if none found.
+func (m *GetRatingHistoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRatingHistoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRatingHistoryReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRatingHistoryReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRatingHistoryReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.NextCursor != nil {
+		// no validation rules for NextCursor
+	}
+
+	if len(errors) > 0 {
+		return GetRatingHistoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRatingHistoryReplyMultiError is an error wrapping multiple validation
+// errors returned by GetRatingHistoryReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetRatingHistoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRatingHistoryReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRatingHistoryReplyMultiError) AllErrors() []error { return m }
+
+// GetRatingHistoryReplyValidationError is the validation error returned by
+// GetRatingHistoryReply.Validate if the designated constraints aren't met.
+type GetRatingHistoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRatingHistoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRatingHistoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRatingHistoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRatingHistoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRatingHistoryReplyValidationError) ErrorName() string {
+	return "GetRatingHistoryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRatingHistoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRatingHistoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRatingHistoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRatingHistoryReplyValidationError{}
+
+// Validate checks the field values on RatingEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RatingEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RatingEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RatingEventMultiError, or
+// nil if none found.
+func (m *RatingEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RatingEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MovieTitle
+
+	// no validation rules for RaterId
+
+	// no validation rules for EventType
+
+	// no validation rules for NewRating
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RatingEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RatingEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RatingEventValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.OldRating != nil {
+		// no validation rules for OldRating
+	}
+
+	if m.ClientIp != nil {
+		// no validation rules for ClientIp
+	}
+
+	if m.UserAgent != nil {
+		// no validation rules for UserAgent
+	}
+
+	if len(errors) > 0 {
+		return RatingEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// RatingEventMultiError is an error wrapping multiple validation errors
+// returned by RatingEvent.ValidateAll() if the designated constraints aren't met.
+type RatingEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RatingEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RatingEventMultiError) AllErrors() []error { return m }
+
+// RatingEventValidationError is the validation error returned by
+// RatingEvent.Validate if the designated constraints aren't met.
+type RatingEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RatingEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RatingEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RatingEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RatingEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RatingEventValidationError) ErrorName() string { return "RatingEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RatingEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRatingEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RatingEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RatingEventValidationError{}
+
+// Validate checks the field values on HealthCheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HealthCheckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HealthCheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HealthCheckRequestMultiError, or nil if none found.
+func (m *HealthCheckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HealthCheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return HealthCheckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// HealthCheckRequestMultiError is an error wrapping multiple validation errors
+// returned by HealthCheckRequest.ValidateAll() if the designated constraints
+// aren't met.
+type HealthCheckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HealthCheckRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HealthCheckRequestMultiError) AllErrors() []error { return m }
+
+// HealthCheckRequestValidationError is the validation error returned by
+// HealthCheckRequest.Validate if the designated constraints aren't met.
+type HealthCheckRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HealthCheckRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HealthCheckRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HealthCheckRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HealthCheckRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HealthCheckRequestValidationError) ErrorName() string {
+	return "HealthCheckRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HealthCheckRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHealthCheckRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HealthCheckRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HealthCheckRequestValidationError{}
+
+// Validate checks the field values on HealthCheckReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HealthCheckReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HealthCheckReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HealthCheckReplyMultiError, or nil if none found.
+func (m *HealthCheckReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HealthCheckReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return HealthCheckReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// HealthCheckReplyMultiError is an error wrapping multiple validation errors
+// returned by HealthCheckReply.ValidateAll() if the designated constraints
+// aren't met.
+type HealthCheckReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HealthCheckReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HealthCheckReplyMultiError) AllErrors() []error { return m }
+
+// HealthCheckReplyValidationError is the validation error returned by
+// HealthCheckReply.Validate if the designated constraints aren't met.
+type HealthCheckReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HealthCheckReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HealthCheckReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HealthCheckReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HealthCheckReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HealthCheckReplyValidationError) ErrorName() string { return "HealthCheckReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HealthCheckReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHealthCheckReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HealthCheckReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HealthCheckReplyValidationError{}
@@ -19,11 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MovieService_CreateMovie_FullMethodName  = "/api.movie.v1.MovieService/CreateMovie"
-	MovieService_ListMovies_FullMethodName   = "/api.movie.v1.MovieService/ListMovies"
-	MovieService_SubmitRating_FullMethodName = "/api.movie.v1.MovieService/SubmitRating"
-	MovieService_GetRating_FullMethodName    = "/api.movie.v1.MovieService/GetRating"
-	MovieService_HealthCheck_FullMethodName  = "/api.movie.v1.MovieService/HealthCheck"
+	MovieService_CreateMovie_FullMethodName            = "/api.movie.v1.MovieService/CreateMovie"
+	MovieService_BulkImportMovies_FullMethodName       = "/api.movie.v1.MovieService/BulkImportMovies"
+	MovieService_ListMovies_FullMethodName             = "/api.movie.v1.MovieService/ListMovies"
+	MovieService_BatchGetMovies_FullMethodName         = "/api.movie.v1.MovieService/BatchGetMovies"
+	MovieService_ExportMovies_FullMethodName           = "/api.movie.v1.MovieService/ExportMovies"
+	MovieService_GetMovieByExternalId_FullMethodName   = "/api.movie.v1.MovieService/GetMovieByExternalId"
+	MovieService_SetMovieExternalId_FullMethodName     = "/api.movie.v1.MovieService/SetMovieExternalId"
+	MovieService_DeleteMovieExternalId_FullMethodName  = "/api.movie.v1.MovieService/DeleteMovieExternalId"
+	MovieService_SetMovieCredits_FullMethodName        = "/api.movie.v1.MovieService/SetMovieCredits"
+	MovieService_SetMovieGenres_FullMethodName         = "/api.movie.v1.MovieService/SetMovieGenres"
+	MovieService_SetMovieTags_FullMethodName           = "/api.movie.v1.MovieService/SetMovieTags"
+	MovieService_AddAlternateTitle_FullMethodName      = "/api.movie.v1.MovieService/AddAlternateTitle"
+	MovieService_RemoveAlternateTitle_FullMethodName   = "/api.movie.v1.MovieService/RemoveAlternateTitle"
+	MovieService_SetMovieTranslation_FullMethodName    = "/api.movie.v1.MovieService/SetMovieTranslation"
+	MovieService_DeleteMovieTranslation_FullMethodName = "/api.movie.v1.MovieService/DeleteMovieTranslation"
+	MovieService_UploadMovieImage_FullMethodName       = "/api.movie.v1.MovieService/UploadMovieImage"
+	MovieService_DeleteMovieImage_FullMethodName       = "/api.movie.v1.MovieService/DeleteMovieImage"
+	MovieService_SubmitRating_FullMethodName           = "/api.movie.v1.MovieService/SubmitRating"
+	MovieService_GetRating_FullMethodName              = "/api.movie.v1.MovieService/GetRating"
+	MovieService_BatchGetRatings_FullMethodName        = "/api.movie.v1.MovieService/BatchGetRatings"
+	MovieService_GetRatingHistory_FullMethodName       = "/api.movie.v1.MovieService/GetRatingHistory"
+	MovieService_HealthCheck_FullMethodName            = "/api.movie.v1.MovieService/HealthCheck"
 )
 
 // MovieServiceClient is the client API for MovieService service.
@@ -34,12 +51,54 @@ const (
 type MovieServiceClient interface {
 	// Create a new movie
 	CreateMovie(ctx context.Context, in *CreateMovieRequest, opts ...grpc.CallOption) (*CreateMovieReply, error)
+	// Create movies in bulk from a CSV or JSONL file, with a per-row report.
+	// Requests are bound by the server timeout; large catalogs with box-office
+	// enrichment are better loaded with the import command.
+	BulkImportMovies(ctx context.Context, in *BulkImportMoviesRequest, opts ...grpc.CallOption) (*BulkImportMoviesReply, error)
 	// List movies with filters and pagination
 	ListMovies(ctx context.Context, in *ListMoviesRequest, opts ...grpc.CallOption) (*ListMoviesReply, error)
+	// Get up to 100 movies by title or ID in one call. Movies that are not
+	// found or whose title is ambiguous are reported per item.
+	BatchGetMovies(ctx context.Context, in *BatchGetMoviesRequest, opts ...grpc.CallOption) (*BatchGetMoviesReply, error)
+	// Export the movies matching the ListMovies filters as CSV, JSONL or
+	// Parquet, streamed in chunks. Over HTTP it is GET /movies/export with the
+	// same query parameters, served as a chunked download.
+	ExportMovies(ctx context.Context, in *ExportMoviesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportMoviesChunk], error)
+	// Find a movie by an external ID. EIDR IDs go without their 10.5240/
+	// prefix, e.g. /movies/by-external/eidr/7791-8534-2C23-9030-8610-5
+	GetMovieByExternalId(ctx context.Context, in *GetMovieByExternalIdRequest, opts ...grpc.CallOption) (*MovieItem, error)
+	// Set or replace the ID of a movie in an external scheme (imdb, tmdb, eidr)
+	SetMovieExternalId(ctx context.Context, in *SetMovieExternalIdRequest, opts ...grpc.CallOption) (*MovieItem, error)
+	// Remove the ID of a movie in an external scheme
+	DeleteMovieExternalId(ctx context.Context, in *DeleteMovieExternalIdRequest, opts ...grpc.CallOption) (*MovieItem, error)
+	// Replace the cast and crew of a movie
+	SetMovieCredits(ctx context.Context, in *SetMovieCreditsRequest, opts ...grpc.CallOption) (*MovieItem, error)
+	// Replace the genres of a movie; the first becomes its primary genre
+	SetMovieGenres(ctx context.Context, in *SetMovieGenresRequest, opts ...grpc.CallOption) (*MovieItem, error)
+	// Replace the tags of a movie
+	SetMovieTags(ctx context.Context, in *SetMovieTagsRequest, opts ...grpc.CallOption) (*MovieItem, error)
+	// Add another title the movie is known by (localized, original, working...)
+	AddAlternateTitle(ctx context.Context, in *AddAlternateTitleRequest, opts ...grpc.CallOption) (*MovieItem, error)
+	// Remove an alternate title of a movie
+	RemoveAlternateTitle(ctx context.Context, in *RemoveAlternateTitleRequest, opts ...grpc.CallOption) (*MovieItem, error)
+	// Create or replace the synopsis and tagline of a movie in a locale
+	SetMovieTranslation(ctx context.Context, in *SetMovieTranslationRequest, opts ...grpc.CallOption) (*MovieItem, error)
+	// Remove the text of a movie in a locale
+	DeleteMovieTranslation(ctx context.Context, in *DeleteMovieTranslationRequest, opts ...grpc.CallOption) (*MovieItem, error)
+	// Upload a poster or still. Over HTTP this is POST /movies/{id}/images
+	// with multipart/form-data (fields file and kind), routed by the server.
+	UploadMovieImage(ctx context.Context, in *UploadMovieImageRequest, opts ...grpc.CallOption) (*MovieImage, error)
+	// Delete an image of a movie with its thumbnails
+	DeleteMovieImage(ctx context.Context, in *DeleteMovieImageRequest, opts ...grpc.CallOption) (*DeleteMovieImageReply, error)
 	// Submit or update a rating for a movie
 	SubmitRating(ctx context.Context, in *SubmitRatingRequest, opts ...grpc.CallOption) (*SubmitRatingReply, error)
 	// Get aggregated rating for a movie
 	GetRating(ctx context.Context, in *GetRatingRequest, opts ...grpc.CallOption) (*GetRatingReply, error)
+	// Get the aggregated ratings of up to 100 movies by title or ID in one
+	// call, reporting movies that are not found per item
+	BatchGetRatings(ctx context.Context, in *BatchGetRatingsRequest, opts ...grpc.CallOption) (*BatchGetRatingsReply, error)
+	// Get the rating change history for a movie (admin)
+	GetRatingHistory(ctx context.Context, in *GetRatingHistoryRequest, opts ...grpc.CallOption) (*GetRatingHistoryReply, error)
 	// Health check
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckReply, error)
 }
@@ -62,6 +121,16 @@ func (c *movieServiceClient) CreateMovie(ctx context.Context, in *CreateMovieReq
 	return out, nil
 }
 
+func (c *movieServiceClient) BulkImportMovies(ctx context.Context, in *BulkImportMoviesRequest, opts ...grpc.CallOption) (*BulkImportMoviesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkImportMoviesReply)
+	err := c.cc.Invoke(ctx, MovieService_BulkImportMovies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *movieServiceClient) ListMovies(ctx context.Context, in *ListMoviesRequest, opts ...grpc.CallOption) (*ListMoviesReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListMoviesReply)
@@ -72,6 +141,155 @@ func (c *movieServiceClient) ListMovies(ctx context.Context, in *ListMoviesReque
 	return out, nil
 }
 
+func (c *movieServiceClient) BatchGetMovies(ctx context.Context, in *BatchGetMoviesRequest, opts ...grpc.CallOption) (*BatchGetMoviesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetMoviesReply)
+	err := c.cc.Invoke(ctx, MovieService_BatchGetMovies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) ExportMovies(ctx context.Context, in *ExportMoviesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportMoviesChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MovieService_ServiceDesc.Streams[0], MovieService_ExportMovies_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportMoviesRequest, ExportMoviesChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MovieService_ExportMoviesClient = grpc.ServerStreamingClient[ExportMoviesChunk]
+
+func (c *movieServiceClient) GetMovieByExternalId(ctx context.Context, in *GetMovieByExternalIdRequest, opts ...grpc.CallOption) (*MovieItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovieItem)
+	err := c.cc.Invoke(ctx, MovieService_GetMovieByExternalId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) SetMovieExternalId(ctx context.Context, in *SetMovieExternalIdRequest, opts ...grpc.CallOption) (*MovieItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovieItem)
+	err := c.cc.Invoke(ctx, MovieService_SetMovieExternalId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) DeleteMovieExternalId(ctx context.Context, in *DeleteMovieExternalIdRequest, opts ...grpc.CallOption) (*MovieItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovieItem)
+	err := c.cc.Invoke(ctx, MovieService_DeleteMovieExternalId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) SetMovieCredits(ctx context.Context, in *SetMovieCreditsRequest, opts ...grpc.CallOption) (*MovieItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovieItem)
+	err := c.cc.Invoke(ctx, MovieService_SetMovieCredits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) SetMovieGenres(ctx context.Context, in *SetMovieGenresRequest, opts ...grpc.CallOption) (*MovieItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovieItem)
+	err := c.cc.Invoke(ctx, MovieService_SetMovieGenres_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) SetMovieTags(ctx context.Context, in *SetMovieTagsRequest, opts ...grpc.CallOption) (*MovieItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovieItem)
+	err := c.cc.Invoke(ctx, MovieService_SetMovieTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) AddAlternateTitle(ctx context.Context, in *AddAlternateTitleRequest, opts ...grpc.CallOption) (*MovieItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovieItem)
+	err := c.cc.Invoke(ctx, MovieService_AddAlternateTitle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) RemoveAlternateTitle(ctx context.Context, in *RemoveAlternateTitleRequest, opts ...grpc.CallOption) (*MovieItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovieItem)
+	err := c.cc.Invoke(ctx, MovieService_RemoveAlternateTitle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) SetMovieTranslation(ctx context.Context, in *SetMovieTranslationRequest, opts ...grpc.CallOption) (*MovieItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovieItem)
+	err := c.cc.Invoke(ctx, MovieService_SetMovieTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) DeleteMovieTranslation(ctx context.Context, in *DeleteMovieTranslationRequest, opts ...grpc.CallOption) (*MovieItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovieItem)
+	err := c.cc.Invoke(ctx, MovieService_DeleteMovieTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) UploadMovieImage(ctx context.Context, in *UploadMovieImageRequest, opts ...grpc.CallOption) (*MovieImage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MovieImage)
+	err := c.cc.Invoke(ctx, MovieService_UploadMovieImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) DeleteMovieImage(ctx context.Context, in *DeleteMovieImageRequest, opts ...grpc.CallOption) (*DeleteMovieImageReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMovieImageReply)
+	err := c.cc.Invoke(ctx, MovieService_DeleteMovieImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *movieServiceClient) SubmitRating(ctx context.Context, in *SubmitRatingRequest, opts ...grpc.CallOption) (*SubmitRatingReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SubmitRatingReply)
@@ -92,6 +310,26 @@ func (c *movieServiceClient) GetRating(ctx context.Context, in *GetRatingRequest
 	return out, nil
 }
 
+func (c *movieServiceClient) BatchGetRatings(ctx context.Context, in *BatchGetRatingsRequest, opts ...grpc.CallOption) (*BatchGetRatingsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetRatingsReply)
+	err := c.cc.Invoke(ctx, MovieService_BatchGetRatings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *movieServiceClient) GetRatingHistory(ctx context.Context, in *GetRatingHistoryRequest, opts ...grpc.CallOption) (*GetRatingHistoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRatingHistoryReply)
+	err := c.cc.Invoke(ctx, MovieService_GetRatingHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *movieServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthCheckReply)
@@ -110,12 +348,54 @@ func (c *movieServiceClient) HealthCheck(ctx context.Context, in *HealthCheckReq
 type MovieServiceServer interface {
 	// Create a new movie
 	CreateMovie(context.Context, *CreateMovieRequest) (*CreateMovieReply, error)
+	// Create movies in bulk from a CSV or JSONL file, with a per-row report.
+	// Requests are bound by the server timeout; large catalogs with box-office
+	// enrichment are better loaded with the import command.
+	BulkImportMovies(context.Context, *BulkImportMoviesRequest) (*BulkImportMoviesReply, error)
 	// List movies with filters and pagination
 	ListMovies(context.Context, *ListMoviesRequest) (*ListMoviesReply, error)
+	// Get up to 100 movies by title or ID in one call. Movies that are not
+	// found or whose title is ambiguous are reported per item.
+	BatchGetMovies(context.Context, *BatchGetMoviesRequest) (*BatchGetMoviesReply, error)
+	// Export the movies matching the ListMovies filters as CSV, JSONL or
+	// Parquet, streamed in chunks. Over HTTP it is GET /movies/export with the
+	// same query parameters, served as a chunked download.
+	ExportMovies(*ExportMoviesRequest, grpc.ServerStreamingServer[ExportMoviesChunk]) error
+	// Find a movie by an external ID. EIDR IDs go without their 10.5240/
+	// prefix, e.g. /movies/by-external/eidr/7791-8534-2C23-9030-8610-5
+	GetMovieByExternalId(context.Context, *GetMovieByExternalIdRequest) (*MovieItem, error)
+	// Set or replace the ID of a movie in an external scheme (imdb, tmdb, eidr)
+	SetMovieExternalId(context.Context, *SetMovieExternalIdRequest) (*MovieItem, error)
+	// Remove the ID of a movie in an external scheme
+	DeleteMovieExternalId(context.Context, *DeleteMovieExternalIdRequest) (*MovieItem, error)
+	// Replace the cast and crew of a movie
+	SetMovieCredits(context.Context, *SetMovieCreditsRequest) (*MovieItem, error)
+	// Replace the genres of a movie; the first becomes its primary genre
+	SetMovieGenres(context.Context, *SetMovieGenresRequest) (*MovieItem, error)
+	// Replace the tags of a movie
+	SetMovieTags(context.Context, *SetMovieTagsRequest) (*MovieItem, error)
+	// Add another title the movie is known by (localized, original, working...)
+	AddAlternateTitle(context.Context, *AddAlternateTitleRequest) (*MovieItem, error)
+	// Remove an alternate title of a movie
+	RemoveAlternateTitle(context.Context, *RemoveAlternateTitleRequest) (*MovieItem, error)
+	// Create or replace the synopsis and tagline of a movie in a locale
+	SetMovieTranslation(context.Context, *SetMovieTranslationRequest) (*MovieItem, error)
+	// Remove the text of a movie in a locale
+	DeleteMovieTranslation(context.Context, *DeleteMovieTranslationRequest) (*MovieItem, error)
+	// Upload a poster or still. Over HTTP this is POST /movies/{id}/images
+	// with multipart/form-data (fields file and kind), routed by the server.
+	UploadMovieImage(context.Context, *UploadMovieImageRequest) (*MovieImage, error)
+	// Delete an image of a movie with its thumbnails
+	DeleteMovieImage(context.Context, *DeleteMovieImageRequest) (*DeleteMovieImageReply, error)
 	// Submit or update a rating for a movie
 	SubmitRating(context.Context, *SubmitRatingRequest) (*SubmitRatingReply, error)
 	// Get aggregated rating for a movie
 	GetRating(context.Context, *GetRatingRequest) (*GetRatingReply, error)
+	// Get the aggregated ratings of up to 100 movies by title or ID in one
+	// call, reporting movies that are not found per item
+	BatchGetRatings(context.Context, *BatchGetRatingsRequest) (*BatchGetRatingsReply, error)
+	// Get the rating change history for a movie (admin)
+	GetRatingHistory(context.Context, *GetRatingHistoryRequest) (*GetRatingHistoryReply, error)
 	// Health check
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckReply, error)
 	mustEmbedUnimplementedMovieServiceServer()
@@ -131,15 +411,66 @@ type UnimplementedMovieServiceServer struct{}
 func (UnimplementedMovieServiceServer) CreateMovie(context.Context, *CreateMovieRequest) (*CreateMovieReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMovie not implemented")
 }
+func (UnimplementedMovieServiceServer) BulkImportMovies(context.Context, *BulkImportMoviesRequest) (*BulkImportMoviesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkImportMovies not implemented")
+}
 func (UnimplementedMovieServiceServer) ListMovies(context.Context, *ListMoviesRequest) (*ListMoviesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMovies not implemented")
 }
+func (UnimplementedMovieServiceServer) BatchGetMovies(context.Context, *BatchGetMoviesRequest) (*BatchGetMoviesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetMovies not implemented")
+}
+func (UnimplementedMovieServiceServer) ExportMovies(*ExportMoviesRequest, grpc.ServerStreamingServer[ExportMoviesChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMovies not implemented")
+}
+func (UnimplementedMovieServiceServer) GetMovieByExternalId(context.Context, *GetMovieByExternalIdRequest) (*MovieItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMovieByExternalId not implemented")
+}
+func (UnimplementedMovieServiceServer) SetMovieExternalId(context.Context, *SetMovieExternalIdRequest) (*MovieItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMovieExternalId not implemented")
+}
+func (UnimplementedMovieServiceServer) DeleteMovieExternalId(context.Context, *DeleteMovieExternalIdRequest) (*MovieItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMovieExternalId not implemented")
+}
+func (UnimplementedMovieServiceServer) SetMovieCredits(context.Context, *SetMovieCreditsRequest) (*MovieItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMovieCredits not implemented")
+}
+func (UnimplementedMovieServiceServer) SetMovieGenres(context.Context, *SetMovieGenresRequest) (*MovieItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMovieGenres not implemented")
+}
+func (UnimplementedMovieServiceServer) SetMovieTags(context.Context, *SetMovieTagsRequest) (*MovieItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMovieTags not implemented")
+}
+func (UnimplementedMovieServiceServer) AddAlternateTitle(context.Context, *AddAlternateTitleRequest) (*MovieItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAlternateTitle not implemented")
+}
+func (UnimplementedMovieServiceServer) RemoveAlternateTitle(context.Context, *RemoveAlternateTitleRequest) (*MovieItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAlternateTitle not implemented")
+}
+func (UnimplementedMovieServiceServer) SetMovieTranslation(context.Context, *SetMovieTranslationRequest) (*MovieItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMovieTranslation not implemented")
+}
+func (UnimplementedMovieServiceServer) DeleteMovieTranslation(context.Context, *DeleteMovieTranslationRequest) (*MovieItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMovieTranslation not implemented")
+}
+func (UnimplementedMovieServiceServer) UploadMovieImage(context.Context, *UploadMovieImageRequest) (*MovieImage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadMovieImage not implemented")
+}
+func (UnimplementedMovieServiceServer) DeleteMovieImage(context.Context, *DeleteMovieImageRequest) (*DeleteMovieImageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMovieImage not implemented")
+}
 func (UnimplementedMovieServiceServer) SubmitRating(context.Context, *SubmitRatingRequest) (*SubmitRatingReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitRating not implemented")
 }
 func (UnimplementedMovieServiceServer) GetRating(context.Context, *GetRatingRequest) (*GetRatingReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRating not implemented")
 }
+func (UnimplementedMovieServiceServer) BatchGetRatings(context.Context, *BatchGetRatingsRequest) (*BatchGetRatingsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetRatings not implemented")
+}
+func (UnimplementedMovieServiceServer) GetRatingHistory(context.Context, *GetRatingHistoryRequest) (*GetRatingHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatingHistory not implemented")
+}
 func (UnimplementedMovieServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -182,6 +513,24 @@ func _MovieService_CreateMovie_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MovieService_BulkImportMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkImportMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).BulkImportMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_BulkImportMovies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).BulkImportMovies(ctx, req.(*BulkImportMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MovieService_ListMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListMoviesRequest)
 	if err := dec(in); err != nil {
@@ -200,6 +549,251 @@ func _MovieService_ListMovies_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MovieService_BatchGetMovies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetMoviesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).BatchGetMovies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_BatchGetMovies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).BatchGetMovies(ctx, req.(*BatchGetMoviesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_ExportMovies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportMoviesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MovieServiceServer).ExportMovies(m, &grpc.GenericServerStream[ExportMoviesRequest, ExportMoviesChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MovieService_ExportMoviesServer = grpc.ServerStreamingServer[ExportMoviesChunk]
+
+func _MovieService_GetMovieByExternalId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMovieByExternalIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).GetMovieByExternalId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_GetMovieByExternalId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).GetMovieByExternalId(ctx, req.(*GetMovieByExternalIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_SetMovieExternalId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMovieExternalIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).SetMovieExternalId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_SetMovieExternalId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).SetMovieExternalId(ctx, req.(*SetMovieExternalIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_DeleteMovieExternalId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMovieExternalIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).DeleteMovieExternalId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_DeleteMovieExternalId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).DeleteMovieExternalId(ctx, req.(*DeleteMovieExternalIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_SetMovieCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMovieCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).SetMovieCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_SetMovieCredits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).SetMovieCredits(ctx, req.(*SetMovieCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_SetMovieGenres_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMovieGenresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).SetMovieGenres(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_SetMovieGenres_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).SetMovieGenres(ctx, req.(*SetMovieGenresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_SetMovieTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMovieTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).SetMovieTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_SetMovieTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).SetMovieTags(ctx, req.(*SetMovieTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_AddAlternateTitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAlternateTitleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).AddAlternateTitle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_AddAlternateTitle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).AddAlternateTitle(ctx, req.(*AddAlternateTitleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_RemoveAlternateTitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAlternateTitleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).RemoveAlternateTitle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_RemoveAlternateTitle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).RemoveAlternateTitle(ctx, req.(*RemoveAlternateTitleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_SetMovieTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMovieTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).SetMovieTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_SetMovieTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).SetMovieTranslation(ctx, req.(*SetMovieTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_DeleteMovieTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMovieTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).DeleteMovieTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_DeleteMovieTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).DeleteMovieTranslation(ctx, req.(*DeleteMovieTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_UploadMovieImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadMovieImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).UploadMovieImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_UploadMovieImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).UploadMovieImage(ctx, req.(*UploadMovieImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_DeleteMovieImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMovieImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).DeleteMovieImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_DeleteMovieImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).DeleteMovieImage(ctx, req.(*DeleteMovieImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MovieService_SubmitRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitRatingRequest)
 	if err := dec(in); err != nil {
@@ -236,6 +830,42 @@ func _MovieService_GetRating_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MovieService_BatchGetRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetRatingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).BatchGetRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_BatchGetRatings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).BatchGetRatings(ctx, req.(*BatchGetRatingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MovieService_GetRatingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MovieServiceServer).GetRatingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MovieService_GetRatingHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MovieServiceServer).GetRatingHistory(ctx, req.(*GetRatingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MovieService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HealthCheckRequest)
 	if err := dec(in); err != nil {
@@ -265,10 +895,66 @@ var MovieService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateMovie",
 			Handler:    _MovieService_CreateMovie_Handler,
 		},
+		{
+			MethodName: "BulkImportMovies",
+			Handler:    _MovieService_BulkImportMovies_Handler,
+		},
 		{
 			MethodName: "ListMovies",
 			Handler:    _MovieService_ListMovies_Handler,
 		},
+		{
+			MethodName: "BatchGetMovies",
+			Handler:    _MovieService_BatchGetMovies_Handler,
+		},
+		{
+			MethodName: "GetMovieByExternalId",
+			Handler:    _MovieService_GetMovieByExternalId_Handler,
+		},
+		{
+			MethodName: "SetMovieExternalId",
+			Handler:    _MovieService_SetMovieExternalId_Handler,
+		},
+		{
+			MethodName: "DeleteMovieExternalId",
+			Handler:    _MovieService_DeleteMovieExternalId_Handler,
+		},
+		{
+			MethodName: "SetMovieCredits",
+			Handler:    _MovieService_SetMovieCredits_Handler,
+		},
+		{
+			MethodName: "SetMovieGenres",
+			Handler:    _MovieService_SetMovieGenres_Handler,
+		},
+		{
+			MethodName: "SetMovieTags",
+			Handler:    _MovieService_SetMovieTags_Handler,
+		},
+		{
+			MethodName: "AddAlternateTitle",
+			Handler:    _MovieService_AddAlternateTitle_Handler,
+		},
+		{
+			MethodName: "RemoveAlternateTitle",
+			Handler:    _MovieService_RemoveAlternateTitle_Handler,
+		},
+		{
+			MethodName: "SetMovieTranslation",
+			Handler:    _MovieService_SetMovieTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteMovieTranslation",
+			Handler:    _MovieService_DeleteMovieTranslation_Handler,
+		},
+		{
+			MethodName: "UploadMovieImage",
+			Handler:    _MovieService_UploadMovieImage_Handler,
+		},
+		{
+			MethodName: "DeleteMovieImage",
+			Handler:    _MovieService_DeleteMovieImage_Handler,
+		},
 		{
 			MethodName: "SubmitRating",
 			Handler:    _MovieService_SubmitRating_Handler,
@@ -277,11 +963,25 @@ var MovieService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRating",
 			Handler:    _MovieService_GetRating_Handler,
 		},
+		{
+			MethodName: "BatchGetRatings",
+			Handler:    _MovieService_BatchGetRatings_Handler,
+		},
+		{
+			MethodName: "GetRatingHistory",
+			Handler:    _MovieService_GetRatingHistory_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _MovieService_HealthCheck_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportMovies",
+			Handler:       _MovieService_ExportMovies_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "movie/v1/movie.proto",
 }
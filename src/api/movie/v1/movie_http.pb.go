@@ -19,21 +19,71 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationMovieServiceAddAlternateTitle = "/api.movie.v1.MovieService/AddAlternateTitle"
+const OperationMovieServiceBatchGetMovies = "/api.movie.v1.MovieService/BatchGetMovies"
+const OperationMovieServiceBatchGetRatings = "/api.movie.v1.MovieService/BatchGetRatings"
+const OperationMovieServiceBulkImportMovies = "/api.movie.v1.MovieService/BulkImportMovies"
 const OperationMovieServiceCreateMovie = "/api.movie.v1.MovieService/CreateMovie"
+const OperationMovieServiceDeleteMovieExternalId = "/api.movie.v1.MovieService/DeleteMovieExternalId"
+const OperationMovieServiceDeleteMovieImage = "/api.movie.v1.MovieService/DeleteMovieImage"
+const OperationMovieServiceDeleteMovieTranslation = "/api.movie.v1.MovieService/DeleteMovieTranslation"
+const OperationMovieServiceGetMovieByExternalId = "/api.movie.v1.MovieService/GetMovieByExternalId"
 const OperationMovieServiceGetRating = "/api.movie.v1.MovieService/GetRating"
+const OperationMovieServiceGetRatingHistory = "/api.movie.v1.MovieService/GetRatingHistory"
 const OperationMovieServiceHealthCheck = "/api.movie.v1.MovieService/HealthCheck"
 const OperationMovieServiceListMovies = "/api.movie.v1.MovieService/ListMovies"
+const OperationMovieServiceRemoveAlternateTitle = "/api.movie.v1.MovieService/RemoveAlternateTitle"
+const OperationMovieServiceSetMovieCredits = "/api.movie.v1.MovieService/SetMovieCredits"
+const OperationMovieServiceSetMovieExternalId = "/api.movie.v1.MovieService/SetMovieExternalId"
+const OperationMovieServiceSetMovieGenres = "/api.movie.v1.MovieService/SetMovieGenres"
+const OperationMovieServiceSetMovieTags = "/api.movie.v1.MovieService/SetMovieTags"
+const OperationMovieServiceSetMovieTranslation = "/api.movie.v1.MovieService/SetMovieTranslation"
 const OperationMovieServiceSubmitRating = "/api.movie.v1.MovieService/SubmitRating"
 
 type MovieServiceHTTPServer interface {
+	// AddAlternateTitle Add another title the movie is known by (localized, original, working...)
+	AddAlternateTitle(context.Context, *AddAlternateTitleRequest) (*MovieItem, error)
+	// BatchGetMovies Get up to 100 movies by title or ID in one call. Movies that are not
+	// found or whose title is ambiguous are reported per item.
+	BatchGetMovies(context.Context, *BatchGetMoviesRequest) (*BatchGetMoviesReply, error)
+	// BatchGetRatings Get the aggregated ratings of up to 100 movies by title or ID in one
+	// call, reporting movies that are not found per item
+	BatchGetRatings(context.Context, *BatchGetRatingsRequest) (*BatchGetRatingsReply, error)
+	// BulkImportMovies Create movies in bulk from a CSV or JSONL file, with a per-row report.
+	// Requests are bound by the server timeout; large catalogs with box-office
+	// enrichment are better loaded with the import command.
+	BulkImportMovies(context.Context, *BulkImportMoviesRequest) (*BulkImportMoviesReply, error)
 	// CreateMovie Create a new movie
 	CreateMovie(context.Context, *CreateMovieRequest) (*CreateMovieReply, error)
+	// DeleteMovieExternalId Remove the ID of a movie in an external scheme
+	DeleteMovieExternalId(context.Context, *DeleteMovieExternalIdRequest) (*MovieItem, error)
+	// DeleteMovieImage Delete an image of a movie with its thumbnails
+	DeleteMovieImage(context.Context, *DeleteMovieImageRequest) (*DeleteMovieImageReply, error)
+	// DeleteMovieTranslation Remove the text of a movie in a locale
+	DeleteMovieTranslation(context.Context, *DeleteMovieTranslationRequest) (*MovieItem, error)
+	// GetMovieByExternalId Find a movie by an external ID. EIDR IDs go without their 10.5240/
+	// prefix, e.g. /movies/by-external/eidr/7791-8534-2C23-9030-8610-5
+	GetMovieByExternalId(context.Context, *GetMovieByExternalIdRequest) (*MovieItem, error)
 	// GetRating Get aggregated rating for a movie
 	GetRating(context.Context, *GetRatingRequest) (*GetRatingReply, error)
+	// GetRatingHistory Get the rating change history for a movie (admin)
+	GetRatingHistory(context.Context, *GetRatingHistoryRequest) (*GetRatingHistoryReply, error)
 	// HealthCheck Health check
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckReply, error)
 	// ListMovies List movies with filters and pagination
 	ListMovies(context.Context, *ListMoviesRequest) (*ListMoviesReply, error)
+	// RemoveAlternateTitle Remove an alternate title of a movie
+	RemoveAlternateTitle(context.Context, *RemoveAlternateTitleRequest) (*MovieItem, error)
+	// SetMovieCredits Replace the cast and crew of a movie
+	SetMovieCredits(context.Context, *SetMovieCreditsRequest) (*MovieItem, error)
+	// SetMovieExternalId Set or replace the ID of a movie in an external scheme (imdb, tmdb, eidr)
+	SetMovieExternalId(context.Context, *SetMovieExternalIdRequest) (*MovieItem, error)
+	// SetMovieGenres Replace the genres of a movie; the first becomes its primary genre
+	SetMovieGenres(context.Context, *SetMovieGenresRequest) (*MovieItem, error)
+	// SetMovieTags Replace the tags of a movie
+	SetMovieTags(context.Context, *SetMovieTagsRequest) (*MovieItem, error)
+	// SetMovieTranslation Create or replace the synopsis and tagline of a movie in a locale
+	SetMovieTranslation(context.Context, *SetMovieTranslationRequest) (*MovieItem, error)
 	// SubmitRating Submit or update a rating for a movie
 	SubmitRating(context.Context, *SubmitRatingRequest) (*SubmitRatingReply, error)
 }
@@ -41,9 +91,24 @@ type MovieServiceHTTPServer interface {
 func RegisterMovieServiceHTTPServer(s *http.Server, srv MovieServiceHTTPServer) {
 	r := s.Route("/")
 	r.POST("/movies", _MovieService_CreateMovie0_HTTP_Handler(srv))
+	r.POST("/movies/import", _MovieService_BulkImportMovies0_HTTP_Handler(srv))
 	r.GET("/movies", _MovieService_ListMovies0_HTTP_Handler(srv))
+	r.POST("/movies/batch-get", _MovieService_BatchGetMovies0_HTTP_Handler(srv))
+	r.GET("/movies/by-external/{scheme}/{id}", _MovieService_GetMovieByExternalId0_HTTP_Handler(srv))
+	r.PUT("/movies/{title}/external-ids/{scheme}", _MovieService_SetMovieExternalId0_HTTP_Handler(srv))
+	r.DELETE("/movies/{title}/external-ids/{scheme}", _MovieService_DeleteMovieExternalId0_HTTP_Handler(srv))
+	r.PUT("/movies/{title}/credits", _MovieService_SetMovieCredits0_HTTP_Handler(srv))
+	r.PUT("/movies/{title}/genres", _MovieService_SetMovieGenres0_HTTP_Handler(srv))
+	r.PUT("/movies/{title}/tags", _MovieService_SetMovieTags0_HTTP_Handler(srv))
+	r.POST("/movies/{title}/alternate-titles", _MovieService_AddAlternateTitle0_HTTP_Handler(srv))
+	r.DELETE("/movies/{title}/alternate-titles/{id}", _MovieService_RemoveAlternateTitle0_HTTP_Handler(srv))
+	r.PUT("/movies/{title}/translations/{locale}", _MovieService_SetMovieTranslation0_HTTP_Handler(srv))
+	r.DELETE("/movies/{title}/translations/{locale}", _MovieService_DeleteMovieTranslation0_HTTP_Handler(srv))
+	r.DELETE("/movies/{id}/images/{image_id}", _MovieService_DeleteMovieImage0_HTTP_Handler(srv))
 	r.POST("/movies/{title}/ratings", _MovieService_SubmitRating0_HTTP_Handler(srv))
 	r.GET("/movies/{title}/rating", _MovieService_GetRating0_HTTP_Handler(srv))
+	r.POST("/movies/ratings/batch-get", _MovieService_BatchGetRatings0_HTTP_Handler(srv))
+	r.GET("/movies/{title}/ratings/history", _MovieService_GetRatingHistory0_HTTP_Handler(srv))
 	r.GET("/healthz", _MovieService_HealthCheck0_HTTP_Handler(srv))
 }
 
@@ -69,6 +134,28 @@ func _MovieService_CreateMovie0_HTTP_Handler(srv MovieServiceHTTPServer) func(ct
 	}
 }
 
+func _MovieService_BulkImportMovies0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BulkImportMoviesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMovieServiceBulkImportMovies)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BulkImportMovies(ctx, req.(*BulkImportMoviesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BulkImportMoviesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _MovieService_ListMovies0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ListMoviesRequest
@@ -88,6 +175,288 @@ func _MovieService_ListMovies0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx
 	}
 }
 
+func _MovieService_BatchGetMovies0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchGetMoviesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMovieServiceBatchGetMovies)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchGetMovies(ctx, req.(*BatchGetMoviesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchGetMoviesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MovieService_GetMovieByExternalId0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMovieByExternalIdRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMovieServiceGetMovieByExternalId)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMovieByExternalId(ctx, req.(*GetMovieByExternalIdRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MovieItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MovieService_SetMovieExternalId0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetMovieExternalIdRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMovieServiceSetMovieExternalId)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetMovieExternalId(ctx, req.(*SetMovieExternalIdRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MovieItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MovieService_DeleteMovieExternalId0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteMovieExternalIdRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMovieServiceDeleteMovieExternalId)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteMovieExternalId(ctx, req.(*DeleteMovieExternalIdRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MovieItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MovieService_SetMovieCredits0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetMovieCreditsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMovieServiceSetMovieCredits)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetMovieCredits(ctx, req.(*SetMovieCreditsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MovieItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MovieService_SetMovieGenres0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetMovieGenresRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMovieServiceSetMovieGenres)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetMovieGenres(ctx, req.(*SetMovieGenresRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MovieItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MovieService_SetMovieTags0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetMovieTagsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMovieServiceSetMovieTags)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetMovieTags(ctx, req.(*SetMovieTagsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MovieItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MovieService_AddAlternateTitle0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddAlternateTitleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMovieServiceAddAlternateTitle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddAlternateTitle(ctx, req.(*AddAlternateTitleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MovieItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MovieService_RemoveAlternateTitle0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveAlternateTitleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMovieServiceRemoveAlternateTitle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveAlternateTitle(ctx, req.(*RemoveAlternateTitleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MovieItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MovieService_SetMovieTranslation0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetMovieTranslationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMovieServiceSetMovieTranslation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetMovieTranslation(ctx, req.(*SetMovieTranslationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MovieItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MovieService_DeleteMovieTranslation0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteMovieTranslationRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMovieServiceDeleteMovieTranslation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteMovieTranslation(ctx, req.(*DeleteMovieTranslationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MovieItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MovieService_DeleteMovieImage0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteMovieImageRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMovieServiceDeleteMovieImage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteMovieImage(ctx, req.(*DeleteMovieImageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteMovieImageReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _MovieService_SubmitRating0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in SubmitRatingRequest
@@ -135,6 +504,50 @@ func _MovieService_GetRating0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx
 	}
 }
 
+func _MovieService_BatchGetRatings0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchGetRatingsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMovieServiceBatchGetRatings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchGetRatings(ctx, req.(*BatchGetRatingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchGetRatingsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _MovieService_GetRatingHistory0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRatingHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationMovieServiceGetRatingHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRatingHistory(ctx, req.(*GetRatingHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetRatingHistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _MovieService_HealthCheck0_HTTP_Handler(srv MovieServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in HealthCheckRequest
@@ -155,14 +568,49 @@ func _MovieService_HealthCheck0_HTTP_Handler(srv MovieServiceHTTPServer) func(ct
 }
 
 type MovieServiceHTTPClient interface {
+	// AddAlternateTitle Add another title the movie is known by (localized, original, working...)
+	AddAlternateTitle(ctx context.Context, req *AddAlternateTitleRequest, opts ...http.CallOption) (rsp *MovieItem, err error)
+	// BatchGetMovies Get up to 100 movies by title or ID in one call. Movies that are not
+	// found or whose title is ambiguous are reported per item.
+	BatchGetMovies(ctx context.Context, req *BatchGetMoviesRequest, opts ...http.CallOption) (rsp *BatchGetMoviesReply, err error)
+	// BatchGetRatings Get the aggregated ratings of up to 100 movies by title or ID in one
+	// call, reporting movies that are not found per item
+	BatchGetRatings(ctx context.Context, req *BatchGetRatingsRequest, opts ...http.CallOption) (rsp *BatchGetRatingsReply, err error)
+	// BulkImportMovies Create movies in bulk from a CSV or JSONL file, with a per-row report.
+	// Requests are bound by the server timeout; large catalogs with box-office
+	// enrichment are better loaded with the import command.
+	BulkImportMovies(ctx context.Context, req *BulkImportMoviesRequest, opts ...http.CallOption) (rsp *BulkImportMoviesReply, err error)
 	// CreateMovie Create a new movie
 	CreateMovie(ctx context.Context, req *CreateMovieRequest, opts ...http.CallOption) (rsp *CreateMovieReply, err error)
+	// DeleteMovieExternalId Remove the ID of a movie in an external scheme
+	DeleteMovieExternalId(ctx context.Context, req *DeleteMovieExternalIdRequest, opts ...http.CallOption) (rsp *MovieItem, err error)
+	// DeleteMovieImage Delete an image of a movie with its thumbnails
+	DeleteMovieImage(ctx context.Context, req *DeleteMovieImageRequest, opts ...http.CallOption) (rsp *DeleteMovieImageReply, err error)
+	// DeleteMovieTranslation Remove the text of a movie in a locale
+	DeleteMovieTranslation(ctx context.Context, req *DeleteMovieTranslationRequest, opts ...http.CallOption) (rsp *MovieItem, err error)
+	// GetMovieByExternalId Find a movie by an external ID. EIDR IDs go without their 10.5240/
+	// prefix, e.g. /movies/by-external/eidr/7791-8534-2C23-9030-8610-5
+	GetMovieByExternalId(ctx context.Context, req *GetMovieByExternalIdRequest, opts ...http.CallOption) (rsp *MovieItem, err error)
 	// GetRating Get aggregated rating for a movie
 	GetRating(ctx context.Context, req *GetRatingRequest, opts ...http.CallOption) (rsp *GetRatingReply, err error)
+	// GetRatingHistory Get the rating change history for a movie (admin)
+	GetRatingHistory(ctx context.Context, req *GetRatingHistoryRequest, opts ...http.CallOption) (rsp *GetRatingHistoryReply, err error)
 	// HealthCheck Health check
 	HealthCheck(ctx context.Context, req *HealthCheckRequest, opts ...http.CallOption) (rsp *HealthCheckReply, err error)
 	// ListMovies List movies with filters and pagination
 	ListMovies(ctx context.Context, req *ListMoviesRequest, opts ...http.CallOption) (rsp *ListMoviesReply, err error)
+	// RemoveAlternateTitle Remove an alternate title of a movie
+	RemoveAlternateTitle(ctx context.Context, req *RemoveAlternateTitleRequest, opts ...http.CallOption) (rsp *MovieItem, err error)
+	// SetMovieCredits Replace the cast and crew of a movie
+	SetMovieCredits(ctx context.Context, req *SetMovieCreditsRequest, opts ...http.CallOption) (rsp *MovieItem, err error)
+	// SetMovieExternalId Set or replace the ID of a movie in an external scheme (imdb, tmdb, eidr)
+	SetMovieExternalId(ctx context.Context, req *SetMovieExternalIdRequest, opts ...http.CallOption) (rsp *MovieItem, err error)
+	// SetMovieGenres Replace the genres of a movie; the first becomes its primary genre
+	SetMovieGenres(ctx context.Context, req *SetMovieGenresRequest, opts ...http.CallOption) (rsp *MovieItem, err error)
+	// SetMovieTags Replace the tags of a movie
+	SetMovieTags(ctx context.Context, req *SetMovieTagsRequest, opts ...http.CallOption) (rsp *MovieItem, err error)
+	// SetMovieTranslation Create or replace the synopsis and tagline of a movie in a locale
+	SetMovieTranslation(ctx context.Context, req *SetMovieTranslationRequest, opts ...http.CallOption) (rsp *MovieItem, err error)
 	// SubmitRating Submit or update a rating for a movie
 	SubmitRating(ctx context.Context, req *SubmitRatingRequest, opts ...http.CallOption) (rsp *SubmitRatingReply, err error)
 }
@@ -175,6 +623,66 @@ func NewMovieServiceHTTPClient(client *http.Client) MovieServiceHTTPClient {
 	return &MovieServiceHTTPClientImpl{client}
 }
 
+// AddAlternateTitle Add another title the movie is known by (localized, original, working...)
+func (c *MovieServiceHTTPClientImpl) AddAlternateTitle(ctx context.Context, in *AddAlternateTitleRequest, opts ...http.CallOption) (*MovieItem, error) {
+	var out MovieItem
+	pattern := "/movies/{title}/alternate-titles"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMovieServiceAddAlternateTitle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BatchGetMovies Get up to 100 movies by title or ID in one call. Movies that are not
+// found or whose title is ambiguous are reported per item.
+func (c *MovieServiceHTTPClientImpl) BatchGetMovies(ctx context.Context, in *BatchGetMoviesRequest, opts ...http.CallOption) (*BatchGetMoviesReply, error) {
+	var out BatchGetMoviesReply
+	pattern := "/movies/batch-get"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMovieServiceBatchGetMovies))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BatchGetRatings Get the aggregated ratings of up to 100 movies by title or ID in one
+// call, reporting movies that are not found per item
+func (c *MovieServiceHTTPClientImpl) BatchGetRatings(ctx context.Context, in *BatchGetRatingsRequest, opts ...http.CallOption) (*BatchGetRatingsReply, error) {
+	var out BatchGetRatingsReply
+	pattern := "/movies/ratings/batch-get"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMovieServiceBatchGetRatings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BulkImportMovies Create movies in bulk from a CSV or JSONL file, with a per-row report.
+// Requests are bound by the server timeout; large catalogs with box-office
+// enrichment are better loaded with the import command.
+func (c *MovieServiceHTTPClientImpl) BulkImportMovies(ctx context.Context, in *BulkImportMoviesRequest, opts ...http.CallOption) (*BulkImportMoviesReply, error) {
+	var out BulkImportMoviesReply
+	pattern := "/movies/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMovieServiceBulkImportMovies))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // CreateMovie Create a new movie
 func (c *MovieServiceHTTPClientImpl) CreateMovie(ctx context.Context, in *CreateMovieRequest, opts ...http.CallOption) (*CreateMovieReply, error) {
 	var out CreateMovieReply
@@ -189,6 +697,63 @@ func (c *MovieServiceHTTPClientImpl) CreateMovie(ctx context.Context, in *Create
 	return &out, nil
 }
 
+// DeleteMovieExternalId Remove the ID of a movie in an external scheme
+func (c *MovieServiceHTTPClientImpl) DeleteMovieExternalId(ctx context.Context, in *DeleteMovieExternalIdRequest, opts ...http.CallOption) (*MovieItem, error) {
+	var out MovieItem
+	pattern := "/movies/{title}/external-ids/{scheme}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMovieServiceDeleteMovieExternalId))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteMovieImage Delete an image of a movie with its thumbnails
+func (c *MovieServiceHTTPClientImpl) DeleteMovieImage(ctx context.Context, in *DeleteMovieImageRequest, opts ...http.CallOption) (*DeleteMovieImageReply, error) {
+	var out DeleteMovieImageReply
+	pattern := "/movies/{id}/images/{image_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMovieServiceDeleteMovieImage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteMovieTranslation Remove the text of a movie in a locale
+func (c *MovieServiceHTTPClientImpl) DeleteMovieTranslation(ctx context.Context, in *DeleteMovieTranslationRequest, opts ...http.CallOption) (*MovieItem, error) {
+	var out MovieItem
+	pattern := "/movies/{title}/translations/{locale}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMovieServiceDeleteMovieTranslation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMovieByExternalId Find a movie by an external ID. EIDR IDs go without their 10.5240/
+// prefix, e.g. /movies/by-external/eidr/7791-8534-2C23-9030-8610-5
+func (c *MovieServiceHTTPClientImpl) GetMovieByExternalId(ctx context.Context, in *GetMovieByExternalIdRequest, opts ...http.CallOption) (*MovieItem, error) {
+	var out MovieItem
+	pattern := "/movies/by-external/{scheme}/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMovieServiceGetMovieByExternalId))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetRating Get aggregated rating for a movie
 func (c *MovieServiceHTTPClientImpl) GetRating(ctx context.Context, in *GetRatingRequest, opts ...http.CallOption) (*GetRatingReply, error) {
 	var out GetRatingReply
@@ -203,6 +768,20 @@ func (c *MovieServiceHTTPClientImpl) GetRating(ctx context.Context, in *GetRatin
 	return &out, nil
 }
 
+// GetRatingHistory Get the rating change history for a movie (admin)
+func (c *MovieServiceHTTPClientImpl) GetRatingHistory(ctx context.Context, in *GetRatingHistoryRequest, opts ...http.CallOption) (*GetRatingHistoryReply, error) {
+	var out GetRatingHistoryReply
+	pattern := "/movies/{title}/ratings/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMovieServiceGetRatingHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // HealthCheck Health check
 func (c *MovieServiceHTTPClientImpl) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...http.CallOption) (*HealthCheckReply, error) {
 	var out HealthCheckReply
@@ -231,6 +810,90 @@ func (c *MovieServiceHTTPClientImpl) ListMovies(ctx context.Context, in *ListMov
 	return &out, nil
 }
 
+// RemoveAlternateTitle Remove an alternate title of a movie
+func (c *MovieServiceHTTPClientImpl) RemoveAlternateTitle(ctx context.Context, in *RemoveAlternateTitleRequest, opts ...http.CallOption) (*MovieItem, error) {
+	var out MovieItem
+	pattern := "/movies/{title}/alternate-titles/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationMovieServiceRemoveAlternateTitle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetMovieCredits Replace the cast and crew of a movie
+func (c *MovieServiceHTTPClientImpl) SetMovieCredits(ctx context.Context, in *SetMovieCreditsRequest, opts ...http.CallOption) (*MovieItem, error) {
+	var out MovieItem
+	pattern := "/movies/{title}/credits"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMovieServiceSetMovieCredits))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetMovieExternalId Set or replace the ID of a movie in an external scheme (imdb, tmdb, eidr)
+func (c *MovieServiceHTTPClientImpl) SetMovieExternalId(ctx context.Context, in *SetMovieExternalIdRequest, opts ...http.CallOption) (*MovieItem, error) {
+	var out MovieItem
+	pattern := "/movies/{title}/external-ids/{scheme}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMovieServiceSetMovieExternalId))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetMovieGenres Replace the genres of a movie; the first becomes its primary genre
+func (c *MovieServiceHTTPClientImpl) SetMovieGenres(ctx context.Context, in *SetMovieGenresRequest, opts ...http.CallOption) (*MovieItem, error) {
+	var out MovieItem
+	pattern := "/movies/{title}/genres"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMovieServiceSetMovieGenres))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetMovieTags Replace the tags of a movie
+func (c *MovieServiceHTTPClientImpl) SetMovieTags(ctx context.Context, in *SetMovieTagsRequest, opts ...http.CallOption) (*MovieItem, error) {
+	var out MovieItem
+	pattern := "/movies/{title}/tags"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMovieServiceSetMovieTags))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetMovieTranslation Create or replace the synopsis and tagline of a movie in a locale
+func (c *MovieServiceHTTPClientImpl) SetMovieTranslation(ctx context.Context, in *SetMovieTranslationRequest, opts ...http.CallOption) (*MovieItem, error) {
+	var out MovieItem
+	pattern := "/movies/{title}/translations/{locale}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationMovieServiceSetMovieTranslation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // SubmitRating Submit or update a rating for a movie
 func (c *MovieServiceHTTPClientImpl) SubmitRating(ctx context.Context, in *SubmitRatingRequest, opts ...http.CallOption) (*SubmitRatingReply, error) {
 	var out SubmitRatingReply
@@ -0,0 +1,791 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
+// source: movie/v1/person.proto
+
+package v1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Person struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BirthDate     *string                `protobuf:"bytes,3,opt,name=birth_date,json=birthDate,proto3,oneof" json:"birth_date,omitempty"` // YYYY-MM-DD format
+	Biography     *string                `protobuf:"bytes,4,opt,name=biography,proto3,oneof" json:"biography,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Person) Reset() {
+	*x = Person{}
+	mi := &file_movie_v1_person_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Person) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Person) ProtoMessage() {}
+
+func (x *Person) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_person_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Person.ProtoReflect.Descriptor instead.
+func (*Person) Descriptor() ([]byte, []int) {
+	return file_movie_v1_person_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Person) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Person) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Person) GetBirthDate() string {
+	if x != nil && x.BirthDate != nil {
+		return *x.BirthDate
+	}
+	return ""
+}
+
+func (x *Person) GetBiography() string {
+	if x != nil && x.Biography != nil {
+		return *x.Biography
+	}
+	return ""
+}
+
+func (x *Person) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Person) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Messages for CreatePerson
+type CreatePersonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BirthDate     *string                `protobuf:"bytes,2,opt,name=birth_date,json=birthDate,proto3,oneof" json:"birth_date,omitempty"` // YYYY-MM-DD format
+	Biography     *string                `protobuf:"bytes,3,opt,name=biography,proto3,oneof" json:"biography,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePersonRequest) Reset() {
+	*x = CreatePersonRequest{}
+	mi := &file_movie_v1_person_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePersonRequest) ProtoMessage() {}
+
+func (x *CreatePersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_person_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePersonRequest.ProtoReflect.Descriptor instead.
+func (*CreatePersonRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_person_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePersonRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePersonRequest) GetBirthDate() string {
+	if x != nil && x.BirthDate != nil {
+		return *x.BirthDate
+	}
+	return ""
+}
+
+func (x *CreatePersonRequest) GetBiography() string {
+	if x != nil && x.Biography != nil {
+		return *x.Biography
+	}
+	return ""
+}
+
+// Messages for GetPerson
+type GetPersonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPersonRequest) Reset() {
+	*x = GetPersonRequest{}
+	mi := &file_movie_v1_person_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPersonRequest) ProtoMessage() {}
+
+func (x *GetPersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_person_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPersonRequest.ProtoReflect.Descriptor instead.
+func (*GetPersonRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_person_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPersonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Messages for UpdatePerson
+type UpdatePersonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // from path
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	BirthDate     *string                `protobuf:"bytes,3,opt,name=birth_date,json=birthDate,proto3,oneof" json:"birth_date,omitempty"` // YYYY-MM-DD format
+	Biography     *string                `protobuf:"bytes,4,opt,name=biography,proto3,oneof" json:"biography,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePersonRequest) Reset() {
+	*x = UpdatePersonRequest{}
+	mi := &file_movie_v1_person_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePersonRequest) ProtoMessage() {}
+
+func (x *UpdatePersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_person_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePersonRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePersonRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_person_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdatePersonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdatePersonRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePersonRequest) GetBirthDate() string {
+	if x != nil && x.BirthDate != nil {
+		return *x.BirthDate
+	}
+	return ""
+}
+
+func (x *UpdatePersonRequest) GetBiography() string {
+	if x != nil && x.Biography != nil {
+		return *x.Biography
+	}
+	return ""
+}
+
+// Messages for DeletePerson
+type DeletePersonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePersonRequest) Reset() {
+	*x = DeletePersonRequest{}
+	mi := &file_movie_v1_person_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePersonRequest) ProtoMessage() {}
+
+func (x *DeletePersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_person_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePersonRequest.ProtoReflect.Descriptor instead.
+func (*DeletePersonRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_person_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeletePersonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeletePersonReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePersonReply) Reset() {
+	*x = DeletePersonReply{}
+	mi := &file_movie_v1_person_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePersonReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePersonReply) ProtoMessage() {}
+
+func (x *DeletePersonReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_person_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePersonReply.ProtoReflect.Descriptor instead.
+func (*DeletePersonReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_person_proto_rawDescGZIP(), []int{5}
+}
+
+// Messages for ListPeople
+type ListPeopleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Q             *string                `protobuf:"bytes,1,opt,name=q,proto3,oneof" json:"q,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor        *string                `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPeopleRequest) Reset() {
+	*x = ListPeopleRequest{}
+	mi := &file_movie_v1_person_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPeopleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeopleRequest) ProtoMessage() {}
+
+func (x *ListPeopleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_person_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeopleRequest.ProtoReflect.Descriptor instead.
+func (*ListPeopleRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_person_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListPeopleRequest) GetQ() string {
+	if x != nil && x.Q != nil {
+		return *x.Q
+	}
+	return ""
+}
+
+func (x *ListPeopleRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListPeopleRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+type ListPeopleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Person              `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPeopleReply) Reset() {
+	*x = ListPeopleReply{}
+	mi := &file_movie_v1_person_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPeopleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeopleReply) ProtoMessage() {}
+
+func (x *ListPeopleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_person_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeopleReply.ProtoReflect.Descriptor instead.
+func (*ListPeopleReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_person_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListPeopleReply) GetItems() []*Person {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListPeopleReply) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+// Messages for ListPersonMovies
+type ListPersonMoviesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPersonMoviesRequest) Reset() {
+	*x = ListPersonMoviesRequest{}
+	mi := &file_movie_v1_person_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPersonMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPersonMoviesRequest) ProtoMessage() {}
+
+func (x *ListPersonMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_person_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPersonMoviesRequest.ProtoReflect.Descriptor instead.
+func (*ListPersonMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_person_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListPersonMoviesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListPersonMoviesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PersonMovie         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPersonMoviesReply) Reset() {
+	*x = ListPersonMoviesReply{}
+	mi := &file_movie_v1_person_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPersonMoviesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPersonMoviesReply) ProtoMessage() {}
+
+func (x *ListPersonMoviesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_person_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPersonMoviesReply.ProtoReflect.Descriptor instead.
+func (*ListPersonMoviesReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_person_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListPersonMoviesReply) GetItems() []*PersonMovie {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type PersonMovie struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movie         *MovieItem             `protobuf:"bytes,1,opt,name=movie,proto3" json:"movie,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	CharacterName *string                `protobuf:"bytes,3,opt,name=character_name,json=characterName,proto3,oneof" json:"character_name,omitempty"`
+	BillingOrder  *int32                 `protobuf:"varint,4,opt,name=billing_order,json=billingOrder,proto3,oneof" json:"billing_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonMovie) Reset() {
+	*x = PersonMovie{}
+	mi := &file_movie_v1_person_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonMovie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonMovie) ProtoMessage() {}
+
+func (x *PersonMovie) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_person_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonMovie.ProtoReflect.Descriptor instead.
+func (*PersonMovie) Descriptor() ([]byte, []int) {
+	return file_movie_v1_person_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PersonMovie) GetMovie() *MovieItem {
+	if x != nil {
+		return x.Movie
+	}
+	return nil
+}
+
+func (x *PersonMovie) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PersonMovie) GetCharacterName() string {
+	if x != nil && x.CharacterName != nil {
+		return *x.CharacterName
+	}
+	return ""
+}
+
+func (x *PersonMovie) GetBillingOrder() int32 {
+	if x != nil && x.BillingOrder != nil {
+		return *x.BillingOrder
+	}
+	return 0
+}
+
+var File_movie_v1_person_proto protoreflect.FileDescriptor
+
+const file_movie_v1_person_proto_rawDesc = "" +
+	"\n" +
+	"\x15movie/v1/person.proto\x12\fapi.movie.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14movie/v1/movie.proto\"\x86\x02\n" +
+	"\x06Person\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\"\n" +
+	"\n" +
+	"birth_date\x18\x03 \x01(\tH\x00R\tbirthDate\x88\x01\x01\x12!\n" +
+	"\tbiography\x18\x04 \x01(\tH\x01R\tbiography\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\r\n" +
+	"\v_birth_dateB\f\n" +
+	"\n" +
+	"_biography\"\x8d\x01\n" +
+	"\x13CreatePersonRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\"\n" +
+	"\n" +
+	"birth_date\x18\x02 \x01(\tH\x00R\tbirthDate\x88\x01\x01\x12!\n" +
+	"\tbiography\x18\x03 \x01(\tH\x01R\tbiography\x88\x01\x01B\r\n" +
+	"\v_birth_dateB\f\n" +
+	"\n" +
+	"_biography\"\"\n" +
+	"\x10GetPersonRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xab\x01\n" +
+	"\x13UpdatePersonRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"birth_date\x18\x03 \x01(\tH\x01R\tbirthDate\x88\x01\x01\x12!\n" +
+	"\tbiography\x18\x04 \x01(\tH\x02R\tbiography\x88\x01\x01B\a\n" +
+	"\x05_nameB\r\n" +
+	"\v_birth_dateB\f\n" +
+	"\n" +
+	"_biography\"%\n" +
+	"\x13DeletePersonRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x13\n" +
+	"\x11DeletePersonReply\"y\n" +
+	"\x11ListPeopleRequest\x12\x11\n" +
+	"\x01q\x18\x01 \x01(\tH\x00R\x01q\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x05H\x01R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x02R\x06cursor\x88\x01\x01B\x04\n" +
+	"\x02_qB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursor\"s\n" +
+	"\x0fListPeopleReply\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.api.movie.v1.PersonR\x05items\x12$\n" +
+	"\vnext_cursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\")\n" +
+	"\x17ListPersonMoviesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
+	"\x15ListPersonMoviesReply\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.api.movie.v1.PersonMovieR\x05items\"\xcb\x01\n" +
+	"\vPersonMovie\x12-\n" +
+	"\x05movie\x18\x01 \x01(\v2\x17.api.movie.v1.MovieItemR\x05movie\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12*\n" +
+	"\x0echaracter_name\x18\x03 \x01(\tH\x00R\rcharacterName\x88\x01\x01\x12(\n" +
+	"\rbilling_order\x18\x04 \x01(\x05H\x01R\fbillingOrder\x88\x01\x01B\x11\n" +
+	"\x0f_character_nameB\x10\n" +
+	"\x0e_billing_order2\xed\x04\n" +
+	"\rPersonService\x12[\n" +
+	"\fCreatePerson\x12!.api.movie.v1.CreatePersonRequest\x1a\x14.api.movie.v1.Person\"\x12\x82\xd3\xe4\x93\x02\f:\x01*\"\a/people\x12W\n" +
+	"\tGetPerson\x12\x1e.api.movie.v1.GetPersonRequest\x1a\x14.api.movie.v1.Person\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/people/{id}\x12`\n" +
+	"\fUpdatePerson\x12!.api.movie.v1.UpdatePersonRequest\x1a\x14.api.movie.v1.Person\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*2\f/people/{id}\x12h\n" +
+	"\fDeletePerson\x12!.api.movie.v1.DeletePersonRequest\x1a\x1f.api.movie.v1.DeletePersonReply\"\x14\x82\xd3\xe4\x93\x02\x0e*\f/people/{id}\x12]\n" +
+	"\n" +
+	"ListPeople\x12\x1f.api.movie.v1.ListPeopleRequest\x1a\x1d.api.movie.v1.ListPeopleReply\"\x0f\x82\xd3\xe4\x93\x02\t\x12\a/people\x12{\n" +
+	"\x10ListPersonMovies\x12%.api.movie.v1.ListPersonMoviesRequest\x1a#.api.movie.v1.ListPersonMoviesReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/people/{id}/moviesB\x1cZ\x1aRobin-Camp/api/movie/v1;v1b\x06proto3"
+
+var (
+	file_movie_v1_person_proto_rawDescOnce sync.Once
+	file_movie_v1_person_proto_rawDescData []byte
+)
+
+func file_movie_v1_person_proto_rawDescGZIP() []byte {
+	file_movie_v1_person_proto_rawDescOnce.Do(func() {
+		file_movie_v1_person_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_movie_v1_person_proto_rawDesc), len(file_movie_v1_person_proto_rawDesc)))
+	})
+	return file_movie_v1_person_proto_rawDescData
+}
+
+var file_movie_v1_person_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_movie_v1_person_proto_goTypes = []any{
+	(*Person)(nil),                  // 0: api.movie.v1.Person
+	(*CreatePersonRequest)(nil),     // 1: api.movie.v1.CreatePersonRequest
+	(*GetPersonRequest)(nil),        // 2: api.movie.v1.GetPersonRequest
+	(*UpdatePersonRequest)(nil),     // 3: api.movie.v1.UpdatePersonRequest
+	(*DeletePersonRequest)(nil),     // 4: api.movie.v1.DeletePersonRequest
+	(*DeletePersonReply)(nil),       // 5: api.movie.v1.DeletePersonReply
+	(*ListPeopleRequest)(nil),       // 6: api.movie.v1.ListPeopleRequest
+	(*ListPeopleReply)(nil),         // 7: api.movie.v1.ListPeopleReply
+	(*ListPersonMoviesRequest)(nil), // 8: api.movie.v1.ListPersonMoviesRequest
+	(*ListPersonMoviesReply)(nil),   // 9: api.movie.v1.ListPersonMoviesReply
+	(*PersonMovie)(nil),             // 10: api.movie.v1.PersonMovie
+	(*timestamppb.Timestamp)(nil),   // 11: google.protobuf.Timestamp
+	(*MovieItem)(nil),               // 12: api.movie.v1.MovieItem
+}
+var file_movie_v1_person_proto_depIdxs = []int32{
+	11, // 0: api.movie.v1.Person.created_at:type_name -> google.protobuf.Timestamp
+	11, // 1: api.movie.v1.Person.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: api.movie.v1.ListPeopleReply.items:type_name -> api.movie.v1.Person
+	10, // 3: api.movie.v1.ListPersonMoviesReply.items:type_name -> api.movie.v1.PersonMovie
+	12, // 4: api.movie.v1.PersonMovie.movie:type_name -> api.movie.v1.MovieItem
+	1,  // 5: api.movie.v1.PersonService.CreatePerson:input_type -> api.movie.v1.CreatePersonRequest
+	2,  // 6: api.movie.v1.PersonService.GetPerson:input_type -> api.movie.v1.GetPersonRequest
+	3,  // 7: api.movie.v1.PersonService.UpdatePerson:input_type -> api.movie.v1.UpdatePersonRequest
+	4,  // 8: api.movie.v1.PersonService.DeletePerson:input_type -> api.movie.v1.DeletePersonRequest
+	6,  // 9: api.movie.v1.PersonService.ListPeople:input_type -> api.movie.v1.ListPeopleRequest
+	8,  // 10: api.movie.v1.PersonService.ListPersonMovies:input_type -> api.movie.v1.ListPersonMoviesRequest
+	0,  // 11: api.movie.v1.PersonService.CreatePerson:output_type -> api.movie.v1.Person
+	0,  // 12: api.movie.v1.PersonService.GetPerson:output_type -> api.movie.v1.Person
+	0,  // 13: api.movie.v1.PersonService.UpdatePerson:output_type -> api.movie.v1.Person
+	5,  // 14: api.movie.v1.PersonService.DeletePerson:output_type -> api.movie.v1.DeletePersonReply
+	7,  // 15: api.movie.v1.PersonService.ListPeople:output_type -> api.movie.v1.ListPeopleReply
+	9,  // 16: api.movie.v1.PersonService.ListPersonMovies:output_type -> api.movie.v1.ListPersonMoviesReply
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_movie_v1_person_proto_init() }
+func file_movie_v1_person_proto_init() {
+	if File_movie_v1_person_proto != nil {
+		return
+	}
+	file_movie_v1_movie_proto_init()
+	file_movie_v1_person_proto_msgTypes[0].OneofWrappers = []any{}
+	file_movie_v1_person_proto_msgTypes[1].OneofWrappers = []any{}
+	file_movie_v1_person_proto_msgTypes[3].OneofWrappers = []any{}
+	file_movie_v1_person_proto_msgTypes[6].OneofWrappers = []any{}
+	file_movie_v1_person_proto_msgTypes[7].OneofWrappers = []any{}
+	file_movie_v1_person_proto_msgTypes[10].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_movie_v1_person_proto_rawDesc), len(file_movie_v1_person_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_movie_v1_person_proto_goTypes,
+		DependencyIndexes: file_movie_v1_person_proto_depIdxs,
+		MessageInfos:      file_movie_v1_person_proto_msgTypes,
+	}.Build()
+	File_movie_v1_person_proto = out.File
+	file_movie_v1_person_proto_goTypes = nil
+	file_movie_v1_person_proto_depIdxs = nil
+}
@@ -0,0 +1,1370 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: movie/v1/person.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Person with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Person) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Person with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PersonMultiError, or nil if none found.
+func (m *Person) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Person) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PersonValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PersonValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PersonValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PersonValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PersonValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PersonValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.BirthDate != nil {
+		// no validation rules for BirthDate
+	}
+
+	if m.Biography != nil {
+		// no validation rules for Biography
+	}
+
+	if len(errors) > 0 {
+		return PersonMultiError(errors)
+	}
+
+	return nil
+}
+
+// PersonMultiError is an error wrapping multiple validation errors returned by
+// Person.ValidateAll() if the designated constraints aren't met.
+type PersonMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PersonMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PersonMultiError) AllErrors() []error { return m }
+
+// PersonValidationError is the validation error returned by Person.Validate if
+// the designated constraints aren't met.
+type PersonValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PersonValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PersonValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PersonValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PersonValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PersonValidationError) ErrorName() string { return "PersonValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PersonValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPerson.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PersonValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PersonValidationError{}
+
+// Validate checks the field values on CreatePersonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePersonRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePersonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePersonRequestMultiError, or nil if none found.
+func (m *CreatePersonRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePersonRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if m.BirthDate != nil {
+		// no validation rules for BirthDate
+	}
+
+	if m.Biography != nil {
+		// no validation rules for Biography
+	}
+
+	if len(errors) > 0 {
+		return CreatePersonRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePersonRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePersonRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePersonRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePersonRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePersonRequestMultiError) AllErrors() []error { return m }
+
+// CreatePersonRequestValidationError is the validation error returned by
+// CreatePersonRequest.Validate if the designated constraints aren't met.
+type CreatePersonRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePersonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePersonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePersonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePersonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePersonRequestValidationError) ErrorName() string {
+	return "CreatePersonRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePersonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePersonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePersonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePersonRequestValidationError{}
+
+// Validate checks the field values on GetPersonRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetPersonRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPersonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPersonRequestMultiError, or nil if none found.
+func (m *GetPersonRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPersonRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetPersonRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPersonRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPersonRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetPersonRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPersonRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPersonRequestMultiError) AllErrors() []error { return m }
+
+// GetPersonRequestValidationError is the validation error returned by
+// GetPersonRequest.Validate if the designated constraints aren't met.
+type GetPersonRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPersonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPersonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPersonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPersonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPersonRequestValidationError) ErrorName() string { return "GetPersonRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetPersonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPersonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPersonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPersonRequestValidationError{}
+
+// Validate checks the field values on UpdatePersonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePersonRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePersonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePersonRequestMultiError, or nil if none found.
+func (m *UpdatePersonRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePersonRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if m.BirthDate != nil {
+		// no validation rules for BirthDate
+	}
+
+	if m.Biography != nil {
+		// no validation rules for Biography
+	}
+
+	if len(errors) > 0 {
+		return UpdatePersonRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePersonRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePersonRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePersonRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePersonRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePersonRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePersonRequestValidationError is the validation error returned by
+// UpdatePersonRequest.Validate if the designated constraints aren't met.
+type UpdatePersonRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePersonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePersonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePersonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePersonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePersonRequestValidationError) ErrorName() string {
+	return "UpdatePersonRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePersonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePersonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePersonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePersonRequestValidationError{}
+
+// Validate checks the field values on DeletePersonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePersonRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePersonRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePersonRequestMultiError, or nil if none found.
+func (m *DeletePersonRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePersonRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeletePersonRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePersonRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePersonRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePersonRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePersonRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePersonRequestMultiError) AllErrors() []error { return m }
+
+// DeletePersonRequestValidationError is the validation error returned by
+// DeletePersonRequest.Validate if the designated constraints aren't met.
+type DeletePersonRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePersonRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePersonRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePersonRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePersonRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePersonRequestValidationError) ErrorName() string {
+	return "DeletePersonRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePersonRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePersonRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePersonRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePersonRequestValidationError{}
+
+// Validate checks the field values on DeletePersonReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeletePersonReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePersonReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePersonReplyMultiError, or nil if none found.
+func (m *DeletePersonReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePersonReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeletePersonReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePersonReplyMultiError is an error wrapping multiple validation errors
+// returned by DeletePersonReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeletePersonReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePersonReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePersonReplyMultiError) AllErrors() []error { return m }
+
+// DeletePersonReplyValidationError is the validation error returned by
+// DeletePersonReply.Validate if the designated constraints aren't met.
+type DeletePersonReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePersonReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePersonReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePersonReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePersonReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePersonReplyValidationError) ErrorName() string {
+	return "DeletePersonReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePersonReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePersonReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePersonReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePersonReplyValidationError{}
+
+// Validate checks the field values on ListPeopleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListPeopleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPeopleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPeopleRequestMultiError, or nil if none found.
+func (m *ListPeopleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPeopleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Q != nil {
+		// no validation rules for Q
+	}
+
+	if m.Limit != nil {
+		// no validation rules for Limit
+	}
+
+	if m.Cursor != nil {
+		// no validation rules for Cursor
+	}
+
+	if len(errors) > 0 {
+		return ListPeopleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPeopleRequestMultiError is an error wrapping multiple validation errors
+// returned by ListPeopleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListPeopleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPeopleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPeopleRequestMultiError) AllErrors() []error { return m }
+
+// ListPeopleRequestValidationError is the validation error returned by
+// ListPeopleRequest.Validate if the designated constraints aren't met.
+type ListPeopleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPeopleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPeopleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPeopleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPeopleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPeopleRequestValidationError) ErrorName() string {
+	return "ListPeopleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPeopleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPeopleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPeopleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPeopleRequestValidationError{}
+
+// Validate checks the field values on ListPeopleReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListPeopleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPeopleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPeopleReplyMultiError, or nil if none found.
+func (m *ListPeopleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPeopleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPeopleReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPeopleReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPeopleReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.NextCursor != nil {
+		// no validation rules for NextCursor
+	}
+
+	if len(errors) > 0 {
+		return ListPeopleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPeopleReplyMultiError is an error wrapping multiple validation errors
+// returned by ListPeopleReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListPeopleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPeopleReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPeopleReplyMultiError) AllErrors() []error { return m }
+
+// ListPeopleReplyValidationError is the validation error returned by
+// ListPeopleReply.Validate if the designated constraints aren't met.
+type ListPeopleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPeopleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPeopleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPeopleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPeopleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPeopleReplyValidationError) ErrorName() string { return "ListPeopleReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListPeopleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPeopleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPeopleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPeopleReplyValidationError{}
+
+// Validate checks the field values on ListPersonMoviesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPersonMoviesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPersonMoviesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPersonMoviesRequestMultiError, or nil if none found.
+func (m *ListPersonMoviesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPersonMoviesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ListPersonMoviesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPersonMoviesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPersonMoviesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPersonMoviesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPersonMoviesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPersonMoviesRequestMultiError) AllErrors() []error { return m }
+
+// ListPersonMoviesRequestValidationError is the validation error returned by
+// ListPersonMoviesRequest.Validate if the designated constraints aren't met.
+type ListPersonMoviesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPersonMoviesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPersonMoviesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPersonMoviesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPersonMoviesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPersonMoviesRequestValidationError) ErrorName() string {
+	return "ListPersonMoviesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPersonMoviesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPersonMoviesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPersonMoviesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPersonMoviesRequestValidationError{}
+
+// Validate checks the field values on ListPersonMoviesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPersonMoviesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPersonMoviesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPersonMoviesReplyMultiError, or nil if none found.
+func (m *ListPersonMoviesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPersonMoviesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPersonMoviesReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPersonMoviesReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPersonMoviesReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPersonMoviesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPersonMoviesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListPersonMoviesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListPersonMoviesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPersonMoviesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPersonMoviesReplyMultiError) AllErrors() []error { return m }
+
+// ListPersonMoviesReplyValidationError is the validation error returned by
+// ListPersonMoviesReply.Validate if the designated constraints aren't met.
+type ListPersonMoviesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPersonMoviesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPersonMoviesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPersonMoviesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPersonMoviesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPersonMoviesReplyValidationError) ErrorName() string {
+	return "ListPersonMoviesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPersonMoviesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPersonMoviesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPersonMoviesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPersonMoviesReplyValidationError{}
+
+// Validate checks the field values on PersonMovie with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PersonMovie) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PersonMovie with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PersonMovieMultiError, or
+// nil if none found.
+func (m *PersonMovie) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PersonMovie) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMovie()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PersonMovieValidationError{
+					field:  "Movie",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PersonMovieValidationError{
+					field:  "Movie",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMovie()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PersonMovieValidationError{
+				field:  "Movie",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Role
+
+	if m.CharacterName != nil {
+		// no validation rules for CharacterName
+	}
+
+	if m.BillingOrder != nil {
+		// no validation rules for BillingOrder
+	}
+
+	if len(errors) > 0 {
+		return PersonMovieMultiError(errors)
+	}
+
+	return nil
+}
+
+// PersonMovieMultiError is an error wrapping multiple validation errors
+// returned by PersonMovie.ValidateAll() if the designated constraints aren't met.
+type PersonMovieMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PersonMovieMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PersonMovieMultiError) AllErrors() []error { return m }
+
+// PersonMovieValidationError is the validation error returned by
+// PersonMovie.Validate if the designated constraints aren't met.
+type PersonMovieValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PersonMovieValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PersonMovieValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PersonMovieValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PersonMovieValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PersonMovieValidationError) ErrorName() string { return "PersonMovieValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PersonMovieValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPersonMovie.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PersonMovieValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PersonMovieValidationError{}
@@ -0,0 +1,511 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
+// source: movie/v1/ranking.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Messages for ListTopRated and ListPopular
+type ListRankingRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Window *string                `protobuf:"bytes,1,opt,name=window,proto3,oneof" json:"window,omitempty"` // 7d, 30d, 365d or all (default)
+	Limit  *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// Segments; when several are given a movie must match all of them
+	Genre         *string `protobuf:"bytes,3,opt,name=genre,proto3,oneof" json:"genre,omitempty"`
+	Year          *int32  `protobuf:"varint,4,opt,name=year,proto3,oneof" json:"year,omitempty"`
+	MpaRating     *string `protobuf:"bytes,5,opt,name=mpa_rating,json=mpaRating,proto3,oneof" json:"mpa_rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRankingRequest) Reset() {
+	*x = ListRankingRequest{}
+	mi := &file_movie_v1_ranking_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRankingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRankingRequest) ProtoMessage() {}
+
+func (x *ListRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_ranking_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRankingRequest.ProtoReflect.Descriptor instead.
+func (*ListRankingRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_ranking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListRankingRequest) GetWindow() string {
+	if x != nil && x.Window != nil {
+		return *x.Window
+	}
+	return ""
+}
+
+func (x *ListRankingRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListRankingRequest) GetGenre() string {
+	if x != nil && x.Genre != nil {
+		return *x.Genre
+	}
+	return ""
+}
+
+func (x *ListRankingRequest) GetYear() int32 {
+	if x != nil && x.Year != nil {
+		return *x.Year
+	}
+	return 0
+}
+
+func (x *ListRankingRequest) GetMpaRating() string {
+	if x != nil && x.MpaRating != nil {
+		return *x.MpaRating
+	}
+	return ""
+}
+
+type ListRankingReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        string                 `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	Items         []*RankingItem         `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRankingReply) Reset() {
+	*x = ListRankingReply{}
+	mi := &file_movie_v1_ranking_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRankingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRankingReply) ProtoMessage() {}
+
+func (x *ListRankingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_ranking_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRankingReply.ProtoReflect.Descriptor instead.
+func (*ListRankingReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_ranking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRankingReply) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *ListRankingReply) GetItems() []*RankingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RankingItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Average       float64                `protobuf:"fixed64,3,opt,name=average,proto3" json:"average,omitempty"`
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RankingItem) Reset() {
+	*x = RankingItem{}
+	mi := &file_movie_v1_ranking_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankingItem) ProtoMessage() {}
+
+func (x *RankingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_ranking_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankingItem.ProtoReflect.Descriptor instead.
+func (*RankingItem) Descriptor() ([]byte, []int) {
+	return file_movie_v1_ranking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RankingItem) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *RankingItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RankingItem) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *RankingItem) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Messages for ListTrending
+type ListTrendingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Genre         *string                `protobuf:"bytes,1,opt,name=genre,proto3,oneof" json:"genre,omitempty"`
+	Year          *int32                 `protobuf:"varint,2,opt,name=year,proto3,oneof" json:"year,omitempty"`
+	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingRequest) Reset() {
+	*x = ListTrendingRequest{}
+	mi := &file_movie_v1_ranking_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingRequest) ProtoMessage() {}
+
+func (x *ListTrendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_ranking_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingRequest.ProtoReflect.Descriptor instead.
+func (*ListTrendingRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_ranking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTrendingRequest) GetGenre() string {
+	if x != nil && x.Genre != nil {
+		return *x.Genre
+	}
+	return ""
+}
+
+func (x *ListTrendingRequest) GetYear() int32 {
+	if x != nil && x.Year != nil {
+		return *x.Year
+	}
+	return 0
+}
+
+func (x *ListTrendingRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListTrendingReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TrendingItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingReply) Reset() {
+	*x = ListTrendingReply{}
+	mi := &file_movie_v1_ranking_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingReply) ProtoMessage() {}
+
+func (x *ListTrendingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_ranking_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingReply.ProtoReflect.Descriptor instead.
+func (*ListTrendingReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_ranking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTrendingReply) GetItems() []*TrendingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type TrendingItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Score         float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"` // Decayed rating activity; each rating counts 1 when new
+	Average       float64                `protobuf:"fixed64,4,opt,name=average,proto3" json:"average,omitempty"`
+	Count         int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingItem) Reset() {
+	*x = TrendingItem{}
+	mi := &file_movie_v1_ranking_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingItem) ProtoMessage() {}
+
+func (x *TrendingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_ranking_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingItem.ProtoReflect.Descriptor instead.
+func (*TrendingItem) Descriptor() ([]byte, []int) {
+	return file_movie_v1_ranking_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TrendingItem) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TrendingItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TrendingItem) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TrendingItem) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *TrendingItem) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_movie_v1_ranking_proto protoreflect.FileDescriptor
+
+const file_movie_v1_ranking_proto_rawDesc = "" +
+	"\n" +
+	"\x16movie/v1/ranking.proto\x12\fapi.movie.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\x84\x02\n" +
+	"\x12ListRankingRequest\x129\n" +
+	"\x06window\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17R\x027dR\x0330dR\x04365dR\x03all\xd0\x01\x01H\x00R\x06window\x88\x01\x01\x12$\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01H\x01R\x05limit\x88\x01\x01\x12\x19\n" +
+	"\x05genre\x18\x03 \x01(\tH\x02R\x05genre\x88\x01\x01\x12\x17\n" +
+	"\x04year\x18\x04 \x01(\x05H\x03R\x04year\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"mpa_rating\x18\x05 \x01(\tH\x04R\tmpaRating\x88\x01\x01B\t\n" +
+	"\a_windowB\b\n" +
+	"\x06_limitB\b\n" +
+	"\x06_genreB\a\n" +
+	"\x05_yearB\r\n" +
+	"\v_mpa_rating\"[\n" +
+	"\x10ListRankingReply\x12\x16\n" +
+	"\x06window\x18\x01 \x01(\tR\x06window\x12/\n" +
+	"\x05items\x18\x02 \x03(\v2\x19.api.movie.v1.RankingItemR\x05items\"g\n" +
+	"\vRankingItem\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\aaverage\x18\x03 \x01(\x01R\aaverage\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\"\x8c\x01\n" +
+	"\x13ListTrendingRequest\x12\x19\n" +
+	"\x05genre\x18\x01 \x01(\tH\x00R\x05genre\x88\x01\x01\x12\x17\n" +
+	"\x04year\x18\x02 \x01(\x05H\x01R\x04year\x88\x01\x01\x12$\n" +
+	"\x05limit\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01H\x02R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_genreB\a\n" +
+	"\x05_yearB\b\n" +
+	"\x06_limit\"E\n" +
+	"\x11ListTrendingReply\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.api.movie.v1.TrendingItemR\x05items\"~\n" +
+	"\fTrendingItem\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\x12\x18\n" +
+	"\aaverage\x18\x04 \x01(\x01R\aaverage\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x05R\x05count2\xd5\x02\n" +
+	"\x0eRankingService\x12g\n" +
+	"\fListTopRated\x12 .api.movie.v1.ListRankingRequest\x1a\x1e.api.movie.v1.ListRankingReply\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/rankings/top\x12j\n" +
+	"\vListPopular\x12 .api.movie.v1.ListRankingRequest\x1a\x1e.api.movie.v1.ListRankingReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/rankings/popular\x12n\n" +
+	"\fListTrending\x12!.api.movie.v1.ListTrendingRequest\x1a\x1f.api.movie.v1.ListTrendingReply\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/rankings/trendingB\x1cZ\x1aRobin-Camp/api/movie/v1;v1b\x06proto3"
+
+var (
+	file_movie_v1_ranking_proto_rawDescOnce sync.Once
+	file_movie_v1_ranking_proto_rawDescData []byte
+)
+
+func file_movie_v1_ranking_proto_rawDescGZIP() []byte {
+	file_movie_v1_ranking_proto_rawDescOnce.Do(func() {
+		file_movie_v1_ranking_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_movie_v1_ranking_proto_rawDesc), len(file_movie_v1_ranking_proto_rawDesc)))
+	})
+	return file_movie_v1_ranking_proto_rawDescData
+}
+
+var file_movie_v1_ranking_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_movie_v1_ranking_proto_goTypes = []any{
+	(*ListRankingRequest)(nil),  // 0: api.movie.v1.ListRankingRequest
+	(*ListRankingReply)(nil),    // 1: api.movie.v1.ListRankingReply
+	(*RankingItem)(nil),         // 2: api.movie.v1.RankingItem
+	(*ListTrendingRequest)(nil), // 3: api.movie.v1.ListTrendingRequest
+	(*ListTrendingReply)(nil),   // 4: api.movie.v1.ListTrendingReply
+	(*TrendingItem)(nil),        // 5: api.movie.v1.TrendingItem
+}
+var file_movie_v1_ranking_proto_depIdxs = []int32{
+	2, // 0: api.movie.v1.ListRankingReply.items:type_name -> api.movie.v1.RankingItem
+	5, // 1: api.movie.v1.ListTrendingReply.items:type_name -> api.movie.v1.TrendingItem
+	0, // 2: api.movie.v1.RankingService.ListTopRated:input_type -> api.movie.v1.ListRankingRequest
+	0, // 3: api.movie.v1.RankingService.ListPopular:input_type -> api.movie.v1.ListRankingRequest
+	3, // 4: api.movie.v1.RankingService.ListTrending:input_type -> api.movie.v1.ListTrendingRequest
+	1, // 5: api.movie.v1.RankingService.ListTopRated:output_type -> api.movie.v1.ListRankingReply
+	1, // 6: api.movie.v1.RankingService.ListPopular:output_type -> api.movie.v1.ListRankingReply
+	4, // 7: api.movie.v1.RankingService.ListTrending:output_type -> api.movie.v1.ListTrendingReply
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_movie_v1_ranking_proto_init() }
+func file_movie_v1_ranking_proto_init() {
+	if File_movie_v1_ranking_proto != nil {
+		return
+	}
+	file_movie_v1_ranking_proto_msgTypes[0].OneofWrappers = []any{}
+	file_movie_v1_ranking_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_movie_v1_ranking_proto_rawDesc), len(file_movie_v1_ranking_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_movie_v1_ranking_proto_goTypes,
+		DependencyIndexes: file_movie_v1_ranking_proto_depIdxs,
+		MessageInfos:      file_movie_v1_ranking_proto_msgTypes,
+	}.Build()
+	File_movie_v1_ranking_proto = out.File
+	file_movie_v1_ranking_proto_goTypes = nil
+	file_movie_v1_ranking_proto_depIdxs = nil
+}
@@ -0,0 +1,804 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: movie/v1/ranking.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListRankingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRankingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRankingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRankingRequestMultiError, or nil if none found.
+func (m *ListRankingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRankingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Window != nil {
+
+		if m.GetWindow() != "" {
+
+			if _, ok := _ListRankingRequest_Window_InLookup[m.GetWindow()]; !ok {
+				err := ListRankingRequestValidationError{
+					field:  "Window",
+					reason: "value must be in list [7d 30d 365d all]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val < 1 || val > 100 {
+			err := ListRankingRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Genre != nil {
+		// no validation rules for Genre
+	}
+
+	if m.Year != nil {
+		// no validation rules for Year
+	}
+
+	if m.MpaRating != nil {
+		// no validation rules for MpaRating
+	}
+
+	if len(errors) > 0 {
+		return ListRankingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRankingRequestMultiError is an error wrapping multiple validation errors
+// returned by ListRankingRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListRankingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRankingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRankingRequestMultiError) AllErrors() []error { return m }
+
+// ListRankingRequestValidationError is the validation error returned by
+// ListRankingRequest.Validate if the designated constraints aren't met.
+type ListRankingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRankingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRankingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRankingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRankingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRankingRequestValidationError) ErrorName() string {
+	return "ListRankingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRankingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRankingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRankingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRankingRequestValidationError{}
+
+var _ListRankingRequest_Window_InLookup = map[string]struct{}{
+	"7d":   {},
+	"30d":  {},
+	"365d": {},
+	"all":  {},
+}
+
+// Validate checks the field values on ListRankingReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRankingReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRankingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRankingReplyMultiError, or nil if none found.
+func (m *ListRankingReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRankingReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Window
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRankingReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRankingReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRankingReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRankingReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRankingReplyMultiError is an error wrapping multiple validation errors
+// returned by ListRankingReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListRankingReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRankingReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRankingReplyMultiError) AllErrors() []error { return m }
+
+// ListRankingReplyValidationError is the validation error returned by
+// ListRankingReply.Validate if the designated constraints aren't met.
+type ListRankingReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRankingReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRankingReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRankingReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRankingReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRankingReplyValidationError) ErrorName() string { return "ListRankingReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRankingReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRankingReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRankingReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRankingReplyValidationError{}
+
+// Validate checks the field values on RankingItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RankingItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RankingItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RankingItemMultiError, or
+// nil if none found.
+func (m *RankingItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RankingItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Rank
+
+	// no validation rules for Title
+
+	// no validation rules for Average
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return RankingItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// RankingItemMultiError is an error wrapping multiple validation errors
+// returned by RankingItem.ValidateAll() if the designated constraints aren't met.
+type RankingItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RankingItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RankingItemMultiError) AllErrors() []error { return m }
+
+// RankingItemValidationError is the validation error returned by
+// RankingItem.Validate if the designated constraints aren't met.
+type RankingItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RankingItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RankingItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RankingItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RankingItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RankingItemValidationError) ErrorName() string { return "RankingItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RankingItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRankingItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RankingItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RankingItemValidationError{}
+
+// Validate checks the field values on ListTrendingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTrendingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTrendingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTrendingRequestMultiError, or nil if none found.
+func (m *ListTrendingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTrendingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Genre != nil {
+		// no validation rules for Genre
+	}
+
+	if m.Year != nil {
+		// no validation rules for Year
+	}
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val < 1 || val > 100 {
+			err := ListTrendingRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTrendingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTrendingRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTrendingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTrendingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTrendingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTrendingRequestMultiError) AllErrors() []error { return m }
+
+// ListTrendingRequestValidationError is the validation error returned by
+// ListTrendingRequest.Validate if the designated constraints aren't met.
+type ListTrendingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTrendingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTrendingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTrendingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTrendingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTrendingRequestValidationError) ErrorName() string {
+	return "ListTrendingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTrendingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTrendingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTrendingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTrendingRequestValidationError{}
+
+// Validate checks the field values on ListTrendingReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTrendingReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTrendingReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTrendingReplyMultiError, or nil if none found.
+func (m *ListTrendingReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTrendingReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTrendingReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTrendingReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTrendingReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTrendingReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTrendingReplyMultiError is an error wrapping multiple validation errors
+// returned by ListTrendingReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListTrendingReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTrendingReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTrendingReplyMultiError) AllErrors() []error { return m }
+
+// ListTrendingReplyValidationError is the validation error returned by
+// ListTrendingReply.Validate if the designated constraints aren't met.
+type ListTrendingReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTrendingReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTrendingReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTrendingReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTrendingReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTrendingReplyValidationError) ErrorName() string {
+	return "ListTrendingReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTrendingReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTrendingReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTrendingReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTrendingReplyValidationError{}
+
+// Validate checks the field values on TrendingItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TrendingItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrendingItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TrendingItemMultiError, or
+// nil if none found.
+func (m *TrendingItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrendingItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Rank
+
+	// no validation rules for Title
+
+	// no validation rules for Score
+
+	// no validation rules for Average
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return TrendingItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrendingItemMultiError is an error wrapping multiple validation errors
+// returned by TrendingItem.ValidateAll() if the designated constraints aren't met.
+type TrendingItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrendingItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrendingItemMultiError) AllErrors() []error { return m }
+
+// TrendingItemValidationError is the validation error returned by
+// TrendingItem.Validate if the designated constraints aren't met.
+type TrendingItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrendingItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrendingItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrendingItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrendingItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrendingItemValidationError) ErrorName() string { return "TrendingItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrendingItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrendingItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrendingItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrendingItemValidationError{}
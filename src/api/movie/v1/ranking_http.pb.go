@@ -0,0 +1,156 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v6.32.1
+// source: movie/v1/ranking.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationRankingServiceListPopular = "/api.movie.v1.RankingService/ListPopular"
+const OperationRankingServiceListTopRated = "/api.movie.v1.RankingService/ListTopRated"
+const OperationRankingServiceListTrending = "/api.movie.v1.RankingService/ListTrending"
+
+type RankingServiceHTTPServer interface {
+	// ListPopular List most popular movies (by rating count)
+	ListPopular(context.Context, *ListRankingRequest) (*ListRankingReply, error)
+	// ListTopRated List top-rated movies (by average rating)
+	ListTopRated(context.Context, *ListRankingRequest) (*ListRankingReply, error)
+	// ListTrending List trending movies (by time-decayed rating activity)
+	ListTrending(context.Context, *ListTrendingRequest) (*ListTrendingReply, error)
+}
+
+func RegisterRankingServiceHTTPServer(s *http.Server, srv RankingServiceHTTPServer) {
+	r := s.Route("/")
+	r.GET("/rankings/top", _RankingService_ListTopRated0_HTTP_Handler(srv))
+	r.GET("/rankings/popular", _RankingService_ListPopular0_HTTP_Handler(srv))
+	r.GET("/rankings/trending", _RankingService_ListTrending0_HTTP_Handler(srv))
+}
+
+func _RankingService_ListTopRated0_HTTP_Handler(srv RankingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRankingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRankingServiceListTopRated)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTopRated(ctx, req.(*ListRankingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRankingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RankingService_ListPopular0_HTTP_Handler(srv RankingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRankingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRankingServiceListPopular)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPopular(ctx, req.(*ListRankingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRankingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RankingService_ListTrending0_HTTP_Handler(srv RankingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTrendingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRankingServiceListTrending)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTrending(ctx, req.(*ListTrendingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTrendingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type RankingServiceHTTPClient interface {
+	// ListPopular List most popular movies (by rating count)
+	ListPopular(ctx context.Context, req *ListRankingRequest, opts ...http.CallOption) (rsp *ListRankingReply, err error)
+	// ListTopRated List top-rated movies (by average rating)
+	ListTopRated(ctx context.Context, req *ListRankingRequest, opts ...http.CallOption) (rsp *ListRankingReply, err error)
+	// ListTrending List trending movies (by time-decayed rating activity)
+	ListTrending(ctx context.Context, req *ListTrendingRequest, opts ...http.CallOption) (rsp *ListTrendingReply, err error)
+}
+
+type RankingServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewRankingServiceHTTPClient(client *http.Client) RankingServiceHTTPClient {
+	return &RankingServiceHTTPClientImpl{client}
+}
+
+// ListPopular List most popular movies (by rating count)
+func (c *RankingServiceHTTPClientImpl) ListPopular(ctx context.Context, in *ListRankingRequest, opts ...http.CallOption) (*ListRankingReply, error) {
+	var out ListRankingReply
+	pattern := "/rankings/popular"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRankingServiceListPopular))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTopRated List top-rated movies (by average rating)
+func (c *RankingServiceHTTPClientImpl) ListTopRated(ctx context.Context, in *ListRankingRequest, opts ...http.CallOption) (*ListRankingReply, error) {
+	var out ListRankingReply
+	pattern := "/rankings/top"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRankingServiceListTopRated))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTrending List trending movies (by time-decayed rating activity)
+func (c *RankingServiceHTTPClientImpl) ListTrending(ctx context.Context, in *ListTrendingRequest, opts ...http.CallOption) (*ListTrendingReply, error) {
+	var out ListTrendingReply
+	pattern := "/rankings/trending"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRankingServiceListTrending))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,421 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
+// source: movie/v1/recommendation.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Messages for GetSimilarMovies
+type GetSimilarMoviesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSimilarMoviesRequest) Reset() {
+	*x = GetSimilarMoviesRequest{}
+	mi := &file_movie_v1_recommendation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimilarMoviesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarMoviesRequest) ProtoMessage() {}
+
+func (x *GetSimilarMoviesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_recommendation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarMoviesRequest.ProtoReflect.Descriptor instead.
+func (*GetSimilarMoviesRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_recommendation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetSimilarMoviesRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetSimilarMoviesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type GetSimilarMoviesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*SimilarMovieItem    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSimilarMoviesReply) Reset() {
+	*x = GetSimilarMoviesReply{}
+	mi := &file_movie_v1_recommendation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimilarMoviesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarMoviesReply) ProtoMessage() {}
+
+func (x *GetSimilarMoviesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_recommendation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSimilarMoviesReply.ProtoReflect.Descriptor instead.
+func (*GetSimilarMoviesReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_recommendation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSimilarMoviesReply) GetItems() []*SimilarMovieItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type SimilarMovieItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Similarity    float64                `protobuf:"fixed64,2,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	CoRaters      int32                  `protobuf:"varint,3,opt,name=co_raters,json=coRaters,proto3" json:"co_raters,omitempty"` // Raters who rated both movies; 0 for content-based matches
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`                      // collaborative or content
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarMovieItem) Reset() {
+	*x = SimilarMovieItem{}
+	mi := &file_movie_v1_recommendation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarMovieItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarMovieItem) ProtoMessage() {}
+
+func (x *SimilarMovieItem) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_recommendation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarMovieItem.ProtoReflect.Descriptor instead.
+func (*SimilarMovieItem) Descriptor() ([]byte, []int) {
+	return file_movie_v1_recommendation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SimilarMovieItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SimilarMovieItem) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *SimilarMovieItem) GetCoRaters() int32 {
+	if x != nil {
+		return x.CoRaters
+	}
+	return 0
+}
+
+func (x *SimilarMovieItem) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// Messages for GetRecommendations
+type GetRecommendationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         *int32                 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsRequest) Reset() {
+	*x = GetRecommendationsRequest{}
+	mi := &file_movie_v1_recommendation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsRequest) ProtoMessage() {}
+
+func (x *GetRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_recommendation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_movie_v1_recommendation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRecommendationsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type GetRecommendationsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*RecommendationItem  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsReply) Reset() {
+	*x = GetRecommendationsReply{}
+	mi := &file_movie_v1_recommendation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsReply) ProtoMessage() {}
+
+func (x *GetRecommendationsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_recommendation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsReply.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsReply) Descriptor() ([]byte, []int) {
+	return file_movie_v1_recommendation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRecommendationsReply) GetItems() []*RecommendationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RecommendationItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	PredictedRating float64                `protobuf:"fixed64,2,opt,name=predicted_rating,json=predictedRating,proto3" json:"predicted_rating,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RecommendationItem) Reset() {
+	*x = RecommendationItem{}
+	mi := &file_movie_v1_recommendation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationItem) ProtoMessage() {}
+
+func (x *RecommendationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_movie_v1_recommendation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationItem.ProtoReflect.Descriptor instead.
+func (*RecommendationItem) Descriptor() ([]byte, []int) {
+	return file_movie_v1_recommendation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RecommendationItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RecommendationItem) GetPredictedRating() float64 {
+	if x != nil {
+		return x.PredictedRating
+	}
+	return 0
+}
+
+var File_movie_v1_recommendation_proto protoreflect.FileDescriptor
+
+const file_movie_v1_recommendation_proto_rawDesc = "" +
+	"\n" +
+	"\x1dmovie/v1/recommendation.proto\x12\fapi.movie.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"_\n" +
+	"\x17GetSimilarMoviesRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12$\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"M\n" +
+	"\x15GetSimilarMoviesReply\x124\n" +
+	"\x05items\x18\x01 \x03(\v2\x1e.api.movie.v1.SimilarMovieItemR\x05items\"}\n" +
+	"\x10SimilarMovieItem\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x02 \x01(\x01R\n" +
+	"similarity\x12\x1b\n" +
+	"\tco_raters\x18\x03 \x01(\x05R\bcoRaters\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\"K\n" +
+	"\x19GetRecommendationsRequest\x12$\n" +
+	"\x05limit\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"Q\n" +
+	"\x17GetRecommendationsReply\x126\n" +
+	"\x05items\x18\x01 \x03(\v2 .api.movie.v1.RecommendationItemR\x05items\"U\n" +
+	"\x12RecommendationItem\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12)\n" +
+	"\x10predicted_rating\x18\x02 \x01(\x01R\x0fpredictedRating2\xa3\x02\n" +
+	"\x15RecommendationService\x12\x7f\n" +
+	"\x10GetSimilarMovies\x12%.api.movie.v1.GetSimilarMoviesRequest\x1a#.api.movie.v1.GetSimilarMoviesReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/movies/{title}/similar\x12\x88\x01\n" +
+	"\x12GetRecommendations\x12'.api.movie.v1.GetRecommendationsRequest\x1a%.api.movie.v1.GetRecommendationsReply\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/raters/me/recommendationsB\x1cZ\x1aRobin-Camp/api/movie/v1;v1b\x06proto3"
+
+var (
+	file_movie_v1_recommendation_proto_rawDescOnce sync.Once
+	file_movie_v1_recommendation_proto_rawDescData []byte
+)
+
+func file_movie_v1_recommendation_proto_rawDescGZIP() []byte {
+	file_movie_v1_recommendation_proto_rawDescOnce.Do(func() {
+		file_movie_v1_recommendation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_movie_v1_recommendation_proto_rawDesc), len(file_movie_v1_recommendation_proto_rawDesc)))
+	})
+	return file_movie_v1_recommendation_proto_rawDescData
+}
+
+var file_movie_v1_recommendation_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_movie_v1_recommendation_proto_goTypes = []any{
+	(*GetSimilarMoviesRequest)(nil),   // 0: api.movie.v1.GetSimilarMoviesRequest
+	(*GetSimilarMoviesReply)(nil),     // 1: api.movie.v1.GetSimilarMoviesReply
+	(*SimilarMovieItem)(nil),          // 2: api.movie.v1.SimilarMovieItem
+	(*GetRecommendationsRequest)(nil), // 3: api.movie.v1.GetRecommendationsRequest
+	(*GetRecommendationsReply)(nil),   // 4: api.movie.v1.GetRecommendationsReply
+	(*RecommendationItem)(nil),        // 5: api.movie.v1.RecommendationItem
+}
+var file_movie_v1_recommendation_proto_depIdxs = []int32{
+	2, // 0: api.movie.v1.GetSimilarMoviesReply.items:type_name -> api.movie.v1.SimilarMovieItem
+	5, // 1: api.movie.v1.GetRecommendationsReply.items:type_name -> api.movie.v1.RecommendationItem
+	0, // 2: api.movie.v1.RecommendationService.GetSimilarMovies:input_type -> api.movie.v1.GetSimilarMoviesRequest
+	3, // 3: api.movie.v1.RecommendationService.GetRecommendations:input_type -> api.movie.v1.GetRecommendationsRequest
+	1, // 4: api.movie.v1.RecommendationService.GetSimilarMovies:output_type -> api.movie.v1.GetSimilarMoviesReply
+	4, // 5: api.movie.v1.RecommendationService.GetRecommendations:output_type -> api.movie.v1.GetRecommendationsReply
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_movie_v1_recommendation_proto_init() }
+func file_movie_v1_recommendation_proto_init() {
+	if File_movie_v1_recommendation_proto != nil {
+		return
+	}
+	file_movie_v1_recommendation_proto_msgTypes[0].OneofWrappers = []any{}
+	file_movie_v1_recommendation_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_movie_v1_recommendation_proto_rawDesc), len(file_movie_v1_recommendation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_movie_v1_recommendation_proto_goTypes,
+		DependencyIndexes: file_movie_v1_recommendation_proto_depIdxs,
+		MessageInfos:      file_movie_v1_recommendation_proto_msgTypes,
+	}.Build()
+	File_movie_v1_recommendation_proto = out.File
+	file_movie_v1_recommendation_proto_goTypes = nil
+	file_movie_v1_recommendation_proto_depIdxs = nil
+}
@@ -0,0 +1,758 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: movie/v1/recommendation.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetSimilarMoviesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSimilarMoviesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSimilarMoviesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSimilarMoviesRequestMultiError, or nil if none found.
+func (m *GetSimilarMoviesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSimilarMoviesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val < 1 || val > 100 {
+			err := GetSimilarMoviesRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSimilarMoviesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSimilarMoviesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSimilarMoviesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSimilarMoviesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSimilarMoviesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSimilarMoviesRequestMultiError) AllErrors() []error { return m }
+
+// GetSimilarMoviesRequestValidationError is the validation error returned by
+// GetSimilarMoviesRequest.Validate if the designated constraints aren't met.
+type GetSimilarMoviesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSimilarMoviesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSimilarMoviesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSimilarMoviesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSimilarMoviesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSimilarMoviesRequestValidationError) ErrorName() string {
+	return "GetSimilarMoviesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSimilarMoviesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSimilarMoviesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSimilarMoviesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSimilarMoviesRequestValidationError{}
+
+// Validate checks the field values on GetSimilarMoviesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSimilarMoviesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSimilarMoviesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSimilarMoviesReplyMultiError, or nil if none found.
+func (m *GetSimilarMoviesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSimilarMoviesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSimilarMoviesReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSimilarMoviesReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSimilarMoviesReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSimilarMoviesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSimilarMoviesReplyMultiError is an error wrapping multiple validation
+// errors returned by GetSimilarMoviesReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetSimilarMoviesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSimilarMoviesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSimilarMoviesReplyMultiError) AllErrors() []error { return m }
+
+// GetSimilarMoviesReplyValidationError is the validation error returned by
+// GetSimilarMoviesReply.Validate if the designated constraints aren't met.
+type GetSimilarMoviesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSimilarMoviesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSimilarMoviesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSimilarMoviesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSimilarMoviesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSimilarMoviesReplyValidationError) ErrorName() string {
+	return "GetSimilarMoviesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSimilarMoviesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSimilarMoviesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSimilarMoviesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSimilarMoviesReplyValidationError{}
+
+// Validate checks the field values on SimilarMovieItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SimilarMovieItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SimilarMovieItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SimilarMovieItemMultiError, or nil if none found.
+func (m *SimilarMovieItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SimilarMovieItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Similarity
+
+	// no validation rules for CoRaters
+
+	// no validation rules for Source
+
+	if len(errors) > 0 {
+		return SimilarMovieItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// SimilarMovieItemMultiError is an error wrapping multiple validation errors
+// returned by SimilarMovieItem.ValidateAll() if the designated constraints
+// aren't met.
+type SimilarMovieItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SimilarMovieItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SimilarMovieItemMultiError) AllErrors() []error { return m }
+
+// SimilarMovieItemValidationError is the validation error returned by
+// SimilarMovieItem.Validate if the designated constraints aren't met.
+type SimilarMovieItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SimilarMovieItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SimilarMovieItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SimilarMovieItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SimilarMovieItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SimilarMovieItemValidationError) ErrorName() string { return "SimilarMovieItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SimilarMovieItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSimilarMovieItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SimilarMovieItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SimilarMovieItemValidationError{}
+
+// Validate checks the field values on GetRecommendationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRecommendationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRecommendationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRecommendationsRequestMultiError, or nil if none found.
+func (m *GetRecommendationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRecommendationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Limit != nil {
+
+		if val := m.GetLimit(); val < 1 || val > 100 {
+			err := GetRecommendationsRequestValidationError{
+				field:  "Limit",
+				reason: "value must be inside range [1, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetRecommendationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRecommendationsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRecommendationsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetRecommendationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRecommendationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRecommendationsRequestMultiError) AllErrors() []error { return m }
+
+// GetRecommendationsRequestValidationError is the validation error returned by
+// GetRecommendationsRequest.Validate if the designated constraints aren't met.
+type GetRecommendationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRecommendationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRecommendationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRecommendationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRecommendationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRecommendationsRequestValidationError) ErrorName() string {
+	return "GetRecommendationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRecommendationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRecommendationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRecommendationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRecommendationsRequestValidationError{}
+
+// Validate checks the field values on GetRecommendationsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRecommendationsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRecommendationsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRecommendationsReplyMultiError, or nil if none found.
+func (m *GetRecommendationsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRecommendationsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRecommendationsReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRecommendationsReplyValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRecommendationsReplyValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetRecommendationsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRecommendationsReplyMultiError is an error wrapping multiple validation
+// errors returned by GetRecommendationsReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetRecommendationsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRecommendationsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRecommendationsReplyMultiError) AllErrors() []error { return m }
+
+// GetRecommendationsReplyValidationError is the validation error returned by
+// GetRecommendationsReply.Validate if the designated constraints aren't met.
+type GetRecommendationsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRecommendationsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRecommendationsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRecommendationsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRecommendationsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRecommendationsReplyValidationError) ErrorName() string {
+	return "GetRecommendationsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRecommendationsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRecommendationsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRecommendationsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRecommendationsReplyValidationError{}
+
+// Validate checks the field values on RecommendationItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendationItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendationItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendationItemMultiError, or nil if none found.
+func (m *RecommendationItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendationItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for PredictedRating
+
+	if len(errors) > 0 {
+		return RecommendationItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendationItemMultiError is an error wrapping multiple validation errors
+// returned by RecommendationItem.ValidateAll() if the designated constraints
+// aren't met.
+type RecommendationItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendationItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendationItemMultiError) AllErrors() []error { return m }
+
+// RecommendationItemValidationError is the validation error returned by
+// RecommendationItem.Validate if the designated constraints aren't met.
+type RecommendationItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendationItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendationItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendationItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendationItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendationItemValidationError) ErrorName() string {
+	return "RecommendationItemValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendationItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendationItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendationItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendationItemValidationError{}
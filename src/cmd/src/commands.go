@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"src/internal/biz"
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// commands are maintenance subcommands, run as `src -conf <path> <command> [flags]`.
+// Without a command the API servers are started.
+var commands = map[string]func(bc *conf.Bootstrap, args []string, logger log.Logger) error{
+	"rebuild-rankings":     rebuildRankings,
+	"compute-similarities": computeSimilarities,
+	"train-recommender":    trainRecommender,
+	"evaluate-recommender": evaluateRecommender,
+	"import":               importMovies,
+}
+
+// rebuildRankings regenerates every leaderboard and segment from the database
+func rebuildRankings(bc *conf.Bootstrap, args []string, logger log.Logger) error {
+	rankingUC, cleanup, err := wireRankingUseCase(bc.Data, bc.Trending, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	_, err = rankingUC.RebuildRankings(context.Background())
+	return err
+}
+
+// computeSimilarities recomputes the similar-movie table once
+func computeSimilarities(bc *conf.Bootstrap, args []string, logger log.Logger) error {
+	similarityUC, cleanup, err := wireSimilarityUseCase(bc.Data, bc.Similarity, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	return similarityUC.ComputeSimilarities(context.Background())
+}
+
+// trainRecommender trains the recommendation model on all ratings and stores it;
+// running servers pick it up on their next reload check
+func trainRecommender(bc *conf.Bootstrap, args []string, logger log.Logger) error {
+	recommendationUC, cleanup, err := wireRecommendationUseCase(bc.Data, bc.Recommender, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	return recommendationUC.TrainModel(context.Background())
+}
+
+// evaluateRecommender reports RMSE and precision@K on a held-out split of the ratings
+func evaluateRecommender(bc *conf.Bootstrap, args []string, logger log.Logger) error {
+	fs := flag.NewFlagSet("evaluate-recommender", flag.ExitOnError)
+	holdout := fs.Float64("holdout", 0.2, "fraction of ratings held out for testing")
+	k := fs.Int("k", 10, "recommendations per rater for precision@K")
+	seed := fs.Uint64("seed", 1, "random seed for the split and training")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	recommendationUC, cleanup, err := wireRecommendationUseCase(bc.Data, bc.Recommender, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	result, err := recommendationUC.Evaluate(context.Background(), *holdout, *k, *seed)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("train ratings:   %d\n", result.TrainRatings)
+	fmt.Printf("test ratings:    %d\n", result.TestRatings)
+	fmt.Printf("RMSE:            %.4f\n", result.RMSE)
+	fmt.Printf("precision@%d:    %.4f (over %d raters)\n", result.K, result.PrecisionAtK, result.EvaluatedRaters)
+	return nil
+}
+
+// importMovies creates movies in bulk from a CSV or JSONL file and prints a
+// report of the rows that were not created
+func importMovies(bc *conf.Bootstrap, args []string, logger log.Logger) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	format := fs.String("format", "", "csv or jsonl; defaults to the file extension")
+	mode := fs.String("mode", string(biz.ImportSkipExisting), "what to do with existing movies: skip or upsert")
+	dryRun := fs.Bool("dry-run", false, "validate and report without writing anything")
+	batchSize := fs.Int("batch-size", biz.DefaultImportBatchSize, "rows per transaction")
+	boxOffice := fs.Bool("box-office", false, "fetch box office data for created and updated movies")
+	boxOfficeRate := fs.Float64("box-office-rate", biz.DefaultBoxOfficeRate, "box-office requests per second")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: import [flags] <file.csv|file.jsonl>")
+	}
+	path := fs.Arg(0)
+	if *format == "" {
+		*format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	movieUC, cleanup, err := wireMovieUseCase(bc.Data, bc.Boxoffice, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	report, err := movieUC.ImportMovies(context.Background(), file, biz.ImportOptions{
+		Format:        biz.ImportFormat(*format),
+		Mode:          biz.ImportMode(*mode),
+		DryRun:        *dryRun,
+		BatchSize:     *batchSize,
+		BoxOffice:     *boxOffice,
+		BoxOfficeRate: *boxOfficeRate,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, row := range report.Rows {
+		if row.Status == biz.ImportFailed {
+			fmt.Printf("line %d: %s: %s\n", row.Line, row.Title, row.Error)
+		}
+	}
+	if report.DryRun {
+		fmt.Println("dry run, nothing was written")
+	}
+	fmt.Printf("created: %d\n", report.Created)
+	fmt.Printf("updated: %d\n", report.Updated)
+	fmt.Printf("skipped: %d\n", report.Skipped)
+	fmt.Printf("failed:  %d\n", report.Failed)
+	return nil
+}
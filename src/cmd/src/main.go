@@ -2,9 +2,11 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
 
 	"src/internal/conf"
+	"src/internal/server"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -33,7 +35,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, js *server.JobServer) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -43,6 +45,7 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			js,
 		),
 	)
 }
@@ -91,7 +94,19 @@ func main() {
 		bc.Boxoffice.ApiKey = boxOfficeKey
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Boxoffice, bc.Auth, logger)
+	// Run a maintenance command instead of the servers if one is given
+	if name := flag.Arg(0); name != "" {
+		command, ok := commands[name]
+		if !ok {
+			panic(fmt.Sprintf("unknown command %q", name))
+		}
+		if err := command(&bc, flag.Args()[1:], logger); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Boxoffice, bc.Auth, bc.Moderation, bc.Anomaly, bc.Ratelimit, bc.Trending, bc.Similarity, bc.Recommender, bc.Media, bc.Idempotency, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -18,6 +18,26 @@ import (
 )
 
 // wireApp init kratos application.
-func wireApp(*conf.Server, *conf.Data, *conf.BoxOffice, *conf.Auth, log.Logger) (*kratos.App, func(), error) {
+func wireApp(*conf.Server, *conf.Data, *conf.BoxOffice, *conf.Auth, *conf.Moderation, *conf.AnomalyDetection, *conf.RateLimit, *conf.Trending, *conf.Similarity, *conf.Recommender, *conf.Media, *conf.Idempotency, log.Logger) (*kratos.App, func(), error) {
 	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
 }
+
+// wireRankingUseCase init the ranking use case for maintenance commands.
+func wireRankingUseCase(*conf.Data, *conf.Trending, log.Logger) (*biz.RankingUseCase, func(), error) {
+	panic(wire.Build(data.ProviderSet, biz.ProviderSet))
+}
+
+// wireSimilarityUseCase init the similarity use case for maintenance commands.
+func wireSimilarityUseCase(*conf.Data, *conf.Similarity, log.Logger) (*biz.SimilarityUseCase, func(), error) {
+	panic(wire.Build(data.ProviderSet, biz.ProviderSet))
+}
+
+// wireRecommendationUseCase init the recommendation use case for maintenance commands.
+func wireRecommendationUseCase(*conf.Data, *conf.Recommender, log.Logger) (*biz.RecommendationUseCase, func(), error) {
+	panic(wire.Build(data.ProviderSet, biz.ProviderSet))
+}
+
+// wireMovieUseCase init the movie use case for maintenance commands.
+func wireMovieUseCase(*conf.Data, *conf.BoxOffice, log.Logger) (*biz.MovieUseCase, func(), error) {
+	panic(wire.Build(data.ProviderSet, biz.ProviderSet))
+}
@@ -23,21 +23,117 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, boxOffice *conf.BoxOffice, auth *conf.Auth, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, boxOffice *conf.BoxOffice, auth *conf.Auth, moderation *conf.Moderation, anomalyDetection *conf.AnomalyDetection, rateLimit *conf.RateLimit, trending *conf.Trending, similarity *conf.Similarity, recommender *conf.Recommender, media *conf.Media, idempotency *conf.Idempotency, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
 	}
+	rateLimiter := data.NewRateLimiter(dataData, logger)
 	movieRepo := data.NewMovieRepo(dataData, logger)
+	personRepo := data.NewPersonRepo(dataData, logger)
 	boxOfficeClient := data.NewBoxOfficeClient(boxOffice, logger)
-	movieUseCase := biz.NewMovieUseCase(movieRepo, boxOfficeClient, logger)
-	ratingRepo := data.NewRatingRepo(dataData, logger)
-	ratingUseCase := biz.NewRatingUseCase(movieRepo, ratingRepo, logger)
-	movieService := service.NewMovieService(movieUseCase, ratingUseCase)
-	grpcServer := server.NewGRPCServer(confServer, movieService, logger)
-	httpServer := server.NewHTTPServer(confServer, auth, movieService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	metrics := data.NewMetrics()
+	movieUseCase := biz.NewMovieUseCase(movieRepo, personRepo, boxOfficeClient, metrics, logger)
+	ratingRepo := data.NewRatingRepo(dataData, trending, logger)
+	contentScreener := data.NewContentScreener(moderation, dataData, logger)
+	ratingAnomalyDetector := data.NewRatingAnomalyDetector(anomalyDetection, dataData, logger)
+	alertPublisher := data.NewAlertPublisher(dataData, logger)
+	ratingUseCase := biz.NewRatingUseCase(movieRepo, ratingRepo, contentScreener, ratingAnomalyDetector, alertPublisher, metrics, anomalyDetection, logger)
+	blobStore, err := data.NewBlobStore(media, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	imageUseCase, err := biz.NewImageUseCase(movieRepo, blobStore, media, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	movieService := service.NewMovieService(movieUseCase, ratingUseCase, imageUseCase)
+	moderationRepo := data.NewModerationRepo(dataData, trending, logger)
+	moderationUseCase := biz.NewModerationUseCase(moderationRepo, moderation, logger)
+	moderationService := service.NewModerationService(moderationUseCase)
+	rankingRepo := data.NewRankingRepo(dataData, trending, logger)
+	rankingUseCase := biz.NewRankingUseCase(rankingRepo, logger)
+	rankingService := service.NewRankingService(rankingUseCase)
+	similarityRepo := data.NewSimilarityRepo(dataData, logger)
+	similarityUseCase := biz.NewSimilarityUseCase(movieRepo, similarityRepo, similarity, logger)
+	recommendationRepo := data.NewRecommendationRepo(dataData, logger)
+	recommenderModelStore := data.NewRecommenderModelStore(recommender, logger)
+	recommendationUseCase := biz.NewRecommendationUseCase(recommendationRepo, recommenderModelStore, recommender, logger)
+	recommendationService := service.NewRecommendationService(similarityUseCase, recommendationUseCase)
+	personUseCase := biz.NewPersonUseCase(personRepo, logger)
+	personService := service.NewPersonService(personUseCase, movieService)
+	collectionRepo := data.NewCollectionRepo(dataData, logger)
+	collectionUseCase := biz.NewCollectionUseCase(collectionRepo, movieRepo, ratingRepo, logger)
+	collectionService := service.NewCollectionService(collectionUseCase, movieService)
+	genreRepo := data.NewGenreRepo(dataData, logger)
+	genreUseCase := biz.NewGenreUseCase(genreRepo, logger)
+	genreService := service.NewGenreService(genreUseCase)
+	idempotencyStore := data.NewIdempotencyStore(dataData, logger)
+	idempotencyUseCase := biz.NewIdempotencyUseCase(idempotencyStore, idempotency, logger)
+	grpcServer := server.NewGRPCServer(confServer, auth, rateLimit, rateLimiter, movieService, moderationService, rankingService, recommendationService, personService, collectionService, genreService, idempotencyUseCase, media, logger)
+	httpServer := server.NewHTTPServer(confServer, auth, rateLimit, rateLimiter, movieService, moderationService, rankingService, recommendationService, personService, collectionService, genreService, idempotencyUseCase, media, blobStore, logger)
+	jobServer := server.NewJobServer(trending, similarity, recommender, rankingUseCase, similarityUseCase, recommendationUseCase, idempotencyUseCase, logger)
+	app := newApp(logger, grpcServer, httpServer, jobServer)
 	return app, func() {
 		cleanup()
 	}, nil
 }
+
+// wireRankingUseCase init the ranking use case for maintenance commands.
+func wireRankingUseCase(confData *conf.Data, trending *conf.Trending, logger log.Logger) (*biz.RankingUseCase, func(), error) {
+	dataData, cleanup, err := data.NewData(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	rankingRepo := data.NewRankingRepo(dataData, trending, logger)
+	rankingUseCase := biz.NewRankingUseCase(rankingRepo, logger)
+	return rankingUseCase, func() {
+		cleanup()
+	}, nil
+}
+
+// wireSimilarityUseCase init the similarity use case for maintenance commands.
+func wireSimilarityUseCase(confData *conf.Data, similarity *conf.Similarity, logger log.Logger) (*biz.SimilarityUseCase, func(), error) {
+	dataData, cleanup, err := data.NewData(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	movieRepo := data.NewMovieRepo(dataData, logger)
+	similarityRepo := data.NewSimilarityRepo(dataData, logger)
+	similarityUseCase := biz.NewSimilarityUseCase(movieRepo, similarityRepo, similarity, logger)
+	return similarityUseCase, func() {
+		cleanup()
+	}, nil
+}
+
+// wireRecommendationUseCase init the recommendation use case for maintenance commands.
+func wireRecommendationUseCase(confData *conf.Data, recommender *conf.Recommender, logger log.Logger) (*biz.RecommendationUseCase, func(), error) {
+	dataData, cleanup, err := data.NewData(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	recommendationRepo := data.NewRecommendationRepo(dataData, logger)
+	recommenderModelStore := data.NewRecommenderModelStore(recommender, logger)
+	recommendationUseCase := biz.NewRecommendationUseCase(recommendationRepo, recommenderModelStore, recommender, logger)
+	return recommendationUseCase, func() {
+		cleanup()
+	}, nil
+}
+
+// wireMovieUseCase init the movie use case for maintenance commands.
+func wireMovieUseCase(confData *conf.Data, boxOffice *conf.BoxOffice, logger log.Logger) (*biz.MovieUseCase, func(), error) {
+	dataData, cleanup, err := data.NewData(confData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	movieRepo := data.NewMovieRepo(dataData, logger)
+	personRepo := data.NewPersonRepo(dataData, logger)
+	boxOfficeClient := data.NewBoxOfficeClient(boxOffice, logger)
+	metrics := data.NewMetrics()
+	movieUseCase := biz.NewMovieUseCase(movieRepo, personRepo, boxOfficeClient, metrics, logger)
+	return movieUseCase, func() {
+		cleanup()
+	}, nil
+}
@@ -0,0 +1,96 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// MaxBatchGetSize bounds the titles and IDs of one batch lookup together
+const MaxBatchGetSize = 100
+
+// ErrInvalidBatch is returned for a batch lookup that is empty or too large
+var ErrInvalidBatch = errors.New("invalid batch")
+
+// MovieLookup is one movie of a batch lookup, requested by title or by ID.
+// The repository sets either Movie or Err.
+type MovieLookup struct {
+	Title string // Resolved like a /movies/{title} route
+	ID    string
+	Movie *Movie
+	Err   error // ErrMovieNotFound or an *AmbiguousTitleError
+}
+
+// RatingLookup is the rating aggregate of one movie of a batch lookup
+type RatingLookup struct {
+	*MovieLookup
+	Aggregate *RatingAggregate // Nil when the movie lookup failed
+}
+
+// newMovieLookups returns the lookups for the titles, then the IDs, in
+// request order
+func newMovieLookups(titles, ids []string) ([]*MovieLookup, error) {
+	n := len(titles) + len(ids)
+	if n == 0 {
+		return nil, fmt.Errorf("%w: no titles or IDs given", ErrInvalidBatch)
+	}
+	if n > MaxBatchGetSize {
+		return nil, fmt.Errorf("%w: %d titles and IDs given, at most %d allowed", ErrInvalidBatch, n, MaxBatchGetSize)
+	}
+
+	lookups := make([]*MovieLookup, 0, n)
+	for _, title := range titles {
+		lookups = append(lookups, &MovieLookup{Title: title})
+	}
+	for _, id := range ids {
+		lookups = append(lookups, &MovieLookup{ID: id})
+	}
+	return lookups, nil
+}
+
+// BatchGetMovies looks up several movies at once. A movie that is not found
+// or whose title is ambiguous is reported on its lookup and does not fail
+// the others.
+func (uc *MovieUseCase) BatchGetMovies(ctx context.Context, titles, ids []string) ([]*MovieLookup, error) {
+	lookups, err := newMovieLookups(titles, ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.BatchGetMovies(ctx, lookups); err != nil {
+		return nil, fmt.Errorf("failed to get movies: %w", err)
+	}
+	return lookups, nil
+}
+
+// BatchGetRatingAggregates returns the rating aggregates of several movies in
+// a window, reporting failed movie lookups like BatchGetMovies
+func (uc *RatingUseCase) BatchGetRatingAggregates(ctx context.Context, titles, ids []string, window RatingWindow) ([]*RatingLookup, error) {
+	lookups, err := newMovieLookups(titles, ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.movieRepo.BatchGetMovies(ctx, lookups); err != nil {
+		return nil, fmt.Errorf("failed to get movies: %w", err)
+	}
+
+	keys := make([]string, 0, len(lookups))
+	for _, lookup := range lookups {
+		if lookup.Movie != nil {
+			keys = append(keys, lookup.Movie.Key)
+		}
+	}
+	aggregates, err := uc.ratingRepo.BatchGetRatingAggregates(ctx, keys, window)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rating aggregates: %w", err)
+	}
+
+	results := make([]*RatingLookup, 0, len(lookups))
+	for _, lookup := range lookups {
+		result := &RatingLookup{MovieLookup: lookup}
+		if lookup.Movie != nil {
+			result.Aggregate = aggregates[lookup.Movie.Key]
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -3,4 +3,4 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewMovieUseCase, NewRatingUseCase)
+var ProviderSet = wire.NewSet(NewMovieUseCase, NewRatingUseCase, NewModerationUseCase, NewRankingUseCase, NewSimilarityUseCase, NewRecommendationUseCase, NewPersonUseCase, NewCollectionUseCase, NewGenreUseCase, NewImageUseCase, NewIdempotencyUseCase)
@@ -0,0 +1,22 @@
+package biz
+
+import "context"
+
+// ClientInfo identifies the caller of a request, as seen by the transport
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
+
+type clientInfoKey struct{}
+
+// NewClientContext returns a context carrying the caller's client info
+func NewClientContext(ctx context.Context, info ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, info)
+}
+
+// ClientFromContext returns the caller's client info, if the transport set it
+func ClientFromContext(ctx context.Context) (ClientInfo, bool) {
+	info, ok := ctx.Value(clientInfoKey{}).(ClientInfo)
+	return info, ok
+}
@@ -0,0 +1,283 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// Collection errors
+var (
+	ErrCollectionNotFound     = errors.New("collection not found")
+	ErrCollectionForbidden    = errors.New("collection belongs to another owner")
+	ErrInvalidCollection      = errors.New("invalid collection")
+	ErrCollectionItemExists   = errors.New("movie is already in the collection")
+	ErrCollectionItemNotFound = errors.New("movie is not in the collection")
+)
+
+// maxCollectionItems bounds a collection so its aggregate stays cheap to compute
+const maxCollectionItems = 500
+
+// CollectionUseCase handles franchises and curated collections
+type CollectionUseCase struct {
+	repo       CollectionRepo
+	movieRepo  MovieRepo
+	ratingRepo RatingRepo
+	log        *log.Helper
+}
+
+// NewCollectionUseCase creates a new CollectionUseCase instance
+func NewCollectionUseCase(repo CollectionRepo, movieRepo MovieRepo, ratingRepo RatingRepo, logger log.Logger) *CollectionUseCase {
+	return &CollectionUseCase{
+		repo:       repo,
+		movieRepo:  movieRepo,
+		ratingRepo: ratingRepo,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// CreateCollection creates an empty collection owned by collection.OwnerID
+func (uc *CollectionUseCase) CreateCollection(ctx context.Context, collection *Collection) (*Collection, error) {
+	collection.Name = strings.TrimSpace(collection.Name)
+	if collection.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidCollection)
+	}
+	if collection.Visibility == "" {
+		collection.Visibility = VisibilityPublic
+	}
+	if !collection.Visibility.Valid() {
+		return nil, fmt.Errorf("%w: visibility must be public or private", ErrInvalidCollection)
+	}
+
+	// Generate collection ID (UUID v7, like movies)
+	collectionID, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate collection ID: %w", err)
+	}
+	collection.ID = collectionID.String()
+
+	if err := uc.repo.CreateCollection(ctx, collection); err != nil {
+		return nil, fmt.Errorf("failed to create collection: %w", err)
+	}
+	collection.Rating = &CollectionAggregate{}
+	return collection, nil
+}
+
+// GetCollection returns a collection with its movies and aggregate rating.
+// Private collections are only found by their owner.
+func (uc *CollectionUseCase) GetCollection(ctx context.Context, id, viewerID string) (*Collection, error) {
+	collection, err := uc.visibleCollection(ctx, id, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.fillRating(ctx, collection); err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
+// UpdateCollection changes the given fields of a collection
+func (uc *CollectionUseCase) UpdateCollection(ctx context.Context, id, ownerID string, update *CollectionUpdate) (*Collection, error) {
+	if _, err := uc.ownedCollection(ctx, id, ownerID); err != nil {
+		return nil, err
+	}
+	if update.Name != nil {
+		name := strings.TrimSpace(*update.Name)
+		if name == "" {
+			return nil, fmt.Errorf("%w: name must not be empty", ErrInvalidCollection)
+		}
+		update.Name = &name
+	}
+	if update.Visibility != nil && !update.Visibility.Valid() {
+		return nil, fmt.Errorf("%w: visibility must be public or private", ErrInvalidCollection)
+	}
+
+	if _, err := uc.repo.UpdateCollection(ctx, id, update); err != nil {
+		return nil, fmt.Errorf("failed to update collection: %w", err)
+	}
+	return uc.GetCollection(ctx, id, ownerID)
+}
+
+// DeleteCollection deletes a collection; its movies are not affected
+func (uc *CollectionUseCase) DeleteCollection(ctx context.Context, id, ownerID string) error {
+	if _, err := uc.ownedCollection(ctx, id, ownerID); err != nil {
+		return err
+	}
+	if err := uc.repo.DeleteCollection(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete collection: %w", err)
+	}
+	return nil
+}
+
+// ListCollections retrieves a paginated list of the collections visible to the viewer
+func (uc *CollectionUseCase) ListCollections(ctx context.Context, query *CollectionListQuery) (*CollectionPage, error) {
+	page, err := uc.repo.ListCollections(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+	return page, nil
+}
+
+// AddItem adds a movie to a collection at position, or at the end when position is nil
+func (uc *CollectionUseCase) AddItem(ctx context.Context, id, ownerID, movieTitle string, position *int32) (*Collection, error) {
+	collection, err := uc.ownedCollection(ctx, id, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	movie, err := uc.movieRepo.GetMovieByTitle(ctx, movieTitle)
+	if err != nil {
+		return nil, movieLookupError(err)
+	}
+	if collectionIndex(collection, movie.ID) >= 0 {
+		return nil, ErrCollectionItemExists
+	}
+	if len(collection.Items) >= maxCollectionItems {
+		return nil, fmt.Errorf("%w: a collection holds at most %d movies", ErrInvalidCollection, maxCollectionItems)
+	}
+
+	pos := int32(len(collection.Items))
+	if position != nil {
+		if *position < 0 {
+			return nil, fmt.Errorf("%w: position must not be negative", ErrInvalidCollection)
+		}
+		pos = min(*position, pos)
+	}
+
+	if err := uc.repo.AddItem(ctx, id, movie.ID, pos); err != nil {
+		return nil, fmt.Errorf("failed to add movie to collection: %w", err)
+	}
+	return uc.GetCollection(ctx, id, ownerID)
+}
+
+// RemoveItem removes a movie from a collection
+func (uc *CollectionUseCase) RemoveItem(ctx context.Context, id, ownerID, movieTitle string) (*Collection, error) {
+	collection, err := uc.ownedCollection(ctx, id, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	i := collectionIndexByTitle(collection, movieTitle)
+	if i < 0 {
+		return nil, ErrCollectionItemNotFound
+	}
+
+	if err := uc.repo.RemoveItem(ctx, id, collection.Items[i].Movie.ID); err != nil {
+		return nil, fmt.Errorf("failed to remove movie from collection: %w", err)
+	}
+	return uc.GetCollection(ctx, id, ownerID)
+}
+
+// ReorderItems puts the movies of a collection in the given order. The titles
+// must list every movie of the collection exactly once.
+func (uc *CollectionUseCase) ReorderItems(ctx context.Context, id, ownerID string, movieTitles []string) (*Collection, error) {
+	collection, err := uc.ownedCollection(ctx, id, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(movieTitles) != len(collection.Items) {
+		return nil, fmt.Errorf("%w: order must list all %d movies of the collection", ErrInvalidCollection, len(collection.Items))
+	}
+	movieIDs := make([]string, 0, len(movieTitles))
+	seen := make(map[int]bool, len(movieTitles))
+	for _, title := range movieTitles {
+		i := collectionIndexByTitle(collection, title)
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %q is not in the collection", ErrInvalidCollection, title)
+		}
+		if seen[i] {
+			return nil, fmt.Errorf("%w: %q is listed more than once", ErrInvalidCollection, title)
+		}
+		seen[i] = true
+		movieIDs = append(movieIDs, collection.Items[i].Movie.ID)
+	}
+
+	if err := uc.repo.ReorderItems(ctx, id, movieIDs); err != nil {
+		return nil, fmt.Errorf("failed to reorder collection: %w", err)
+	}
+	return uc.GetCollection(ctx, id, ownerID)
+}
+
+// visibleCollection loads a collection, hiding private collections from everyone but the owner
+func (uc *CollectionUseCase) visibleCollection(ctx context.Context, id, viewerID string) (*Collection, error) {
+	collection, err := uc.repo.GetCollection(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCollectionNotFound, err)
+	}
+	if collection.Visibility == VisibilityPrivate && collection.OwnerID != viewerID {
+		return nil, ErrCollectionNotFound
+	}
+	return collection, nil
+}
+
+// ownedCollection loads a collection the caller is allowed to change
+func (uc *CollectionUseCase) ownedCollection(ctx context.Context, id, ownerID string) (*Collection, error) {
+	collection, err := uc.visibleCollection(ctx, id, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if collection.OwnerID != ownerID {
+		return nil, ErrCollectionForbidden
+	}
+	return collection, nil
+}
+
+// fillRating combines the members' all-time rating aggregates, weighting each
+// movie's average by its number of ratings
+func (uc *CollectionUseCase) fillRating(ctx context.Context, collection *Collection) error {
+	agg := &CollectionAggregate{}
+	var sum float64
+	for _, item := range collection.Items {
+		movieAgg, err := uc.ratingRepo.GetRatingAggregate(ctx, item.Movie.Key, WindowAll)
+		if err != nil {
+			return fmt.Errorf("failed to get rating of %s: %w", item.Movie.Title, err)
+		}
+		if movieAgg.Count == 0 {
+			continue
+		}
+		sum += movieAgg.Average * float64(movieAgg.Count)
+		agg.Count += movieAgg.Count
+		agg.RatedMovies++
+	}
+	if agg.Count > 0 {
+		agg.Average = math.Round(sum/float64(agg.Count)*10) / 10
+	}
+	collection.Rating = agg
+	return nil
+}
+
+// collectionIndex returns the index of a movie in the collection's items, or -1
+func collectionIndex(collection *Collection, movieID string) int {
+	for i, item := range collection.Items {
+		if item.Movie.ID == movieID {
+			return i
+		}
+	}
+	return -1
+}
+
+// collectionIndexByTitle returns the index of a movie in the collection's items
+// by key, title or "Title (YYYY)", or -1 if no single item matches
+func collectionIndexByTitle(collection *Collection, movieTitle string) int {
+	for i, item := range collection.Items {
+		if item.Movie.Key == movieTitle {
+			return i
+		}
+	}
+	found := -1
+	for i, item := range collection.Items {
+		if item.Movie.Title == movieTitle || DisambiguatedTitle(item.Movie.Title, item.Movie.ReleaseDate.Year()) == movieTitle {
+			if found >= 0 {
+				return -1
+			}
+			found = i
+		}
+	}
+	return found
+}
@@ -0,0 +1,290 @@
+package biz
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// ErrInvalidExport is returned for an export request that cannot be served
+var ErrInvalidExport = errors.New("invalid export")
+
+// exportBatchSize is the number of movies read per keyset page; a Parquet
+// export writes one row group per page
+const exportBatchSize = 1000
+
+// ExportFormat is the file format of a movie export
+type ExportFormat string
+
+// Export formats
+const (
+	ExportCSV     ExportFormat = "csv"
+	ExportJSONL   ExportFormat = "jsonl"
+	ExportParquet ExportFormat = "parquet"
+)
+
+// ContentType returns the media type of an export in the format
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case ExportCSV:
+		return "text/csv; charset=utf-8"
+	case ExportJSONL:
+		return "application/x-ndjson"
+	case ExportParquet:
+		return "application/vnd.apache.parquet"
+	}
+	return "application/octet-stream"
+}
+
+// ExportRow is one exported movie with its all-time rating aggregate, over
+// the ratings moderation has not hidden
+type ExportRow struct {
+	Movie         *Movie
+	RatingAverage float64
+	RatingCount   int32
+}
+
+// exportColumns are the CSV columns of an export; those an import also has
+// keep their import names and format
+var exportColumns = []string{
+	"id", "title", "genre", "release_date", "distributor", "budget", "mpa_rating",
+	"genres", "tags", "imdb_id", "tmdb_id", "eidr",
+	"box_office_worldwide", "box_office_opening_usa", "box_office_currency", "box_office_source", "box_office_last_updated",
+	"rating_average", "rating_count",
+}
+
+// exportRecord is one movie of a JSONL or Parquet export
+type exportRecord struct {
+	ID                   string     `json:"id" parquet:"id"`
+	Title                string     `json:"title" parquet:"title"`
+	Genre                string     `json:"genre" parquet:"genre"`
+	ReleaseDate          int32      `json:"-" parquet:"release_date,date"` // Days since the Unix epoch
+	ReleaseDateText      string     `json:"release_date" parquet:"-"`
+	Distributor          *string    `json:"distributor,omitempty" parquet:"distributor,optional"`
+	Budget               *int64     `json:"budget,omitempty" parquet:"budget,optional"`
+	MPARating            *string    `json:"mpa_rating,omitempty" parquet:"mpa_rating,optional"`
+	Genres               []string   `json:"genres" parquet:"genres,list"`
+	Tags                 []string   `json:"tags" parquet:"tags,list"`
+	IMDbID               *string    `json:"imdb_id,omitempty" parquet:"imdb_id,optional"`
+	TMDBID               *string    `json:"tmdb_id,omitempty" parquet:"tmdb_id,optional"`
+	EIDR                 *string    `json:"eidr,omitempty" parquet:"eidr,optional"`
+	BoxOfficeWorldwide   *int64     `json:"box_office_worldwide,omitempty" parquet:"box_office_worldwide,optional"`
+	BoxOfficeOpeningUSA  *int64     `json:"box_office_opening_usa,omitempty" parquet:"box_office_opening_usa,optional"`
+	BoxOfficeCurrency    *string    `json:"box_office_currency,omitempty" parquet:"box_office_currency,optional"`
+	BoxOfficeSource      *string    `json:"box_office_source,omitempty" parquet:"box_office_source,optional"`
+	BoxOfficeLastUpdated *time.Time `json:"box_office_last_updated,omitempty" parquet:"box_office_last_updated,optional,timestamp(millisecond)"`
+	RatingAverage        float64    `json:"rating_average" parquet:"rating_average"`
+	RatingCount          int32      `json:"rating_count" parquet:"rating_count"`
+}
+
+func newExportRecord(row *ExportRow) *exportRecord {
+	m := row.Movie
+	rec := &exportRecord{
+		ID:              m.ID,
+		Title:           m.Title,
+		Genre:           m.Genre,
+		ReleaseDate:     epochDays(m.ReleaseDate),
+		ReleaseDateText: m.ReleaseDate.Format("2006-01-02"),
+		Distributor:     m.Distributor,
+		Budget:          m.Budget,
+		MPARating:       m.MPARating,
+		Genres:          m.Genres,
+		Tags:            m.Tags,
+		RatingAverage:   row.RatingAverage,
+		RatingCount:     row.RatingCount,
+	}
+	if rec.Genres == nil {
+		rec.Genres = []string{}
+	}
+	if rec.Tags == nil {
+		rec.Tags = []string{}
+	}
+	for scheme, field := range map[string]**string{SchemeIMDb: &rec.IMDbID, SchemeTMDB: &rec.TMDBID, SchemeEIDR: &rec.EIDR} {
+		if id, ok := m.ExternalIDs[scheme]; ok {
+			*field = &id
+		}
+	}
+	if bo := m.BoxOffice; bo != nil {
+		lastUpdated := bo.LastUpdated.UTC()
+		rec.BoxOfficeWorldwide = &bo.Revenue.Worldwide
+		rec.BoxOfficeOpeningUSA = bo.Revenue.OpeningWeekendUSA
+		rec.BoxOfficeCurrency = &bo.Currency
+		rec.BoxOfficeSource = &bo.Source
+		rec.BoxOfficeLastUpdated = &lastUpdated
+	}
+	return rec
+}
+
+// epochDays returns the calendar day of t as days since 1970-01-01, the
+// physical value of a Parquet DATE
+func epochDays(t time.Time) int32 {
+	y, m, d := t.Date()
+	return int32(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+// exportWriter encodes the rows of an export. flush is called after every
+// page of rows and close once at the end.
+type exportWriter interface {
+	write(rec *exportRecord) error
+	flush() error
+	close() error
+}
+
+// newExportWriter returns the writer of a format; an unknown format fails
+// with ErrInvalidExport before anything is written
+func newExportWriter(format ExportFormat, w io.Writer) (exportWriter, error) {
+	switch format {
+	case ExportCSV:
+		return newCSVExportWriter(w)
+	case ExportJSONL:
+		return &jsonlExportWriter{encoder: json.NewEncoder(w)}, nil
+	case ExportParquet:
+		return &parquetExportWriter{writer: parquet.NewGenericWriter[exportRecord](w)}, nil
+	}
+	return nil, fmt.Errorf("%w: unknown format %q, expected csv, jsonl or parquet", ErrInvalidExport, format)
+}
+
+type csvExportWriter struct {
+	writer *csv.Writer
+}
+
+func newCSVExportWriter(w io.Writer) (*csvExportWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(exportColumns); err != nil {
+		return nil, err
+	}
+	return &csvExportWriter{writer: writer}, nil
+}
+
+func (w *csvExportWriter) write(rec *exportRecord) error {
+	return w.writer.Write([]string{
+		rec.ID, rec.Title, rec.Genre, rec.ReleaseDateText,
+		optionalCell(rec.Distributor), optionalIntCell(rec.Budget), optionalCell(rec.MPARating),
+		strings.Join(rec.Genres, importListSeparator), strings.Join(rec.Tags, importListSeparator),
+		optionalCell(rec.IMDbID), optionalCell(rec.TMDBID), optionalCell(rec.EIDR),
+		optionalIntCell(rec.BoxOfficeWorldwide), optionalIntCell(rec.BoxOfficeOpeningUSA),
+		optionalCell(rec.BoxOfficeCurrency), optionalCell(rec.BoxOfficeSource), optionalTimeCell(rec.BoxOfficeLastUpdated),
+		strconv.FormatFloat(rec.RatingAverage, 'f', 1, 64), strconv.Itoa(int(rec.RatingCount)),
+	})
+}
+
+func (w *csvExportWriter) flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func (w *csvExportWriter) close() error {
+	return w.flush()
+}
+
+func optionalCell(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func optionalIntCell(value *int64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatInt(*value, 10)
+}
+
+func optionalTimeCell(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+	return value.Format(time.RFC3339)
+}
+
+type jsonlExportWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *jsonlExportWriter) write(rec *exportRecord) error {
+	return w.encoder.Encode(rec)
+}
+
+func (w *jsonlExportWriter) flush() error { return nil }
+
+func (w *jsonlExportWriter) close() error { return nil }
+
+// parquetExportWriter buffers one page of rows at a time and writes each
+// page as a row group, which bounds its memory use
+type parquetExportWriter struct {
+	writer *parquet.GenericWriter[exportRecord]
+	rows   []exportRecord
+}
+
+func (w *parquetExportWriter) write(rec *exportRecord) error {
+	w.rows = append(w.rows, *rec)
+	return nil
+}
+
+func (w *parquetExportWriter) flush() error {
+	if len(w.rows) == 0 {
+		return nil
+	}
+	if _, err := w.writer.Write(w.rows); err != nil {
+		return err
+	}
+	w.rows = w.rows[:0]
+	return w.writer.Flush()
+}
+
+func (w *parquetExportWriter) close() error {
+	if err := w.flush(); err != nil {
+		return err
+	}
+	return w.writer.Close()
+}
+
+// ExportMovies writes every movie matching the filters of the query to w,
+// in ID order. Movies are read a page at a time by keyset, so memory use does
+// not grow with the catalog; after each page the encoded rows are flushed
+// and onFlush, if set, is called to push them to the client.
+func (uc *MovieUseCase) ExportMovies(ctx context.Context, query *MovieListQuery, format ExportFormat, w io.Writer, onFlush func() error) error {
+	writer, err := newExportWriter(format, w)
+	if err != nil {
+		return err
+	}
+
+	afterID := ""
+	for {
+		rows, err := uc.repo.ExportMovies(ctx, query, afterID, exportBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to export movies: %w", err)
+		}
+		for _, row := range rows {
+			if err := writer.write(newExportRecord(row)); err != nil {
+				return fmt.Errorf("failed to write export: %w", err)
+			}
+		}
+		if err := writer.flush(); err != nil {
+			return fmt.Errorf("failed to write export: %w", err)
+		}
+		if onFlush != nil {
+			if err := onFlush(); err != nil {
+				return err
+			}
+		}
+		if len(rows) < exportBatchSize {
+			break
+		}
+		afterID = rows[len(rows)-1].Movie.ID
+	}
+
+	if err := writer.close(); err != nil {
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// External ID errors
+var (
+	ErrInvalidExternalID   = errors.New("invalid external ID")
+	ErrDuplicateExternalID = errors.New("external ID already belongs to another movie")
+	ErrExternalIDNotFound  = errors.New("external ID not found")
+)
+
+// External ID schemes
+const (
+	SchemeIMDb = "imdb" // IMDb title ID, e.g. tt1375666
+	SchemeTMDB = "tmdb" // TMDB movie ID, e.g. 27205
+	SchemeEIDR = "eidr" // EIDR content ID, e.g. 10.5240/7791-8534-2C23-9030-8610-5
+)
+
+// ExternalIDSchemes lists the known schemes in order of preference for
+// box-office lookups
+var ExternalIDSchemes = []string{SchemeIMDb, SchemeTMDB, SchemeEIDR}
+
+// eidrPrefix is the DOI prefix of every EIDR content ID
+const eidrPrefix = "10.5240/"
+
+var (
+	imdbIDPattern = regexp.MustCompile(`^tt\d{7,10}$`)
+	tmdbIDPattern = regexp.MustCompile(`^[1-9]\d{0,9}$`)
+	eidrIDPattern = regexp.MustCompile(`^([0-9A-F]{4}-){5}[0-9A-Z]$`)
+)
+
+// NormalizeExternalID validates an ID in its scheme's format and returns the
+// scheme and ID in canonical form: lowercase IMDb IDs and EIDR IDs with their
+// 10.5240/ prefix, uppercase, with a valid check character.
+func NormalizeExternalID(scheme, id string) (string, string, error) {
+	scheme = strings.ToLower(strings.TrimSpace(scheme))
+	id = strings.TrimSpace(id)
+
+	switch scheme {
+	case SchemeIMDb:
+		id = strings.ToLower(id)
+		if !imdbIDPattern.MatchString(id) {
+			return "", "", fmt.Errorf("%w: IMDb IDs look like tt1375666, got %q", ErrInvalidExternalID, id)
+		}
+	case SchemeTMDB:
+		if !tmdbIDPattern.MatchString(id) {
+			return "", "", fmt.Errorf("%w: TMDB IDs are positive integers, got %q", ErrInvalidExternalID, id)
+		}
+	case SchemeEIDR:
+		suffix := strings.ToUpper(strings.TrimPrefix(id, eidrPrefix))
+		if !eidrIDPattern.MatchString(suffix) {
+			return "", "", fmt.Errorf("%w: EIDR IDs look like 10.5240/XXXX-XXXX-XXXX-XXXX-XXXX-C, got %q", ErrInvalidExternalID, id)
+		}
+		if !validEIDRCheck(suffix) {
+			return "", "", fmt.Errorf("%w: EIDR ID %q has a wrong check character", ErrInvalidExternalID, id)
+		}
+		id = eidrPrefix + suffix
+	default:
+		return "", "", fmt.Errorf("%w: unknown scheme %q, expected one of %s", ErrInvalidExternalID, scheme, strings.Join(ExternalIDSchemes, ", "))
+	}
+	return scheme, id, nil
+}
+
+// normalizeExternalIDs normalizes the external IDs of a new movie
+func normalizeExternalIDs(ids map[string]string) (map[string]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	result := make(map[string]string, len(ids))
+	for scheme, id := range ids {
+		scheme, id, err := NormalizeExternalID(scheme, id)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := result[scheme]; ok {
+			return nil, fmt.Errorf("%w: more than one %s ID", ErrInvalidExternalID, scheme)
+		}
+		result[scheme] = id
+	}
+	return result, nil
+}
+
+// validEIDRCheck verifies the ISO 7064 Mod 37,36 check character that ends
+// the suffix of an EIDR ID
+func validEIDRCheck(suffix string) bool {
+	const m = 36
+	p := m
+	chars := strings.ReplaceAll(suffix, "-", "")
+	for i := 0; i < len(chars); i++ {
+		c := chars[i]
+		var v int
+		if c >= '0' && c <= '9' {
+			v = int(c - '0')
+		} else {
+			v = int(c-'A') + 10
+		}
+		s := (p + v) % m
+		if s == 0 {
+			s = m
+		}
+		if i == len(chars)-1 {
+			return s == 1
+		}
+		p = s * 2 % (m + 1)
+	}
+	return false
+}
+
+// GetMovieByExternalID finds the movie holding an external ID
+func (uc *MovieUseCase) GetMovieByExternalID(ctx context.Context, scheme, id string) (*Movie, error) {
+	scheme, id, err := NormalizeExternalID(scheme, id)
+	if err != nil {
+		return nil, err
+	}
+	movie, err := uc.repo.GetMovieByExternalID(ctx, scheme, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMovieNotFound, err)
+	}
+	return movie, nil
+}
+
+// SetMovieExternalID sets or replaces the movie's ID in a scheme
+func (uc *MovieUseCase) SetMovieExternalID(ctx context.Context, title, scheme, id string, version int64) (*Movie, error) {
+	movie, err := uc.movieForUpdate(ctx, title, version)
+	if err != nil {
+		return nil, err
+	}
+	scheme, id, err = NormalizeExternalID(scheme, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.SetExternalID(ctx, movie, scheme, id); err != nil {
+		return nil, fmt.Errorf("failed to set external ID: %w", err)
+	}
+	return uc.GetMovieByTitle(ctx, title)
+}
+
+// DeleteMovieExternalID removes the movie's ID in a scheme
+func (uc *MovieUseCase) DeleteMovieExternalID(ctx context.Context, title, scheme string, version int64) (*Movie, error) {
+	movie, err := uc.movieForUpdate(ctx, title, version)
+	if err != nil {
+		return nil, err
+	}
+	scheme = strings.ToLower(strings.TrimSpace(scheme))
+	if !slices.Contains(ExternalIDSchemes, scheme) {
+		return nil, fmt.Errorf("%w: unknown scheme %q", ErrInvalidExternalID, scheme)
+	}
+
+	if err := uc.repo.DeleteExternalID(ctx, movie, scheme); err != nil {
+		return nil, fmt.Errorf("failed to delete external ID: %w", err)
+	}
+	return uc.GetMovieByTitle(ctx, title)
+}
@@ -0,0 +1,119 @@
+package biz
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizeExternalID(t *testing.T) {
+	tests := []struct {
+		name       string
+		scheme     string
+		id         string
+		wantScheme string
+		wantID     string
+		wantErr    bool
+	}{
+		{name: "imdb", scheme: "imdb", id: "tt1375666", wantScheme: "imdb", wantID: "tt1375666"},
+		{name: "imdb is lowercased", scheme: " IMDb ", id: " TT1375666 ", wantScheme: "imdb", wantID: "tt1375666"},
+		{name: "imdb with ten digits", scheme: "imdb", id: "tt1234567890", wantScheme: "imdb", wantID: "tt1234567890"},
+		{name: "imdb too short", scheme: "imdb", id: "tt123456", wantErr: true},
+		{name: "imdb too long", scheme: "imdb", id: "tt12345678901", wantErr: true},
+		{name: "imdb without prefix", scheme: "imdb", id: "1375666", wantErr: true},
+		{name: "imdb name ID", scheme: "imdb", id: "nm0634240", wantErr: true},
+
+		{name: "tmdb", scheme: "tmdb", id: "27205", wantScheme: "tmdb", wantID: "27205"},
+		{name: "tmdb zero", scheme: "tmdb", id: "0", wantErr: true},
+		{name: "tmdb leading zero", scheme: "tmdb", id: "027205", wantErr: true},
+		{name: "tmdb negative", scheme: "tmdb", id: "-27205", wantErr: true},
+		{name: "tmdb too long", scheme: "tmdb", id: "12345678901", wantErr: true},
+
+		{
+			name:       "eidr",
+			scheme:     "eidr",
+			id:         "10.5240/7791-8534-2C23-9030-8610-5",
+			wantScheme: "eidr",
+			wantID:     "10.5240/7791-8534-2C23-9030-8610-5",
+		},
+		{
+			name:       "eidr without prefix, lowercase",
+			scheme:     "EIDR",
+			id:         "7791-8534-2c23-9030-8610-5",
+			wantScheme: "eidr",
+			wantID:     "10.5240/7791-8534-2C23-9030-8610-5",
+		},
+		{name: "eidr wrong check character", scheme: "eidr", id: "10.5240/7791-8534-2C23-9030-8610-6", wantErr: true},
+		{name: "eidr missing group", scheme: "eidr", id: "10.5240/7791-8534-2C23-9030-5", wantErr: true},
+		{name: "eidr other DOI prefix", scheme: "eidr", id: "10.5241/7791-8534-2C23-9030-8610-5", wantErr: true},
+
+		{name: "unknown scheme", scheme: "letterboxd", id: "inception", wantErr: true},
+		{name: "empty scheme", scheme: "", id: "tt1375666", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme, id, err := NormalizeExternalID(tt.scheme, tt.id)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidExternalID) {
+					t.Fatalf("NormalizeExternalID() error = %v, want ErrInvalidExternalID", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NormalizeExternalID() error = %v", err)
+			}
+			if scheme != tt.wantScheme || id != tt.wantID {
+				t.Errorf("NormalizeExternalID() = %s, %s, want %s, %s", scheme, id, tt.wantScheme, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestNormalizeExternalIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		ids     map[string]string
+		want    map[string]string
+		wantErr bool
+	}{
+		{name: "none"},
+		{
+			name: "several schemes",
+			ids:  map[string]string{"imdb": "TT1375666", "tmdb": "27205"},
+			want: map[string]string{"imdb": "tt1375666", "tmdb": "27205"},
+		},
+		{
+			name:    "same scheme twice",
+			ids:     map[string]string{"imdb": "tt1375666", "IMDB": "tt0816692"},
+			wantErr: true,
+		},
+		{
+			name:    "one invalid ID",
+			ids:     map[string]string{"imdb": "tt1375666", "tmdb": "abc"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeExternalIDs(tt.ids)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidExternalID) {
+					t.Fatalf("normalizeExternalIDs() error = %v, want ErrInvalidExternalID", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeExternalIDs() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("normalizeExternalIDs() = %v, want %v", got, tt.want)
+			}
+			for scheme, id := range tt.want {
+				if got[scheme] != id {
+					t.Errorf("normalizeExternalIDs()[%s] = %q, want %q", scheme, got[scheme], id)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Genre and tag errors
+var (
+	ErrGenreNotFound = errors.New("genre not found")
+	ErrGenreExists   = errors.New("genre or alias already exists")
+	ErrInvalidGenre  = errors.New("invalid genre")
+	ErrInvalidTag    = errors.New("invalid tag")
+)
+
+// Limits on genres and tags per movie
+const (
+	maxMovieGenres = 10
+	maxMovieTags   = 20
+	maxTagLength   = 50
+)
+
+// GenreKey normalizes a genre name or alias for matching: lowercase, with runs
+// of anything but ASCII letters and digits collapsed into single spaces.
+// "Sci-Fi", "sci fi" and "SCI  FI" share the key "sci fi". Must match the
+// genre_key SQL function.
+func GenreKey(name string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteRune(r)
+			space = false
+		} else {
+			space = true
+		}
+	}
+	return b.String()
+}
+
+// GenreSlug returns the genre ID for a genre key
+func GenreSlug(key string) string {
+	return strings.ReplaceAll(key, " ", "-")
+}
+
+// normalizeGenres returns the primary genre followed by the extra genres,
+// without duplicates by key
+func normalizeGenres(primary string, extra []string) ([]string, error) {
+	genres := make([]string, 0, 1+len(extra))
+	seen := make(map[string]bool, 1+len(extra))
+	for _, name := range append([]string{primary}, extra...) {
+		name = strings.TrimSpace(name)
+		key := GenreKey(name)
+		if key == "" {
+			return nil, fmt.Errorf("%w: %q has no letters or digits", ErrInvalidGenre, name)
+		}
+		if len(name) > 100 {
+			return nil, fmt.Errorf("%w: %q is longer than 100 characters", ErrInvalidGenre, name)
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		genres = append(genres, name)
+	}
+	if len(genres) > maxMovieGenres {
+		return nil, fmt.Errorf("%w: a movie has at most %d genres", ErrInvalidGenre, maxMovieGenres)
+	}
+	return genres, nil
+}
+
+// normalizeTags lowercases tags, collapses whitespace and drops duplicates
+func normalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.Join(strings.Fields(tag), " "))
+		if tag == "" {
+			return nil, fmt.Errorf("%w: tags must not be empty", ErrInvalidTag)
+		}
+		if len(tag) > maxTagLength {
+			return nil, fmt.Errorf("%w: %q is longer than %d characters", ErrInvalidTag, tag, maxTagLength)
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) > maxMovieTags {
+		return nil, fmt.Errorf("%w: a movie has at most %d tags", ErrInvalidTag, maxMovieTags)
+	}
+	return normalized, nil
+}
+
+// GenreUseCase manages the genre taxonomy
+type GenreUseCase struct {
+	repo GenreRepo
+	log  *log.Helper
+}
+
+// NewGenreUseCase creates a new GenreUseCase instance
+func NewGenreUseCase(repo GenreRepo, logger log.Logger) *GenreUseCase {
+	return &GenreUseCase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ListGenres returns every genre with its aliases
+func (uc *GenreUseCase) ListGenres(ctx context.Context) ([]*Genre, error) {
+	genres, err := uc.repo.ListGenres(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list genres: %w", err)
+	}
+	return genres, nil
+}
+
+// CreateGenre adds a genre with optional aliases. Neither the name nor any
+// alias may already resolve to a genre.
+func (uc *GenreUseCase) CreateGenre(ctx context.Context, name string, aliases []string) (*Genre, error) {
+	name = strings.TrimSpace(name)
+	key := GenreKey(name)
+	if key == "" {
+		return nil, fmt.Errorf("%w: name must contain letters or digits", ErrInvalidGenre)
+	}
+	if len(name) > 100 {
+		return nil, fmt.Errorf("%w: name is longer than 100 characters", ErrInvalidGenre)
+	}
+
+	genre := &Genre{
+		ID:      GenreSlug(key),
+		Name:    name,
+		Aliases: []string{key},
+	}
+	for _, alias := range aliases {
+		aliasKey := GenreKey(alias)
+		if aliasKey == "" {
+			return nil, fmt.Errorf("%w: alias %q has no letters or digits", ErrInvalidGenre, alias)
+		}
+		if !slices.Contains(genre.Aliases, aliasKey) {
+			genre.Aliases = append(genre.Aliases, aliasKey)
+		}
+	}
+
+	for _, alias := range genre.Aliases {
+		if err := uc.checkAliasFree(ctx, alias); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := uc.repo.GetGenre(ctx, genre.ID); err == nil {
+		return nil, fmt.Errorf("%w: genre %s", ErrGenreExists, genre.ID)
+	}
+
+	if err := uc.repo.CreateGenre(ctx, genre); err != nil {
+		return nil, fmt.Errorf("failed to create genre: %w", err)
+	}
+	return genre, nil
+}
+
+// AddGenreAlias makes another spelling resolve to a genre
+func (uc *GenreUseCase) AddGenreAlias(ctx context.Context, id, alias string) (*Genre, error) {
+	if _, err := uc.repo.GetGenre(ctx, id); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrGenreNotFound, err)
+	}
+
+	key := GenreKey(alias)
+	if key == "" {
+		return nil, fmt.Errorf("%w: alias must contain letters or digits", ErrInvalidGenre)
+	}
+	if err := uc.checkAliasFree(ctx, key); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.AddAlias(ctx, id, key); err != nil {
+		return nil, fmt.Errorf("failed to add genre alias: %w", err)
+	}
+
+	genre, err := uc.repo.GetGenre(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get genre: %w", err)
+	}
+	return genre, nil
+}
+
+// SetGenreTranslation creates or replaces the name of a genre in a locale
+func (uc *GenreUseCase) SetGenreTranslation(ctx context.Context, id, locale, name string) (*Genre, error) {
+	if _, err := uc.repo.GetGenre(ctx, id); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrGenreNotFound, err)
+	}
+
+	locale, err := CanonicalLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidTranslation)
+	}
+	if len(name) > maxGenreNameLen {
+		return nil, fmt.Errorf("%w: name is longer than %d characters", ErrInvalidTranslation, maxGenreNameLen)
+	}
+
+	if err := uc.repo.SetTranslation(ctx, id, locale, name); err != nil {
+		return nil, fmt.Errorf("failed to set genre translation: %w", err)
+	}
+	return uc.getGenre(ctx, id)
+}
+
+// DeleteGenreTranslation removes the name of a genre in a locale
+func (uc *GenreUseCase) DeleteGenreTranslation(ctx context.Context, id, locale string) (*Genre, error) {
+	if _, err := uc.repo.GetGenre(ctx, id); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrGenreNotFound, err)
+	}
+
+	locale, err := CanonicalLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.DeleteTranslation(ctx, id, locale); err != nil {
+		return nil, fmt.Errorf("failed to delete genre translation: %w", err)
+	}
+	return uc.getGenre(ctx, id)
+}
+
+// getGenre reloads a genre after a change
+func (uc *GenreUseCase) getGenre(ctx context.Context, id string) (*Genre, error) {
+	genre, err := uc.repo.GetGenre(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get genre: %w", err)
+	}
+	return genre, nil
+}
+
+// checkAliasFree fails with ErrGenreExists if the key already resolves to a genre
+func (uc *GenreUseCase) checkAliasFree(ctx context.Context, key string) error {
+	existing, err := uc.repo.ResolveGenre(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to resolve genre: %w", err)
+	}
+	if existing != nil {
+		return fmt.Errorf("%w: %q already refers to %s", ErrGenreExists, key, existing.Name)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Idempotency defaults
+const (
+	DefaultIdempotencyTTL = 24 * time.Hour
+	// idempotencyClaimTTL bounds how long a key stays locked by a request that
+	// never finishes, e.g. because its server died
+	idempotencyClaimTTL = 5 * time.Minute
+	// MaxIdempotencyKeyLength matches the idempotency_keys.key column size
+	MaxIdempotencyKeyLength = 255
+)
+
+// Idempotency errors
+var (
+	ErrInvalidIdempotencyKey = errors.New("invalid idempotency key")
+	// ErrIdempotencyKeyReused is returned when a key comes back with a
+	// different operation or payload than its first request
+	ErrIdempotencyKeyReused = errors.New("idempotency key reused with a different request")
+	// ErrIdempotencyInProgress is returned while the first request of a key
+	// has not finished
+	ErrIdempotencyInProgress = errors.New("a request with this idempotency key is in progress")
+)
+
+// IdempotencyUseCase replays the first response of requests retried with
+// the same Idempotency-Key
+type IdempotencyUseCase struct {
+	store IdempotencyStore
+	ttl   time.Duration
+	log   *log.Helper
+}
+
+// NewIdempotencyUseCase creates a new IdempotencyUseCase instance
+func NewIdempotencyUseCase(store IdempotencyStore, c *conf.Idempotency, logger log.Logger) *IdempotencyUseCase {
+	ttl := DefaultIdempotencyTTL
+	if c.GetTtl().AsDuration() > 0 {
+		ttl = c.GetTtl().AsDuration()
+	}
+	return &IdempotencyUseCase{
+		store: store,
+		ttl:   ttl,
+		log:   log.NewHelper(logger),
+	}
+}
+
+// Begin claims a key for a request. It returns the response stored for the
+// key if the same request was already served; otherwise the caller holds
+// the key and must call Finish once the request is done.
+func (uc *IdempotencyUseCase) Begin(ctx context.Context, principal, key, requestHash string) ([]byte, error) {
+	if len(key) > MaxIdempotencyKeyLength {
+		return nil, fmt.Errorf("%w: longer than %d characters", ErrInvalidIdempotencyKey, MaxIdempotencyKeyLength)
+	}
+
+	existing, err := uc.store.Claim(ctx, &IdempotencyRecord{Principal: principal, Key: key, RequestHash: requestHash}, idempotencyClaimTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim idempotency key: %w", err)
+	}
+	if existing == nil {
+		return nil, nil
+	}
+	if existing.RequestHash != requestHash {
+		return nil, ErrIdempotencyKeyReused
+	}
+	if existing.Response == nil {
+		return nil, ErrIdempotencyInProgress
+	}
+	return existing.Response, nil
+}
+
+// Finish stores the response of a successful request for replay. A failed
+// request (nil response) releases the key, so it can be retried.
+func (uc *IdempotencyUseCase) Finish(ctx context.Context, principal, key, requestHash string, response []byte) {
+	if response == nil {
+		if err := uc.store.Release(ctx, principal, key); err != nil {
+			uc.log.Warnf("failed to release idempotency key: %v", err)
+		}
+		return
+	}
+	rec := &IdempotencyRecord{Principal: principal, Key: key, RequestHash: requestHash, Response: response}
+	if err := uc.store.Complete(ctx, rec, uc.ttl); err != nil {
+		uc.log.Warnf("failed to store idempotent response: %v", err)
+	}
+}
+
+// PurgeExpired deletes stored responses past their ttl
+func (uc *IdempotencyUseCase) PurgeExpired(ctx context.Context) error {
+	return uc.store.PurgeExpired(ctx)
+}
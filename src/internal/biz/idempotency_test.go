@@ -0,0 +1,244 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// fakeIdempotencyStore keeps records in memory, recording the ttl of each write
+type fakeIdempotencyStore struct {
+	records map[string]*IdempotencyRecord
+	ttls    map[string]time.Duration
+	err     error // returned by every call when set
+}
+
+func newFakeIdempotencyStore(records ...*IdempotencyRecord) *fakeIdempotencyStore {
+	s := &fakeIdempotencyStore{
+		records: make(map[string]*IdempotencyRecord),
+		ttls:    make(map[string]time.Duration),
+	}
+	for _, rec := range records {
+		s.records[rec.Principal+"/"+rec.Key] = rec
+	}
+	return s
+}
+
+func (s *fakeIdempotencyStore) Claim(ctx context.Context, rec *IdempotencyRecord, ttl time.Duration) (*IdempotencyRecord, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	id := rec.Principal + "/" + rec.Key
+	if existing, ok := s.records[id]; ok {
+		return existing, nil
+	}
+	s.records[id] = rec
+	s.ttls[id] = ttl
+	return nil, nil
+}
+
+func (s *fakeIdempotencyStore) Complete(ctx context.Context, rec *IdempotencyRecord, ttl time.Duration) error {
+	if s.err != nil {
+		return s.err
+	}
+	id := rec.Principal + "/" + rec.Key
+	s.records[id] = rec
+	s.ttls[id] = ttl
+	return nil
+}
+
+func (s *fakeIdempotencyStore) Release(ctx context.Context, principal, key string) error {
+	if s.err != nil {
+		return s.err
+	}
+	delete(s.records, principal+"/"+key)
+	return nil
+}
+
+func (s *fakeIdempotencyStore) PurgeExpired(ctx context.Context) error {
+	return s.err
+}
+
+func newTestIdempotencyUseCase(store IdempotencyStore, ttl time.Duration) *IdempotencyUseCase {
+	c := &conf.Idempotency{}
+	if ttl > 0 {
+		c.Ttl = durationpb.New(ttl)
+	}
+	return NewIdempotencyUseCase(store, c, log.NewStdLogger(io.Discard))
+}
+
+func TestIdempotencyBegin(t *testing.T) {
+	storeErr := errors.New("store unavailable")
+
+	tests := []struct {
+		name      string
+		existing  *IdempotencyRecord
+		storeErr  error
+		key       string
+		want      []byte
+		wantErr   error
+		wantClaim bool
+	}{
+		{
+			name:      "new key is claimed",
+			key:       "k1",
+			wantClaim: true,
+		},
+		{
+			name:     "finished request is replayed",
+			existing: &IdempotencyRecord{Principal: "p", Key: "k1", RequestHash: "h1", Response: []byte("reply")},
+			key:      "k1",
+			want:     []byte("reply"),
+		},
+		{
+			name:     "key reused with another payload",
+			existing: &IdempotencyRecord{Principal: "p", Key: "k1", RequestHash: "h2", Response: []byte("reply")},
+			key:      "k1",
+			wantErr:  ErrIdempotencyKeyReused,
+		},
+		{
+			name:     "first request still running",
+			existing: &IdempotencyRecord{Principal: "p", Key: "k1", RequestHash: "h1"},
+			key:      "k1",
+			wantErr:  ErrIdempotencyInProgress,
+		},
+		{
+			name:      "other principal's key is separate",
+			existing:  &IdempotencyRecord{Principal: "other", Key: "k1", RequestHash: "h2", Response: []byte("reply")},
+			key:       "k1",
+			wantClaim: true,
+		},
+		{
+			name:    "key too long",
+			key:     strings.Repeat("k", MaxIdempotencyKeyLength+1),
+			wantErr: ErrInvalidIdempotencyKey,
+		},
+		{
+			name:     "store failure",
+			storeErr: storeErr,
+			key:      "k1",
+			wantErr:  storeErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newFakeIdempotencyStore()
+			if tt.existing != nil {
+				store = newFakeIdempotencyStore(tt.existing)
+			}
+			store.err = tt.storeErr
+			uc := newTestIdempotencyUseCase(store, 0)
+
+			got, err := uc.Begin(context.Background(), "p", tt.key, "h1")
+			if !errors.Is(err, tt.wantErr) || (err != nil) != (tt.wantErr != nil) {
+				t.Fatalf("Begin() error = %v, want %v", err, tt.wantErr)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("Begin() = %q, want %q", got, tt.want)
+			}
+
+			claimed, ok := store.records["p/"+tt.key]
+			if tt.wantClaim {
+				if !ok || claimed.RequestHash != "h1" || claimed.Response != nil {
+					t.Fatalf("Begin() stored %+v, want an in-progress claim with hash h1", claimed)
+				}
+				if ttl := store.ttls["p/"+tt.key]; ttl != idempotencyClaimTTL {
+					t.Errorf("claim ttl = %v, want %v", ttl, idempotencyClaimTTL)
+				}
+			}
+		})
+	}
+}
+
+func TestIdempotencyFinish(t *testing.T) {
+	claim := func() *IdempotencyRecord {
+		return &IdempotencyRecord{Principal: "p", Key: "k1", RequestHash: "h1"}
+	}
+
+	tests := []struct {
+		name     string
+		ttl      time.Duration
+		response []byte
+		storeErr error
+		want     *IdempotencyRecord // record left in the store, nil if none
+		wantTTL  time.Duration
+	}{
+		{
+			name:     "response is stored for the default ttl",
+			response: []byte("reply"),
+			want:     &IdempotencyRecord{Principal: "p", Key: "k1", RequestHash: "h1", Response: []byte("reply")},
+			wantTTL:  DefaultIdempotencyTTL,
+		},
+		{
+			name:     "response is stored for the configured ttl",
+			ttl:      time.Hour,
+			response: []byte("reply"),
+			want:     &IdempotencyRecord{Principal: "p", Key: "k1", RequestHash: "h1", Response: []byte("reply")},
+			wantTTL:  time.Hour,
+		},
+		{
+			name: "failed request releases the key",
+		},
+		{
+			name:     "store failure is only logged",
+			response: []byte("reply"),
+			storeErr: errors.New("store unavailable"),
+			want:     claim(),
+			wantTTL:  idempotencyClaimTTL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newFakeIdempotencyStore(claim())
+			store.ttls["p/k1"] = idempotencyClaimTTL
+			store.err = tt.storeErr
+			uc := newTestIdempotencyUseCase(store, tt.ttl)
+
+			uc.Finish(context.Background(), "p", "k1", "h1", tt.response)
+
+			got, ok := store.records["p/k1"]
+			if tt.want == nil {
+				if ok {
+					t.Fatalf("Finish() left %+v, want the key released", got)
+				}
+				return
+			}
+			if !ok {
+				t.Fatal("Finish() released the key, want it kept")
+			}
+			if got.RequestHash != tt.want.RequestHash || !bytes.Equal(got.Response, tt.want.Response) {
+				t.Errorf("Finish() stored %+v, want %+v", got, tt.want)
+			}
+			if ttl := store.ttls["p/k1"]; ttl != tt.wantTTL {
+				t.Errorf("ttl = %v, want %v", ttl, tt.wantTTL)
+			}
+		})
+	}
+}
+
+func TestIdempotencyRetryAfterFinish(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	uc := newTestIdempotencyUseCase(store, 0)
+	ctx := context.Background()
+
+	if _, err := uc.Begin(ctx, "p", "k1", "h1"); err != nil {
+		t.Fatalf("first Begin() error = %v", err)
+	}
+	uc.Finish(ctx, "p", "k1", "h1", []byte("reply"))
+
+	got, err := uc.Begin(ctx, "p", "k1", "h1")
+	if err != nil || string(got) != "reply" {
+		t.Fatalf("retried Begin() = %q, %v, want the stored reply", got, err)
+	}
+}
@@ -0,0 +1,315 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif" // GIF decoder
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// Image errors
+var (
+	ErrInvalidImage     = errors.New("invalid image")
+	ErrUnsupportedImage = errors.New("unsupported image type")
+	ErrImageTooLarge    = errors.New("image too large")
+	ErrImageNotFound    = errors.New("image not found")
+)
+
+// Defaults for the media configuration
+const (
+	DefaultMaxImageBytes   = 10 << 20
+	defaultMaxImagePixels  = 50_000_000
+	defaultThumbnailWidths = "160,320,640,1280"
+	thumbnailJPEGQuality   = 85
+)
+
+// ImageKind tells how an image is used
+type ImageKind string
+
+// Image kinds
+const (
+	ImagePoster ImageKind = "poster"
+	ImageStill  ImageKind = "still"
+)
+
+// Valid reports whether the kind is one of the known image kinds
+func (k ImageKind) Valid() bool {
+	return k == ImagePoster || k == ImageStill
+}
+
+// MovieImage is an uploaded poster or still with its thumbnails
+type MovieImage struct {
+	ID          string
+	Kind        ImageKind
+	ContentType string
+	Width       int32
+	Height      int32
+	SizeBytes   int64
+	Key         string // Blob store key of the original
+	URL         string
+	Thumbnails  []*Thumbnail // Narrowest first
+	CreatedAt   time.Time
+}
+
+// Thumbnail is a downscaled copy of an image
+type Thumbnail struct {
+	Width  int32
+	Height int32
+	Key    string
+	URL    string
+}
+
+// imageFormats maps the sniffed content types accepted for upload to file extensions
+var imageFormats = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+}
+
+// ImageUseCase handles movie image uploads
+type ImageUseCase struct {
+	movieRepo       MovieRepo
+	store           BlobStore
+	maxBytes        int64
+	maxPixels       int64
+	thumbnailWidths []int
+	log             *log.Helper
+}
+
+// NewImageUseCase creates a new ImageUseCase
+func NewImageUseCase(movieRepo MovieRepo, store BlobStore, c *conf.Media, logger log.Logger) (*ImageUseCase, error) {
+	uc := &ImageUseCase{
+		movieRepo: movieRepo,
+		store:     store,
+		maxBytes:  c.GetMaxUploadBytes(),
+		maxPixels: c.GetMaxPixels(),
+		log:       log.NewHelper(logger),
+	}
+	if uc.maxBytes <= 0 {
+		uc.maxBytes = DefaultMaxImageBytes
+	}
+	if uc.maxPixels <= 0 {
+		uc.maxPixels = defaultMaxImagePixels
+	}
+
+	widths := c.GetThumbnailWidths()
+	if widths == "" {
+		widths = defaultThumbnailWidths
+	}
+	for _, w := range strings.Split(widths, ",") {
+		width, err := strconv.Atoi(strings.TrimSpace(w))
+		if err != nil || width <= 0 {
+			return nil, fmt.Errorf("invalid thumbnail width %q", w)
+		}
+		uc.thumbnailWidths = append(uc.thumbnailWidths, width)
+	}
+	slices.Sort(uc.thumbnailWidths)
+	uc.thumbnailWidths = slices.Compact(uc.thumbnailWidths)
+	return uc, nil
+}
+
+// UploadMovieImage validates an uploaded image, stores it with its
+// thumbnails and attaches it to the movie. The content type is sniffed from
+// the data; the client's claim is not trusted.
+func (uc *ImageUseCase) UploadMovieImage(ctx context.Context, movieID string, kind ImageKind, data []byte) (*MovieImage, error) {
+	movie, err := uc.movieRepo.GetMovieByID(ctx, movieID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMovieNotFound, err)
+	}
+	// Images do not depend on the rest of the movie, so apply to whatever version is current
+	movie.Version = 0
+
+	if kind == "" {
+		kind = ImageStill
+	}
+	if !kind.Valid() {
+		return nil, fmt.Errorf("%w: kind must be poster or still", ErrInvalidImage)
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: file is empty", ErrInvalidImage)
+	}
+	if int64(len(data)) > uc.maxBytes {
+		return nil, fmt.Errorf("%w: larger than %d bytes", ErrImageTooLarge, uc.maxBytes)
+	}
+
+	contentType := http.DetectContentType(data)
+	ext, ok := imageFormats[contentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s, expected JPEG, PNG or GIF", ErrUnsupportedImage, contentType)
+	}
+
+	// Check the dimensions before decoding so huge images are not decompressed
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, fmt.Errorf("%w: image has no pixels", ErrInvalidImage)
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > uc.maxPixels {
+		return nil, fmt.Errorf("%w: %dx%d exceeds %d pixels", ErrImageTooLarge, cfg.Width, cfg.Height, uc.maxPixels)
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate image ID: %w", err)
+	}
+	img := &MovieImage{
+		ID:          id.String(),
+		Kind:        kind,
+		ContentType: contentType,
+		Width:       int32(cfg.Width),
+		Height:      int32(cfg.Height),
+		SizeBytes:   int64(len(data)),
+		Key:         fmt.Sprintf("movies/%s/images/%s/original.%s", movie.ID, id, ext),
+	}
+
+	// Remove whatever was stored if a later step fails
+	var stored []string
+	fail := func(err error) (*MovieImage, error) {
+		if len(stored) > 0 {
+			if delErr := uc.store.Delete(context.WithoutCancel(ctx), stored...); delErr != nil {
+				uc.log.Warnf("failed to clean up blobs of image %s: %v", img.ID, delErr)
+			}
+		}
+		return nil, err
+	}
+
+	if img.URL, err = uc.store.Put(ctx, img.Key, contentType, data); err != nil {
+		return fail(fmt.Errorf("failed to store image: %w", err))
+	}
+	stored = append(stored, img.Key)
+
+	// Thumbnails of PNG and GIF images stay PNG to keep transparency
+	thumbType, thumbExt := "image/jpeg", "jpg"
+	if contentType != "image/jpeg" {
+		thumbType, thumbExt = "image/png", "png"
+	}
+	for _, width := range uc.thumbnailWidths {
+		if width >= cfg.Width {
+			break
+		}
+		resized := resizeImage(src, width)
+		encoded, err := encodeImage(resized, thumbType)
+		if err != nil {
+			return fail(fmt.Errorf("failed to encode thumbnail: %w", err))
+		}
+		thumb := &Thumbnail{
+			Width:  int32(resized.Bounds().Dx()),
+			Height: int32(resized.Bounds().Dy()),
+			Key:    fmt.Sprintf("movies/%s/images/%s/w%d.%s", movie.ID, id, width, thumbExt),
+		}
+		if thumb.URL, err = uc.store.Put(ctx, thumb.Key, thumbType, encoded); err != nil {
+			return fail(fmt.Errorf("failed to store thumbnail: %w", err))
+		}
+		stored = append(stored, thumb.Key)
+		img.Thumbnails = append(img.Thumbnails, thumb)
+	}
+
+	if err := uc.movieRepo.AddImage(ctx, movie, img); err != nil {
+		return fail(fmt.Errorf("failed to save image: %w", err))
+	}
+	return img, nil
+}
+
+// DeleteMovieImage detaches an image from the movie and removes its blobs
+func (uc *ImageUseCase) DeleteMovieImage(ctx context.Context, movieID, imageID string) error {
+	movie, err := uc.movieRepo.GetMovieByID(ctx, movieID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrMovieNotFound, err)
+	}
+	movie.Version = 0
+
+	img, err := uc.movieRepo.DeleteImage(ctx, movie, imageID)
+	if err != nil {
+		return fmt.Errorf("failed to delete image: %w", err)
+	}
+
+	// The image is already gone from the movie; orphaned blobs are only logged
+	keys := []string{img.Key}
+	for _, t := range img.Thumbnails {
+		keys = append(keys, t.Key)
+	}
+	if err := uc.store.Delete(ctx, keys...); err != nil {
+		uc.log.Warnf("failed to delete blobs of image %s: %v", img.ID, err)
+	}
+	return nil
+}
+
+// resizeImage scales src down to the given width, keeping the aspect ratio.
+// Each target pixel averages the source pixels it covers (box filter).
+func resizeImage(src image.Image, width int) *image.RGBA {
+	sb := src.Bounds()
+	sw, sh := sb.Dx(), sb.Dy()
+	height := max(1, (sh*width+sw/2)/sw)
+
+	// Work on premultiplied RGBA so transparent pixels average correctly
+	rgba, ok := src.(*image.RGBA)
+	if !ok || sb.Min != (image.Point{}) {
+		rgba = image.NewRGBA(image.Rect(0, 0, sw, sh))
+		draw.Draw(rgba, rgba.Bounds(), src, sb.Min, draw.Src)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := y * sh / height
+		y1 := max((y+1)*sh/height, y0+1)
+		for x := 0; x < width; x++ {
+			x0 := x * sw / width
+			x1 := max((x+1)*sw/width, x0+1)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				row := rgba.Pix[sy*rgba.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					r += uint64(p[0])
+					g += uint64(p[1])
+					b += uint64(p[2])
+					a += uint64(p[3])
+					n++
+				}
+			}
+			d := dst.Pix[y*dst.Stride+x*4:]
+			d[0] = uint8(r / n)
+			d[1] = uint8(g / n)
+			d[2] = uint8(b / n)
+			d[3] = uint8(a / n)
+		}
+	}
+	return dst
+}
+
+// encodeImage encodes img as JPEG or PNG
+func encodeImage(img image.Image, contentType string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	if contentType == "image/jpeg" {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: thumbnailJPEGQuality})
+	} else {
+		err = png.Encode(&buf, img)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,271 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErrInvalidImport is returned for a malformed import file or record
+var ErrInvalidImport = errors.New("invalid import")
+
+// Defaults for imports
+const (
+	DefaultImportBatchSize = 500
+	MaxImportBatchSize     = 5000
+	DefaultBoxOfficeRate   = 5.0 // Box-office requests per second
+)
+
+// ImportFormat is the file format of a bulk import
+type ImportFormat string
+
+// Import formats
+const (
+	ImportCSV   ImportFormat = "csv"
+	ImportJSONL ImportFormat = "jsonl"
+)
+
+// ImportMode tells what happens to a record whose movie already exists, that
+// is a movie with the same title and release year
+type ImportMode string
+
+// Import modes
+const (
+	ImportSkipExisting ImportMode = "skip"   // Leave the existing movie as it is
+	ImportUpsert       ImportMode = "upsert" // Update the existing movie from the record
+)
+
+// ImportStatus is the outcome of one import record
+type ImportStatus string
+
+// Import statuses. In a dry run they tell what would have happened.
+const (
+	ImportCreated ImportStatus = "created"
+	ImportUpdated ImportStatus = "updated"
+	ImportSkipped ImportStatus = "skipped"
+	ImportFailed  ImportStatus = "failed"
+)
+
+// ImportOptions controls a bulk import
+type ImportOptions struct {
+	Format    ImportFormat
+	Mode      ImportMode // Defaults to ImportSkipExisting
+	DryRun    bool       // Validate and report without writing anything
+	BatchSize int        // Records per transaction; defaults to DefaultImportBatchSize
+
+	// Validate checks a record against the API rules of a CreateMovie
+	// request, which the service layer owns; records it rejects fail
+	Validate func(*CreateMovieRequest) error
+
+	// BoxOffice enriches created and updated movies from the box-office API
+	// like CreateMovie does, at most BoxOfficeRate requests per second
+	BoxOffice     bool
+	BoxOfficeRate float64
+}
+
+// ImportRowResult reports the outcome of one record
+type ImportRowResult struct {
+	Line    int // Line of the file the record starts on
+	Title   string
+	Status  ImportStatus
+	MovieID string // Created, updated or skipped movie
+	Error   string // Why the record failed
+}
+
+// ImportReport summarizes a bulk import
+type ImportReport struct {
+	DryRun  bool
+	Created int
+	Updated int
+	Skipped int
+	Failed  int
+	Rows    []*ImportRowResult
+}
+
+// ImportOutcome is what the repository did with one movie of a batch
+type ImportOutcome struct {
+	Status  ImportStatus
+	MovieID string
+	Err     error
+}
+
+// pendingImport is a validated record waiting for its batch
+type pendingImport struct {
+	row   *ImportRowResult
+	movie *Movie
+}
+
+// ImportMovies creates movies in bulk from a CSV or JSONL file. Every record
+// is validated like a CreateMovie request; invalid records are reported and
+// the others imported in batches, each in one transaction.
+func (uc *MovieUseCase) ImportMovies(ctx context.Context, r io.Reader, opts ImportOptions) (*ImportReport, error) {
+	if opts.Mode == "" {
+		opts.Mode = ImportSkipExisting
+	}
+	if opts.Mode != ImportSkipExisting && opts.Mode != ImportUpsert {
+		return nil, fmt.Errorf("%w: unknown mode %q, expected skip or upsert", ErrInvalidImport, opts.Mode)
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultImportBatchSize
+	}
+	opts.BatchSize = min(opts.BatchSize, MaxImportBatchSize)
+	if opts.BoxOfficeRate <= 0 {
+		opts.BoxOfficeRate = DefaultBoxOfficeRate
+	}
+
+	reader, err := newImportReader(opts.Format, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var throttle *time.Ticker
+	if opts.BoxOffice && !opts.DryRun {
+		throttle = time.NewTicker(time.Duration(float64(time.Second) / opts.BoxOfficeRate))
+		defer throttle.Stop()
+	}
+
+	report := &ImportReport{DryRun: opts.DryRun}
+	seen := make(map[string]int) // Line of the first record per title and year
+	batch := make([]*pendingImport, 0, opts.BatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := uc.importBatch(ctx, batch, opts, throttle)
+		batch = batch[:0]
+		return err
+	}
+
+	for {
+		line, rec, err := reader.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && line == 0 {
+			return nil, err
+		}
+
+		row := &ImportRowResult{Line: line}
+		report.Rows = append(report.Rows, row)
+		if err != nil {
+			row.Status, row.Error = ImportFailed, err.Error()
+			continue
+		}
+		row.Title = rec.Title
+
+		movie, err := uc.importMovie(ctx, rec, opts.Validate)
+		if err != nil {
+			row.Status, row.Error = ImportFailed, err.Error()
+			continue
+		}
+		key := DisambiguatedTitle(movie.Title, movie.ReleaseDate.Year())
+		if first, ok := seen[key]; ok {
+			row.Status, row.Error = ImportFailed, fmt.Sprintf("%v: same title and year as line %d", ErrInvalidImport, first)
+			continue
+		}
+		seen[key] = line
+
+		batch = append(batch, &pendingImport{row: row, movie: movie})
+		if len(batch) == opts.BatchSize {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	for _, row := range report.Rows {
+		switch row.Status {
+		case ImportCreated:
+			report.Created++
+		case ImportUpdated:
+			report.Updated++
+		case ImportSkipped:
+			report.Skipped++
+		case ImportFailed:
+			report.Failed++
+		}
+	}
+	return report, nil
+}
+
+// importMovie validates a record with the rules of CreateMovie
+func (uc *MovieUseCase) importMovie(ctx context.Context, rec *importRecord, validate func(*CreateMovieRequest) error) (*Movie, error) {
+	req, err := rec.toRequest()
+	if err != nil {
+		return nil, err
+	}
+	if validate != nil {
+		if err := validate(req); err != nil {
+			return nil, err
+		}
+	}
+	return uc.newMovie(ctx, req)
+}
+
+// importBatch enriches the movies of a batch if asked to, then stores them
+// in one transaction and records each outcome on its row
+func (uc *MovieUseCase) importBatch(ctx context.Context, batch []*pendingImport, opts ImportOptions, throttle *time.Ticker) error {
+	movies := make([]*Movie, 0, len(batch))
+	for _, p := range batch {
+		movies = append(movies, p.movie)
+	}
+
+	if throttle != nil {
+		if err := uc.enrichImport(ctx, movies, opts.Mode, throttle); err != nil {
+			return err
+		}
+	}
+
+	outcomes, err := uc.repo.ImportMovies(ctx, movies, opts.Mode, opts.DryRun)
+	if err != nil {
+		return fmt.Errorf("failed to import movies: %w", err)
+	}
+	for i, outcome := range outcomes {
+		row := batch[i].row
+		row.Status = outcome.Status
+		row.MovieID = outcome.MovieID
+		if outcome.Err != nil {
+			row.Error = outcome.Err.Error()
+		}
+		if outcome.Status == ImportCreated && !opts.DryRun {
+			uc.metrics.MovieCreated("import")
+		}
+	}
+	return nil
+}
+
+// enrichImport fetches box office data for the movies the batch will create
+// or update, waiting for the throttle before each request. Failures leave
+// the movie without box office data, as in CreateMovie.
+func (uc *MovieUseCase) enrichImport(ctx context.Context, movies []*Movie, mode ImportMode, throttle *time.Ticker) error {
+	existing, err := uc.repo.MatchMovies(ctx, movies)
+	if err != nil {
+		return fmt.Errorf("failed to match existing movies: %w", err)
+	}
+
+	for i, movie := range movies {
+		if existing[i] != "" && mode == ImportSkipExisting {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-throttle.C:
+		}
+
+		boxOfficeData, err := uc.boxOfficeClient.GetBoxOffice(ctx, movie.Title, movie.ExternalIDs)
+		if err != nil {
+			uc.log.Warnf("Failed to fetch box office data for movie '%s': %v", movie.Title, err)
+			continue
+		}
+		if boxOfficeData != nil {
+			mergeBoxOffice(movie, boxOfficeData)
+		}
+	}
+	return nil
+}
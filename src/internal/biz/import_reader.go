@@ -0,0 +1,242 @@
+package biz
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// importListSeparator separates the genres and tags in a CSV cell
+const importListSeparator = "|"
+
+// maxImportLineBytes bounds a single JSONL line
+const maxImportLineBytes = 1 << 20
+
+// importColumns are the CSV columns an import may have; title, genre and
+// release_date are required
+var importColumns = []string{
+	"title", "genre", "release_date", "distributor", "budget", "mpa_rating",
+	"genres", "tags", "imdb_id", "tmdb_id", "eidr",
+}
+
+// importRecord is one movie of an import file. JSONL lines are objects with
+// these keys; CSV rows use the same names as columns, with genres and tags
+// separated by "|" and no credits.
+type importRecord struct {
+	Title       string   `json:"title"`
+	Genre       string   `json:"genre"`
+	ReleaseDate string   `json:"release_date"`
+	Distributor *string  `json:"distributor"`
+	Budget      *int64   `json:"budget"`
+	MPARating   *string  `json:"mpa_rating"`
+	Genres      []string `json:"genres"`
+	Tags        []string `json:"tags"`
+	IMDbID      string   `json:"imdb_id"`
+	TMDBID      string   `json:"tmdb_id"`
+	EIDR        string   `json:"eidr"`
+	Credits     []struct {
+		PersonID      string  `json:"person_id"`
+		Role          string  `json:"role"`
+		CharacterName *string `json:"character_name"`
+		BillingOrder  *int32  `json:"billing_order"`
+	} `json:"credits"`
+}
+
+// toRequest converts the record to a creation request, failing if the release
+// date does not parse. newMovie checks the other fields, with the rules the
+// API applies to CreateMovie requests.
+func (rec *importRecord) toRequest() (*CreateMovieRequest, error) {
+	releaseDate, err := time.Parse("2006-01-02", rec.ReleaseDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid release_date format, expected YYYY-MM-DD: %v", ErrInvalidImport, err)
+	}
+
+	req := &CreateMovieRequest{
+		Title:       rec.Title,
+		Genre:       rec.Genre,
+		ReleaseDate: releaseDate,
+		Distributor: rec.Distributor,
+		Budget:      rec.Budget,
+		MPARating:   rec.MPARating,
+		Genres:      rec.Genres,
+		Tags:        rec.Tags,
+	}
+	for scheme, id := range map[string]string{SchemeIMDb: rec.IMDbID, SchemeTMDB: rec.TMDBID, SchemeEIDR: rec.EIDR} {
+		if id == "" {
+			continue
+		}
+		if req.ExternalIDs == nil {
+			req.ExternalIDs = make(map[string]string)
+		}
+		req.ExternalIDs[scheme] = id
+	}
+	for _, c := range rec.Credits {
+		req.Credits = append(req.Credits, &Credit{
+			PersonID:      c.PersonID,
+			Role:          CreditRole(c.Role),
+			CharacterName: c.CharacterName,
+			BillingOrder:  c.BillingOrder,
+		})
+	}
+	return req, nil
+}
+
+// importReader reads the records of an import file one at a time. next
+// returns the line the record starts on. An error with a line fails only that
+// record; one without (line 0) fails the import, and io.EOF ends the file.
+type importReader interface {
+	next() (line int, rec *importRecord, err error)
+}
+
+// newImportReader checks the file header, if any, and returns its reader.
+// Errors here fail the whole import.
+func newImportReader(format ImportFormat, r io.Reader) (importReader, error) {
+	switch format {
+	case ImportCSV:
+		return newCSVImportReader(r)
+	case ImportJSONL:
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64<<10), maxImportLineBytes)
+		return &jsonlImportReader{scanner: scanner}, nil
+	}
+	return nil, fmt.Errorf("%w: unknown format %q, expected csv or jsonl", ErrInvalidImport, format)
+}
+
+type csvImportReader struct {
+	reader  *csv.Reader
+	columns []string
+}
+
+func newCSVImportReader(r io.Reader) (*csvImportReader, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: file is empty", ErrInvalidImport)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read CSV header: %v", ErrInvalidImport, err)
+	}
+
+	columns := make([]string, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if !slices.Contains(importColumns, name) {
+			return nil, fmt.Errorf("%w: unknown column %q, expected %s", ErrInvalidImport, name, strings.Join(importColumns, ", "))
+		}
+		if slices.Contains(columns[:i], name) {
+			return nil, fmt.Errorf("%w: column %q appears twice", ErrInvalidImport, name)
+		}
+		columns[i] = name
+	}
+	for _, required := range []string{"title", "genre", "release_date"} {
+		if !slices.Contains(columns, required) {
+			return nil, fmt.Errorf("%w: missing column %q", ErrInvalidImport, required)
+		}
+	}
+	return &csvImportReader{reader: reader, columns: columns}, nil
+}
+
+func (r *csvImportReader) next() (int, *importRecord, error) {
+	fields, err := r.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return 0, nil, io.EOF
+	}
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return parseErr.StartLine, nil, fmt.Errorf("%w: %v", ErrInvalidImport, parseErr.Err)
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	line, _ := r.reader.FieldPos(0)
+
+	rec := &importRecord{}
+	for i, value := range fields {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		switch r.columns[i] {
+		case "title":
+			rec.Title = value
+		case "genre":
+			rec.Genre = value
+		case "release_date":
+			rec.ReleaseDate = value
+		case "distributor":
+			rec.Distributor = &value
+		case "budget":
+			budget, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return line, nil, fmt.Errorf("%w: budget must be an integer, got %q", ErrInvalidImport, value)
+			}
+			rec.Budget = &budget
+		case "mpa_rating":
+			rec.MPARating = &value
+		case "genres":
+			rec.Genres = splitImportList(value)
+		case "tags":
+			rec.Tags = splitImportList(value)
+		case "imdb_id":
+			rec.IMDbID = value
+		case "tmdb_id":
+			rec.TMDBID = value
+		case "eidr":
+			rec.EIDR = value
+		}
+	}
+	return line, rec, nil
+}
+
+// splitImportList splits a "|"-separated cell, dropping empty items
+func splitImportList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, importListSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+type jsonlImportReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func (r *jsonlImportReader) next() (int, *importRecord, error) {
+	for r.scanner.Scan() {
+		r.line++
+		data := bytes.TrimSpace(r.scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		rec := &importRecord{}
+		if err := decoder.Decode(rec); err != nil {
+			return r.line, nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+		}
+		if decoder.More() {
+			return r.line, nil, fmt.Errorf("%w: more than one JSON value on the line", ErrInvalidImport)
+		}
+		return r.line, rec, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			err = fmt.Errorf("%w: line %d is longer than %d bytes", ErrInvalidImport, r.line+1, maxImportLineBytes)
+		}
+		return 0, nil, err
+	}
+	return 0, nil, io.EOF
+}
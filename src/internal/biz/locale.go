@@ -0,0 +1,170 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+// DefaultLocale is the language of titles and untranslated genre names; it
+// ends every locale chain
+const DefaultLocale = "en"
+
+// Translation errors
+var (
+	ErrInvalidTranslation  = errors.New("invalid translation")
+	ErrTranslationNotFound = errors.New("translation not found")
+)
+
+// Limits on translated text
+const (
+	maxSynopsisLength = 5000
+	maxTaglineLength  = 500
+	maxGenreNameLen   = 100
+)
+
+// MovieTranslation is the text of a movie in one locale
+type MovieTranslation struct {
+	Locale   string
+	Synopsis *string
+	Tagline  *string
+}
+
+// Localization is a movie's text chosen for the caller's locale chain
+type Localization struct {
+	Locale   string // Locale the synopsis and tagline are in; DefaultLocale if none matched
+	Synopsis *string
+	Tagline  *string
+	Genres   []string // Localized names of Movie.Genres, in the same order
+}
+
+// Localize picks the first translation along the locale chain. Genre names
+// fall back along the chain on their own, ending at the canonical name.
+func (m *Movie) Localize(locales []string) *Localization {
+	l := &Localization{Locale: DefaultLocale}
+	byLocale := make(map[string]*MovieTranslation, len(m.Translations))
+	for _, t := range m.Translations {
+		byLocale[t.Locale] = t
+	}
+	for _, locale := range locales {
+		if t, ok := byLocale[locale]; ok {
+			l.Locale = t.Locale
+			l.Synopsis = t.Synopsis
+			l.Tagline = t.Tagline
+			break
+		}
+	}
+
+	l.Genres = make([]string, 0, len(m.Genres))
+	for _, genre := range m.Genres {
+		l.Genres = append(l.Genres, localizedName(genre, m.GenreTranslations[genre], locales))
+	}
+	return l
+}
+
+// localizedName returns the first name along the locale chain, or name itself
+func localizedName(name string, translations map[string]string, locales []string) string {
+	for _, locale := range locales {
+		if localized, ok := translations[locale]; ok {
+			return localized
+		}
+	}
+	return name
+}
+
+// CanonicalLocale validates a BCP 47 tag and returns it in canonical form,
+// e.g. "pt-br" becomes "pt-BR"
+func CanonicalLocale(s string) (string, error) {
+	tag, err := language.Parse(strings.TrimSpace(s))
+	if err != nil || tag == language.Und {
+		return "", fmt.Errorf("%w: locale must be a BCP 47 tag such as pt-BR", ErrInvalidTranslation)
+	}
+	return tag.String(), nil
+}
+
+// LocaleChain turns an Accept-Language header into the locales to try in
+// order: each accepted tag by preference followed by its less specific
+// forms (zh-Hant-TW, zh-Hant, zh), then DefaultLocale
+func LocaleChain(acceptLanguage string) []string {
+	var chain []string
+	seen := make(map[string]bool)
+	add := func(locale string) {
+		if !seen[locale] {
+			seen[locale] = true
+			chain = append(chain, locale)
+		}
+	}
+
+	// A malformed header still yields the tags parsed before the error
+	tags, _, _ := language.ParseAcceptLanguage(acceptLanguage)
+	for _, tag := range tags {
+		if tag == language.Und {
+			continue
+		}
+		locale := tag.String()
+		for {
+			add(locale)
+			i := strings.LastIndexByte(locale, '-')
+			if i < 0 {
+				break
+			}
+			locale = locale[:i]
+		}
+	}
+	add(DefaultLocale)
+	return chain
+}
+
+type localesKey struct{}
+
+// NewLocaleContext returns a context carrying the caller's locale chain
+func NewLocaleContext(ctx context.Context, locales []string) context.Context {
+	return context.WithValue(ctx, localesKey{}, locales)
+}
+
+// LocalesFromContext returns the caller's locale chain, or just
+// DefaultLocale if the transport set none
+func LocalesFromContext(ctx context.Context) []string {
+	if locales, ok := ctx.Value(localesKey{}).([]string); ok {
+		return locales
+	}
+	return []string{DefaultLocale}
+}
+
+// normalizeMovieTranslation validates a translation and canonicalizes its locale
+func normalizeMovieTranslation(t *MovieTranslation) error {
+	locale, err := CanonicalLocale(t.Locale)
+	if err != nil {
+		return err
+	}
+	t.Locale = locale
+
+	t.Synopsis = trimmedOrNil(t.Synopsis)
+	t.Tagline = trimmedOrNil(t.Tagline)
+	if t.Synopsis == nil && t.Tagline == nil {
+		return fmt.Errorf("%w: synopsis or tagline is required", ErrInvalidTranslation)
+	}
+	if t.Synopsis != nil && utf8.RuneCountInString(*t.Synopsis) > maxSynopsisLength {
+		return fmt.Errorf("%w: synopsis is longer than %d characters", ErrInvalidTranslation, maxSynopsisLength)
+	}
+	if t.Tagline != nil && utf8.RuneCountInString(*t.Tagline) > maxTaglineLength {
+		return fmt.Errorf("%w: tagline is longer than %d characters", ErrInvalidTranslation, maxTaglineLength)
+	}
+	return nil
+}
+
+// trimmedOrNil trims s and returns nil if nothing is left
+func trimmedOrNil(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*s)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
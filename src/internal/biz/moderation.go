@@ -0,0 +1,115 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Moderation errors
+var (
+	ErrRatingNotFound          = errors.New("rating not found")
+	ErrInvalidModerationAction = errors.New("invalid moderation action")
+	ErrInvalidModerationStatus = errors.New("invalid moderation status")
+)
+
+// defaultReportThreshold is used when no threshold is configured
+const defaultReportThreshold = 3
+
+// ModerationUseCase handles review reporting and the moderation queue
+type ModerationUseCase struct {
+	repo            ModerationRepo
+	reportThreshold int32
+	log             *log.Helper
+}
+
+// NewModerationUseCase creates a new ModerationUseCase instance
+func NewModerationUseCase(repo ModerationRepo, c *conf.Moderation, logger log.Logger) *ModerationUseCase {
+	threshold := int32(defaultReportThreshold)
+	if c != nil && c.ReportThreshold > 0 {
+		threshold = c.ReportThreshold
+	}
+	return &ModerationUseCase{
+		repo:            repo,
+		reportThreshold: threshold,
+		log:             log.NewHelper(logger),
+	}
+}
+
+// ReportRating records a user report and queues the rating once enough reports arrive
+func (uc *ModerationUseCase) ReportRating(ctx context.Context, id int64, reporterID string, reason *string) (*Rating, error) {
+	rating, err := uc.repo.GetRating(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRatingNotFound, err)
+	}
+
+	count, err := uc.repo.AddReport(ctx, id, reporterID, reason)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add report: %w", err)
+	}
+	rating.ReportCount = count
+
+	// Only unreviewed content is queued; moderator decisions stick, even one
+	// made since the rating was read
+	if rating.ModerationStatus == ModerationNone && count >= uc.reportThreshold {
+		queueReason := fmt.Sprintf("reported by %d users", count)
+		updated, err := uc.repo.SetModerationStatus(ctx, id, rating.Version, ModerationPending, &queueReason)
+		if errors.Is(err, ErrVersionConflict) {
+			updated, err = uc.repo.GetRating(ctx, id)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to queue rating: %w", err)
+		}
+		rating = updated
+	}
+
+	return rating, nil
+}
+
+// ListQueue lists ratings in a moderation state (pending by default)
+func (uc *ModerationUseCase) ListQueue(ctx context.Context, query *ModerationQueueQuery) (*ModerationQueuePage, error) {
+	switch query.Status {
+	case "":
+		query.Status = ModerationPending
+	case ModerationPending, ModerationApproved, ModerationHidden:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidModerationStatus, query.Status)
+	}
+
+	page, err := uc.repo.ListQueue(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list moderation queue: %w", err)
+	}
+	return page, nil
+}
+
+// Moderate applies a moderator action ("approve" or "hide") to a rating. A
+// non-zero version is the one the moderator reviewed; if the rating has
+// changed since, the action fails with a *VersionConflictError.
+func (uc *ModerationUseCase) Moderate(ctx context.Context, id, version int64, action string, reason *string) (*Rating, error) {
+	var status ModerationStatus
+	switch action {
+	case "approve":
+		status = ModerationApproved
+	case "hide":
+		status = ModerationHidden
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidModerationAction, action)
+	}
+
+	if _, err := uc.repo.GetRating(ctx, id); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRatingNotFound, err)
+	}
+
+	rating, err := uc.repo.SetModerationStatus(ctx, id, version, status, reason)
+	if err != nil {
+		return nil, fmt.Errorf("failed to moderate rating: %w", err)
+	}
+
+	uc.log.Infof("rating %d moderated: %s", id, status)
+	return rating, nil
+}
@@ -2,6 +2,7 @@ package biz
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,80 +10,143 @@ import (
 	"github.com/google/uuid"
 )
 
+// ErrPreconditionFailed is returned for a write conditioned on a state of the
+// movie it no longer has, such as an If-Match ETag, or on a movie that
+// cannot be read
+var ErrPreconditionFailed = errors.New("movie has changed since it was read")
+
+// ErrVersionConflict is matched by every *VersionConflictError
+var ErrVersionConflict = errors.New("version conflict")
+
+// VersionConflictError is returned for a write made against a version of a
+// movie or rating that another write has since replaced
+type VersionConflictError struct {
+	Resource string // "movie" or "rating"
+	ID       string
+	Expected int64
+	Current  int64 // 0 if the rating does not exist yet
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("%s %s is at version %d, not %d; reload it and retry", e.Resource, e.ID, e.Current, e.Expected)
+}
+
+// Is makes errors.Is(err, ErrVersionConflict) match
+func (e *VersionConflictError) Is(target error) bool {
+	return target == ErrVersionConflict
+}
+
 // MovieUseCase handles movie-related business logic
 type MovieUseCase struct {
 	repo            MovieRepo
+	personRepo      PersonRepo
 	boxOfficeClient BoxOfficeClient
+	metrics         Metrics
 	log             *log.Helper
 }
 
 // NewMovieUseCase creates a new MovieUseCase instance
-func NewMovieUseCase(repo MovieRepo, boxOfficeClient BoxOfficeClient, logger log.Logger) *MovieUseCase {
+func NewMovieUseCase(repo MovieRepo, personRepo PersonRepo, boxOfficeClient BoxOfficeClient, metrics Metrics, logger log.Logger) *MovieUseCase {
 	return &MovieUseCase{
 		repo:            repo,
+		personRepo:      personRepo,
 		boxOfficeClient: boxOfficeClient,
+		metrics:         metrics,
 		log:             log.NewHelper(logger),
 	}
 }
 
 // CreateMovie creates a new movie and fetches box office data
 func (uc *MovieUseCase) CreateMovie(ctx context.Context, req *CreateMovieRequest) (*Movie, error) {
-	// Generate movie ID (UUID v7: time-ordered, distributed-friendly)
-	movieID, err := uuid.NewV7()
+	movie, err := uc.newMovie(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate movie ID: %w", err)
-	}
-
-	// Create base movie object
-	movie := &Movie{
-		ID:          movieID.String(),
-		Title:       req.Title,
-		ReleaseDate: req.ReleaseDate,
-		Genre:       req.Genre,
-		Distributor: req.Distributor,
-		Budget:      req.Budget,
-		MPARating:   req.MPARating,
+		return nil, err
 	}
 
 	// Try to fetch box office data (non-blocking on failure)
-	boxOfficeData, err := uc.boxOfficeClient.GetBoxOffice(ctx, req.Title)
+	boxOfficeData, err := uc.boxOfficeClient.GetBoxOffice(ctx, req.Title, movie.ExternalIDs)
 	if err != nil {
 		uc.log.Warnf("Failed to fetch box office data for movie '%s': %v", req.Title, err)
 		// Continue with creation, boxOffice will be nil
 	} else if boxOfficeData != nil {
-		// Merge box office data, but user-provided values take precedence
-		if movie.Distributor == nil && boxOfficeData.Distributor != nil {
-			movie.Distributor = boxOfficeData.Distributor
-		}
-		if movie.Budget == nil && boxOfficeData.Budget != nil {
-			movie.Budget = boxOfficeData.Budget
-		}
-		if movie.MPARating == nil && boxOfficeData.MPARating != nil {
-			movie.MPARating = boxOfficeData.MPARating
-		}
-
-		// Set box office data
-		if boxOfficeData.Revenue != nil {
-			movie.BoxOffice = &BoxOffice{
-				Revenue: Revenue{
-					Worldwide:         boxOfficeData.Revenue.Worldwide,
-					OpeningWeekendUSA: boxOfficeData.Revenue.OpeningWeekendUSA,
-				},
-				Currency:    "USD", // Default currency
-				Source:      "BoxOfficeAPI",
-				LastUpdated: time.Now().UTC(),
-			}
-		}
+		mergeBoxOffice(movie, boxOfficeData)
 	}
 
 	// Save to database
 	if err := uc.repo.CreateMovie(ctx, movie); err != nil {
 		return nil, fmt.Errorf("failed to create movie: %w", err)
 	}
+	uc.metrics.MovieCreated("api")
 
 	return movie, nil
 }
 
+// newMovie validates a creation request and builds the movie with a new ID
+func (uc *MovieUseCase) newMovie(ctx context.Context, req *CreateMovieRequest) (*Movie, error) {
+	genres, err := normalizeGenres(req.Genre, req.Genres)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := normalizeTags(req.Tags)
+	if err != nil {
+		return nil, err
+	}
+	externalIDs, err := normalizeExternalIDs(req.ExternalIDs)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCredits(ctx, uc.personRepo, req.Credits); err != nil {
+		return nil, err
+	}
+
+	// Generate movie ID (UUID v7: time-ordered, distributed-friendly)
+	movieID, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate movie ID: %w", err)
+	}
+
+	return &Movie{
+		ID:          movieID.String(),
+		Title:       req.Title,
+		ReleaseDate: req.ReleaseDate,
+		Genre:       genres[0],
+		Genres:      genres,
+		Tags:        tags,
+		Distributor: req.Distributor,
+		Budget:      req.Budget,
+		MPARating:   req.MPARating,
+		Credits:     req.Credits,
+		ExternalIDs: externalIDs,
+	}, nil
+}
+
+// mergeBoxOffice fills in a movie from box office data; values already set
+// on the movie take precedence
+func mergeBoxOffice(movie *Movie, boxOfficeData *BoxOfficeData) {
+	if movie.Distributor == nil && boxOfficeData.Distributor != nil {
+		movie.Distributor = boxOfficeData.Distributor
+	}
+	if movie.Budget == nil && boxOfficeData.Budget != nil {
+		movie.Budget = boxOfficeData.Budget
+	}
+	if movie.MPARating == nil && boxOfficeData.MPARating != nil {
+		movie.MPARating = boxOfficeData.MPARating
+	}
+
+	// Set box office data
+	if boxOfficeData.Revenue != nil {
+		movie.BoxOffice = &BoxOffice{
+			Revenue: Revenue{
+				Worldwide:         boxOfficeData.Revenue.Worldwide,
+				OpeningWeekendUSA: boxOfficeData.Revenue.OpeningWeekendUSA,
+			},
+			Currency:    "USD", // Default currency
+			Source:      "BoxOfficeAPI",
+			LastUpdated: time.Now().UTC(),
+		}
+	}
+}
+
 // GetMovieByTitle retrieves a movie by its title
 func (uc *MovieUseCase) GetMovieByTitle(ctx context.Context, title string) (*Movie, error) {
 	movie, err := uc.repo.GetMovieByTitle(ctx, title)
@@ -100,3 +164,134 @@ func (uc *MovieUseCase) ListMovies(ctx context.Context, query *MovieListQuery) (
 	}
 	return page, nil
 }
+
+// movieForUpdate looks up the movie a write changes. A non-zero version is
+// the one the client last read: the repository then only writes while the
+// movie still has it, and fails with a *VersionConflictError otherwise.
+// Without one the write applies to whatever version is current.
+func (uc *MovieUseCase) movieForUpdate(ctx context.Context, title string, version int64) (*Movie, error) {
+	movie, err := uc.repo.GetMovieByTitle(ctx, title)
+	if err != nil {
+		return nil, movieLookupError(err)
+	}
+	movie.Version = version
+	return movie, nil
+}
+
+// SetMovieCredits replaces the cast and crew of a movie
+func (uc *MovieUseCase) SetMovieCredits(ctx context.Context, title string, credits []*Credit, version int64) (*Movie, error) {
+	movie, err := uc.movieForUpdate(ctx, title, version)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCredits(ctx, uc.personRepo, credits); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.SetCredits(ctx, movie, credits); err != nil {
+		return nil, fmt.Errorf("failed to set movie credits: %w", err)
+	}
+
+	// Reload to return the credits in display order
+	return uc.GetMovieByTitle(ctx, title)
+}
+
+// SetMovieGenres replaces the genres of a movie; the first one becomes its primary genre
+func (uc *MovieUseCase) SetMovieGenres(ctx context.Context, title string, genres []string, version int64) (*Movie, error) {
+	movie, err := uc.movieForUpdate(ctx, title, version)
+	if err != nil {
+		return nil, err
+	}
+	if len(genres) == 0 {
+		return nil, fmt.Errorf("%w: a movie needs at least one genre", ErrInvalidGenre)
+	}
+	genres, err = normalizeGenres(genres[0], genres[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.SetGenres(ctx, movie, genres); err != nil {
+		return nil, fmt.Errorf("failed to set movie genres: %w", err)
+	}
+	return uc.GetMovieByTitle(ctx, title)
+}
+
+// SetMovieTags replaces the tags of a movie
+func (uc *MovieUseCase) SetMovieTags(ctx context.Context, title string, tags []string, version int64) (*Movie, error) {
+	movie, err := uc.movieForUpdate(ctx, title, version)
+	if err != nil {
+		return nil, err
+	}
+	tags, err = normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.SetTags(ctx, movie, tags); err != nil {
+		return nil, fmt.Errorf("failed to set movie tags: %w", err)
+	}
+	return uc.GetMovieByTitle(ctx, title)
+}
+
+// AddAlternateTitle adds another title the movie is known by
+func (uc *MovieUseCase) AddAlternateTitle(ctx context.Context, title string, alt *AlternateTitle, version int64) (*Movie, error) {
+	movie, err := uc.movieForUpdate(ctx, title, version)
+	if err != nil {
+		return nil, err
+	}
+	if err := normalizeAlternateTitle(alt); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.AddAlternateTitle(ctx, movie, alt); err != nil {
+		return nil, fmt.Errorf("failed to add alternate title: %w", err)
+	}
+	return uc.GetMovieByTitle(ctx, title)
+}
+
+// RemoveAlternateTitle removes an alternate title of the movie
+func (uc *MovieUseCase) RemoveAlternateTitle(ctx context.Context, title string, id, version int64) (*Movie, error) {
+	movie, err := uc.movieForUpdate(ctx, title, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.DeleteAlternateTitle(ctx, movie, id); err != nil {
+		return nil, fmt.Errorf("failed to remove alternate title: %w", err)
+	}
+	// The removed title may have been the one the movie was looked up by
+	return uc.GetMovieByTitle(ctx, DisambiguatedTitle(movie.Title, movie.ReleaseDate.Year()))
+}
+
+// SetMovieTranslation creates or replaces the synopsis and tagline of a movie in a locale
+func (uc *MovieUseCase) SetMovieTranslation(ctx context.Context, title string, translation *MovieTranslation, version int64) (*Movie, error) {
+	movie, err := uc.movieForUpdate(ctx, title, version)
+	if err != nil {
+		return nil, err
+	}
+	if err := normalizeMovieTranslation(translation); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.SetTranslation(ctx, movie, translation); err != nil {
+		return nil, fmt.Errorf("failed to set translation: %w", err)
+	}
+	return uc.GetMovieByTitle(ctx, title)
+}
+
+// DeleteMovieTranslation removes the text of a movie in a locale
+func (uc *MovieUseCase) DeleteMovieTranslation(ctx context.Context, title, locale string, version int64) (*Movie, error) {
+	movie, err := uc.movieForUpdate(ctx, title, version)
+	if err != nil {
+		return nil, err
+	}
+	locale, err = CanonicalLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.DeleteTranslation(ctx, movie, locale); err != nil {
+		return nil, fmt.Errorf("failed to delete translation: %w", err)
+	}
+	return uc.GetMovieByTitle(ctx, title)
+}
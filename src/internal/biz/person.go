@@ -0,0 +1,136 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// People errors
+var (
+	ErrPersonNotFound = errors.New("person not found")
+	ErrInvalidCredit  = errors.New("invalid credit")
+)
+
+// PersonUseCase handles people (cast and crew)
+type PersonUseCase struct {
+	repo PersonRepo
+	log  *log.Helper
+}
+
+// NewPersonUseCase creates a new PersonUseCase instance
+func NewPersonUseCase(repo PersonRepo, logger log.Logger) *PersonUseCase {
+	return &PersonUseCase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreatePerson creates a new person
+func (uc *PersonUseCase) CreatePerson(ctx context.Context, person *Person) (*Person, error) {
+	// Generate person ID (UUID v7, like movies)
+	personID, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate person ID: %w", err)
+	}
+	person.ID = personID.String()
+
+	if err := uc.repo.CreatePerson(ctx, person); err != nil {
+		return nil, fmt.Errorf("failed to create person: %w", err)
+	}
+	return person, nil
+}
+
+// GetPerson retrieves a person by ID
+func (uc *PersonUseCase) GetPerson(ctx context.Context, id string) (*Person, error) {
+	person, err := uc.repo.GetPerson(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPersonNotFound, err)
+	}
+	return person, nil
+}
+
+// UpdatePerson changes the given fields of a person
+func (uc *PersonUseCase) UpdatePerson(ctx context.Context, id string, update *PersonUpdate) (*Person, error) {
+	if _, err := uc.GetPerson(ctx, id); err != nil {
+		return nil, err
+	}
+	person, err := uc.repo.UpdatePerson(ctx, id, update)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update person: %w", err)
+	}
+	return person, nil
+}
+
+// DeletePerson deletes a person along with their credits
+func (uc *PersonUseCase) DeletePerson(ctx context.Context, id string) error {
+	if _, err := uc.GetPerson(ctx, id); err != nil {
+		return err
+	}
+	if err := uc.repo.DeletePerson(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete person: %w", err)
+	}
+	return nil
+}
+
+// ListPeople retrieves a paginated list of people
+func (uc *PersonUseCase) ListPeople(ctx context.Context, query *PersonListQuery) (*PersonPage, error) {
+	page, err := uc.repo.ListPeople(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list people: %w", err)
+	}
+	return page, nil
+}
+
+// ListPersonMovies lists the movies a person is credited on
+func (uc *PersonUseCase) ListPersonMovies(ctx context.Context, id string) ([]*PersonCredit, error) {
+	if _, err := uc.GetPerson(ctx, id); err != nil {
+		return nil, err
+	}
+	credits, err := uc.repo.ListPersonCredits(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list person movies: %w", err)
+	}
+	return credits, nil
+}
+
+// validateCredits checks roles and that every credited person exists,
+// and fills in the person names
+func validateCredits(ctx context.Context, repo PersonRepo, credits []*Credit) error {
+	if len(credits) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(credits))
+	for _, c := range credits {
+		if !c.Role.Valid() {
+			return fmt.Errorf("%w: role must be one of director, writer, actor", ErrInvalidCredit)
+		}
+		if c.CharacterName != nil && c.Role != CreditActor {
+			return fmt.Errorf("%w: character_name is only allowed for actors", ErrInvalidCredit)
+		}
+		ids = append(ids, c.PersonID)
+	}
+
+	people, err := repo.GetPeople(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get credited people: %w", err)
+	}
+	var missing []string
+	for _, c := range credits {
+		person, ok := people[c.PersonID]
+		if !ok {
+			missing = append(missing, c.PersonID)
+			continue
+		}
+		c.PersonName = person.Name
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: unknown person %s", ErrInvalidCredit, strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// MaxRankingLimit is the most entries a leaderboard returns, the upper bound
+// of the limit rule in ranking.proto
+const MaxRankingLimit = 100
+
+// RankingUseCase handles movie leaderboards
+type RankingUseCase struct {
+	repo RankingRepo
+	log  *log.Helper
+}
+
+// NewRankingUseCase creates a new RankingUseCase instance
+func NewRankingUseCase(repo RankingRepo, logger log.Logger) *RankingUseCase {
+	return &RankingUseCase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ListTopRated lists movies with the highest average rating in the window
+func (uc *RankingUseCase) ListTopRated(ctx context.Context, query *RankingQuery) ([]*RankedMovie, error) {
+	movies, err := uc.repo.ListTopRated(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list top-rated movies: %w", err)
+	}
+	return movies, nil
+}
+
+// ListPopular lists movies with the most ratings in the window
+func (uc *RankingUseCase) ListPopular(ctx context.Context, query *RankingQuery) ([]*RankedMovie, error) {
+	movies, err := uc.repo.ListPopular(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list popular movies: %w", err)
+	}
+	return movies, nil
+}
+
+// ListTrending lists movies with the most recent rating activity
+func (uc *RankingUseCase) ListTrending(ctx context.Context, query *TrendingQuery) ([]*RankedMovie, error) {
+	movies, err := uc.repo.ListTrending(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trending movies: %w", err)
+	}
+	return movies, nil
+}
+
+// RenormalizeTrending rebases trending scores so they stay in float range
+func (uc *RankingUseCase) RenormalizeTrending(ctx context.Context) error {
+	if err := uc.repo.RenormalizeTrending(ctx); err != nil {
+		return fmt.Errorf("failed to renormalize trending scores: %w", err)
+	}
+	return nil
+}
+
+// RebuildRankings regenerates all leaderboards, including segments, from the database
+func (uc *RankingUseCase) RebuildRankings(ctx context.Context) (int, error) {
+	count, err := uc.repo.RebuildRankings(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to rebuild rankings: %w", err)
+	}
+	uc.log.Infof("rebuilt rankings for %d movies", count)
+	return count, nil
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
+
+	"src/internal/conf"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -13,35 +16,71 @@ var (
 	ErrMovieNotFound = errors.New("movie not found")
 )
 
+// defaultReviewDuration is used when no review duration is configured
+const defaultReviewDuration = 24 * time.Hour
+
 // RatingUseCase handles rating-related business logic
 type RatingUseCase struct {
-	movieRepo  MovieRepo
-	ratingRepo RatingRepo
-	log        *log.Helper
+	movieRepo      MovieRepo
+	ratingRepo     RatingRepo
+	screener       ContentScreener
+	detector       RatingAnomalyDetector
+	alerts         AlertPublisher
+	metrics        Metrics
+	anomalyEnabled bool
+	freezeAverage  bool
+	reviewDuration time.Duration
+	log            *log.Helper
 }
 
 // NewRatingUseCase creates a new RatingUseCase instance
-func NewRatingUseCase(movieRepo MovieRepo, ratingRepo RatingRepo, logger log.Logger) *RatingUseCase {
+func NewRatingUseCase(movieRepo MovieRepo, ratingRepo RatingRepo, screener ContentScreener, detector RatingAnomalyDetector, alerts AlertPublisher, metrics Metrics, c *conf.AnomalyDetection, logger log.Logger) *RatingUseCase {
+	reviewDuration := defaultReviewDuration
+	if c.GetReviewDuration() != nil && c.GetReviewDuration().AsDuration() > 0 {
+		reviewDuration = c.GetReviewDuration().AsDuration()
+	}
 	return &RatingUseCase{
-		movieRepo:  movieRepo,
-		ratingRepo: ratingRepo,
-		log:        log.NewHelper(logger),
+		movieRepo:      movieRepo,
+		ratingRepo:     ratingRepo,
+		screener:       screener,
+		detector:       detector,
+		alerts:         alerts,
+		metrics:        metrics,
+		anomalyEnabled: c.GetEnabled(),
+		freezeAverage:  c.GetFreezeAverage(),
+		reviewDuration: reviewDuration,
+		log:            log.NewHelper(logger),
 	}
 }
 
-// SubmitRating submits or updates a rating for a movie (Upsert)
-func (uc *RatingUseCase) SubmitRating(ctx context.Context, movieTitle, raterID string, ratingValue float64) (*Rating, error) {
-	// Check if movie exists
-	_, err := uc.movieRepo.GetMovieByTitle(ctx, movieTitle)
+// SubmitRating submits or updates a rating for a movie (Upsert). A non-zero
+// version is the one the rater last read, and the update fails with a
+// *VersionConflictError if the rating has changed since.
+func (uc *RatingUseCase) SubmitRating(ctx context.Context, movieTitle, raterID string, ratingValue float64, review *string, version int64) (*Rating, error) {
+	// Check if movie exists; ratings refer to it by key
+	movie, err := uc.movieRepo.GetMovieByTitle(ctx, movieTitle)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrMovieNotFound, err)
+		return nil, movieLookupError(err)
 	}
 
 	// Create rating object
 	rating := &Rating{
-		MovieTitle: movieTitle,
-		RaterID:    raterID,
-		Rating:     ratingValue,
+		MovieTitle:       movie.Key,
+		RaterID:          raterID,
+		Rating:           ratingValue,
+		Review:           review,
+		ModerationStatus: ModerationNone,
+		Version:          version,
+	}
+
+	// Screen content before storing (non-blocking on failure)
+	verdict, err := uc.screener.Screen(ctx, rating)
+	if err != nil {
+		uc.log.Warnf("failed to screen rating for movie '%s': %v", movieTitle, err)
+	} else if verdict.Flagged {
+		// Flagged content is stored but queued for moderation
+		rating.ModerationStatus = ModerationPending
+		rating.ModerationReason = &verdict.Reason
 	}
 
 	// Upsert rating
@@ -49,23 +88,98 @@ func (uc *RatingUseCase) SubmitRating(ctx context.Context, movieTitle, raterID s
 	if err != nil {
 		return nil, fmt.Errorf("failed to upsert rating: %w", err)
 	}
+	uc.metrics.RatingSubmitted(rating.ModerationStatus)
+
+	// Watch for review-bombing (non-blocking on failure)
+	if uc.anomalyEnabled {
+		uc.detectAnomaly(ctx, rating)
+	}
 
 	return rating, nil
 }
 
-// GetRatingAggregate retrieves aggregated rating for a movie
-func (uc *RatingUseCase) GetRatingAggregate(ctx context.Context, movieTitle string) (*RatingAggregate, error) {
+// GetRatingAggregate retrieves aggregated rating for a movie within a window
+func (uc *RatingUseCase) GetRatingAggregate(ctx context.Context, movieTitle string, window RatingWindow) (*RatingAggregate, error) {
 	// Check if movie exists
-	_, err := uc.movieRepo.GetMovieByTitle(ctx, movieTitle)
+	movie, err := uc.movieRepo.GetMovieByTitle(ctx, movieTitle)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrMovieNotFound, err)
+		return nil, movieLookupError(err)
 	}
 
 	// Get aggregated rating
-	aggregate, err := uc.ratingRepo.GetRatingAggregate(ctx, movieTitle)
+	aggregate, err := uc.ratingRepo.GetRatingAggregate(ctx, movie.Key, window)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get rating aggregate: %w", err)
 	}
 
 	return aggregate, nil
 }
+
+// GetRatingHistory retrieves the rating change history for a movie
+func (uc *RatingUseCase) GetRatingHistory(ctx context.Context, query *RatingHistoryQuery) (*RatingHistoryPage, error) {
+	// Check if movie exists
+	movie, err := uc.movieRepo.GetMovieByTitle(ctx, query.MovieTitle)
+	if err != nil {
+		return nil, movieLookupError(err)
+	}
+	query.MovieTitle = movie.Key
+
+	page, err := uc.ratingRepo.ListRatingEvents(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rating events: %w", err)
+	}
+
+	return page, nil
+}
+
+// detectAnomaly feeds the detector and puts the aggregate under review on a burst
+func (uc *RatingUseCase) detectAnomaly(ctx context.Context, rating *Rating) {
+	anomaly, err := uc.detector.Observe(ctx, rating)
+	if err != nil {
+		uc.log.Warnf("failed to check rating anomaly for movie '%s': %v", rating.MovieTitle, err)
+		return
+	}
+	if anomaly == nil {
+		return
+	}
+
+	review := &AggregateReview{
+		MovieTitle: anomaly.MovieTitle,
+		Reason:     anomaly.Reason,
+		StartedAt:  anomaly.DetectedAt,
+		ExpiresAt:  anomaly.DetectedAt.Add(uc.reviewDuration),
+	}
+	// Only freeze when there is a pre-burst value to freeze at
+	if uc.freezeAverage && anomaly.BaselineCount > 0 {
+		frozen := anomaly.BaselineAverage
+		review.FrozenAverage = &frozen
+	}
+
+	started, err := uc.ratingRepo.MarkUnderReview(ctx, review)
+	if err != nil {
+		uc.log.Warnf("failed to mark movie '%s' under review: %v", rating.MovieTitle, err)
+		return
+	}
+	if !started {
+		// Already under review, the alert was emitted when it started
+		return
+	}
+
+	alert := &AdminAlert{
+		Kind:       "rating_anomaly",
+		MovieTitle: anomaly.MovieTitle,
+		Message:    anomaly.Reason,
+		Fields: map[string]interface{}{
+			"window_count":     anomaly.WindowCount,
+			"window_average":   anomaly.WindowAverage,
+			"baseline_count":   anomaly.BaselineCount,
+			"baseline_average": anomaly.BaselineAverage,
+			"frozen":           review.FrozenAverage != nil,
+			"expires_at":       review.ExpiresAt,
+		},
+		CreatedAt: anomaly.DetectedAt,
+	}
+	if err := uc.alerts.Publish(ctx, alert); err != nil {
+		uc.log.Warnf("failed to publish rating anomaly alert for movie '%s': %v", rating.MovieTitle, err)
+	}
+}
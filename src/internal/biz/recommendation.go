@@ -0,0 +1,166 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Recommendation errors
+var (
+	ErrModelNotFound  = errors.New("recommendation model not found")
+	ErrModelNotLoaded = errors.New("recommendation model not loaded")
+)
+
+// Defaults used when the recommender config leaves a value unset
+const (
+	defaultRecommenderFactors        = 32
+	defaultRecommenderEpochs         = 30
+	defaultRecommenderLearningRate   = 0.01
+	defaultRecommenderRegularization = 0.05
+	defaultRecommenderSeed           = 1
+	// DefaultModelReloadInterval is how often the model file is checked by default
+	DefaultModelReloadInterval = time.Minute
+)
+
+// relevantRating is the held-out rating at which a movie counts as a hit for precision@K
+const relevantRating = 4.0
+
+// RecommendationUseCase serves personalized recommendations from an offline-trained
+// model. The model is swapped atomically when a newer version is stored.
+type RecommendationUseCase struct {
+	repo   RecommendationRepo
+	store  RecommenderModelStore
+	params RecommenderParams
+	log    *log.Helper
+
+	model   atomic.Pointer[RecommenderModel]
+	mu      sync.Mutex // serializes reloads
+	version time.Time
+}
+
+// NewRecommendationUseCase creates a new RecommendationUseCase instance
+func NewRecommendationUseCase(repo RecommendationRepo, store RecommenderModelStore, c *conf.Recommender, logger log.Logger) *RecommendationUseCase {
+	params := RecommenderParams{
+		Factors:        defaultRecommenderFactors,
+		Epochs:         defaultRecommenderEpochs,
+		LearningRate:   defaultRecommenderLearningRate,
+		Regularization: defaultRecommenderRegularization,
+		Seed:           defaultRecommenderSeed,
+	}
+	if c.GetFactors() > 0 {
+		params.Factors = int(c.GetFactors())
+	}
+	if c.GetEpochs() > 0 {
+		params.Epochs = int(c.GetEpochs())
+	}
+	if c.GetLearningRate() > 0 {
+		params.LearningRate = c.GetLearningRate()
+	}
+	if c.GetRegularization() > 0 {
+		params.Regularization = c.GetRegularization()
+	}
+	return &RecommendationUseCase{
+		repo:   repo,
+		store:  store,
+		params: params,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// Recommend returns unseen movies ranked by predicted rating for a rater
+func (uc *RecommendationUseCase) Recommend(ctx context.Context, raterID string, limit int32) ([]*ScoredMovie, error) {
+	model := uc.model.Load()
+	if model == nil {
+		// The first request after startup may arrive before the reload job ran
+		if err := uc.ReloadModel(ctx); err != nil {
+			return nil, err
+		}
+		if model = uc.model.Load(); model == nil {
+			return nil, ErrModelNotLoaded
+		}
+	}
+
+	// Use live ratings so movies rated since training are not recommended
+	titles, err := uc.repo.ListRatedTitles(ctx, raterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rated movies: %w", err)
+	}
+	seen := make(map[string]bool, len(titles))
+	for _, title := range titles {
+		seen[title] = true
+	}
+
+	return model.Recommend(raterID, seen, int(limit)), nil
+}
+
+// ReloadModel loads the stored model if it is newer than the one in memory
+func (uc *RecommendationUseCase) ReloadModel(ctx context.Context) error {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	version, err := uc.store.Version(ctx)
+	if errors.Is(err, ErrModelNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check recommendation model: %w", err)
+	}
+	if !version.After(uc.version) {
+		return nil
+	}
+
+	model, err := uc.store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load recommendation model: %w", err)
+	}
+	uc.model.Store(model)
+	uc.version = version
+	uc.log.Infof("loaded recommendation model trained at %s (%d raters, %d movies)",
+		model.TrainedAt.Format(time.RFC3339), len(model.Raters), len(model.Movies))
+	return nil
+}
+
+// TrainModel trains a model on all visible ratings and stores it
+func (uc *RecommendationUseCase) TrainModel(ctx context.Context) error {
+	ratings, err := uc.repo.ListTrainingRatings(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list training ratings: %w", err)
+	}
+
+	start := time.Now()
+	model := TrainRecommender(ratings, uc.params)
+	uc.log.Infof("trained recommendation model on %d ratings in %s", len(ratings), time.Since(start))
+
+	if err := uc.store.Save(ctx, model); err != nil {
+		return fmt.Errorf("failed to store recommendation model: %w", err)
+	}
+	return nil
+}
+
+// Evaluate trains on all but a held-out fraction of the ratings and reports
+// RMSE and precision@K on the rest
+func (uc *RecommendationUseCase) Evaluate(ctx context.Context, holdout float64, k int, seed uint64) (*RecommenderEvaluation, error) {
+	if holdout <= 0 || holdout >= 1 {
+		return nil, fmt.Errorf("holdout must be between 0 and 1, got %v", holdout)
+	}
+	if k <= 0 {
+		return nil, fmt.Errorf("k must be positive, got %d", k)
+	}
+
+	ratings, err := uc.repo.ListTrainingRatings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list training ratings: %w", err)
+	}
+
+	params := uc.params
+	params.Seed = seed
+	return EvaluateRecommender(ratings, params, holdout, k, relevantRating), nil
+}
@@ -0,0 +1,247 @@
+package biz
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"time"
+)
+
+// Rating bounds used to clamp predictions
+const (
+	minRatingValue = 0.5
+	maxRatingValue = 5.0
+)
+
+// RecommenderParams configures matrix-factorization training
+type RecommenderParams struct {
+	Factors        int
+	Epochs         int
+	LearningRate   float64
+	Regularization float64
+	Seed           uint64
+}
+
+// RecommenderModel is a biased matrix-factorization model:
+// predicted rating = global mean + rater bias + movie bias + rater factors · movie factors
+type RecommenderModel struct {
+	TrainedAt    time.Time
+	GlobalMean   float64
+	Raters       map[string]int // rater ID -> row in RaterBias/RaterFactors
+	Movies       []string       // movie titles, indexed like MovieBias/MovieFactors
+	RaterBias    []float64
+	MovieBias    []float64
+	RaterFactors [][]float64
+	MovieFactors [][]float64
+}
+
+// ScoredMovie is a movie with a predicted rating
+type ScoredMovie struct {
+	Title           string
+	PredictedRating float64
+}
+
+// RecommenderEvaluation reports model quality on a held-out split
+type RecommenderEvaluation struct {
+	TrainRatings int
+	TestRatings  int
+	RMSE         float64
+	K            int
+	PrecisionAtK float64
+	// Raters with at least one relevant held-out movie, over which precision is averaged
+	EvaluatedRaters int
+}
+
+// TrainRecommender fits a model to the ratings with stochastic gradient descent
+func TrainRecommender(ratings []*Rating, p RecommenderParams) *RecommenderModel {
+	rng := rand.New(rand.NewPCG(p.Seed, p.Seed))
+	model := &RecommenderModel{
+		TrainedAt: time.Now().UTC(),
+		Raters:    make(map[string]int),
+	}
+
+	// Index raters and movies and compute the global mean
+	movies := make(map[string]int)
+	type sample struct {
+		rater, movie int
+		rating       float64
+	}
+	samples := make([]sample, 0, len(ratings))
+	var sum float64
+	for _, r := range ratings {
+		u, ok := model.Raters[r.RaterID]
+		if !ok {
+			u = len(model.Raters)
+			model.Raters[r.RaterID] = u
+		}
+		i, ok := movies[r.MovieTitle]
+		if !ok {
+			i = len(model.Movies)
+			movies[r.MovieTitle] = i
+			model.Movies = append(model.Movies, r.MovieTitle)
+		}
+		samples = append(samples, sample{rater: u, movie: i, rating: r.Rating})
+		sum += r.Rating
+	}
+	if len(samples) > 0 {
+		model.GlobalMean = sum / float64(len(samples))
+	}
+
+	model.RaterBias = make([]float64, len(model.Raters))
+	model.MovieBias = make([]float64, len(model.Movies))
+	model.RaterFactors = randomFactors(rng, len(model.Raters), p.Factors)
+	model.MovieFactors = randomFactors(rng, len(model.Movies), p.Factors)
+
+	lr, reg := p.LearningRate, p.Regularization
+	for epoch := 0; epoch < p.Epochs; epoch++ {
+		rng.Shuffle(len(samples), func(a, b int) { samples[a], samples[b] = samples[b], samples[a] })
+		for _, s := range samples {
+			pu, qi := model.RaterFactors[s.rater], model.MovieFactors[s.movie]
+			err := s.rating - model.rawPrediction(s.rater, s.movie)
+
+			model.RaterBias[s.rater] += lr * (err - reg*model.RaterBias[s.rater])
+			model.MovieBias[s.movie] += lr * (err - reg*model.MovieBias[s.movie])
+			for f := range pu {
+				puf, qif := pu[f], qi[f]
+				pu[f] += lr * (err*qif - reg*puf)
+				qi[f] += lr * (err*puf - reg*qif)
+			}
+		}
+	}
+
+	return model
+}
+
+// randomFactors returns n small random factor vectors
+func randomFactors(rng *rand.Rand, n, factors int) [][]float64 {
+	vectors := make([][]float64, n)
+	for i := range vectors {
+		vectors[i] = make([]float64, factors)
+		for f := range vectors[i] {
+			vectors[i][f] = rng.NormFloat64() * 0.1
+		}
+	}
+	return vectors
+}
+
+// rawPrediction predicts without clamping, as used during training
+func (m *RecommenderModel) rawPrediction(rater, movie int) float64 {
+	pred := m.GlobalMean + m.RaterBias[rater] + m.MovieBias[movie]
+	for f, v := range m.RaterFactors[rater] {
+		pred += v * m.MovieFactors[movie][f]
+	}
+	return pred
+}
+
+// predict returns the clamped predicted rating. Unknown raters (rater < 0) get
+// the movie's baseline, so new raters still see well-liked movies first.
+func (m *RecommenderModel) predict(rater, movie int) float64 {
+	var pred float64
+	if rater < 0 {
+		pred = m.GlobalMean + m.MovieBias[movie]
+	} else {
+		pred = m.rawPrediction(rater, movie)
+	}
+	return math.Max(minRatingValue, math.Min(maxRatingValue, pred))
+}
+
+// raterIndex returns the model row of a rater, or -1 if the rater is unknown
+func (m *RecommenderModel) raterIndex(raterID string) int {
+	if u, ok := m.Raters[raterID]; ok {
+		return u
+	}
+	return -1
+}
+
+// Recommend ranks the movies the rater has not seen by predicted rating
+func (m *RecommenderModel) Recommend(raterID string, seen map[string]bool, limit int) []*ScoredMovie {
+	u := m.raterIndex(raterID)
+	scored := make([]*ScoredMovie, 0, len(m.Movies))
+	for i, title := range m.Movies {
+		if seen[title] {
+			continue
+		}
+		scored = append(scored, &ScoredMovie{Title: title, PredictedRating: m.predict(u, i)})
+	}
+
+	sort.SliceStable(scored, func(a, b int) bool {
+		return scored[a].PredictedRating > scored[b].PredictedRating
+	})
+	if limit < len(scored) {
+		scored = scored[:limit]
+	}
+	return scored
+}
+
+// EvaluateRecommender trains on a random split of the ratings and reports RMSE
+// and precision@K on the held-out part. A held-out movie is relevant when the
+// rater gave it at least relevantRating.
+func EvaluateRecommender(ratings []*Rating, p RecommenderParams, holdout float64, k int, relevantRating float64) *RecommenderEvaluation {
+	rng := rand.New(rand.NewPCG(p.Seed, p.Seed^0x9e3779b97f4a7c15))
+	var train, test []*Rating
+	for _, r := range ratings {
+		if rng.Float64() < holdout {
+			test = append(test, r)
+		} else {
+			train = append(train, r)
+		}
+	}
+
+	model := TrainRecommender(train, p)
+	movies := make(map[string]int, len(model.Movies))
+	for i, title := range model.Movies {
+		movies[title] = i
+	}
+
+	result := &RecommenderEvaluation{
+		TrainRatings: len(train),
+		TestRatings:  len(test),
+		K:            k,
+	}
+
+	// RMSE over held-out ratings of movies the model knows
+	var sqErr float64
+	var n int
+	relevant := make(map[string]map[string]bool)
+	for _, r := range test {
+		if i, ok := movies[r.MovieTitle]; ok {
+			diff := model.predict(model.raterIndex(r.RaterID), i) - r.Rating
+			sqErr += diff * diff
+			n++
+		}
+		if r.Rating >= relevantRating {
+			if relevant[r.RaterID] == nil {
+				relevant[r.RaterID] = make(map[string]bool)
+			}
+			relevant[r.RaterID][r.MovieTitle] = true
+		}
+	}
+	if n > 0 {
+		result.RMSE = math.Sqrt(sqErr / float64(n))
+	}
+
+	// Precision@K: share of each rater's top K (excluding training movies) that are relevant
+	seen := make(map[string]map[string]bool)
+	for _, r := range train {
+		if seen[r.RaterID] == nil {
+			seen[r.RaterID] = make(map[string]bool)
+		}
+		seen[r.RaterID][r.MovieTitle] = true
+	}
+	var precision float64
+	for raterID, movies := range relevant {
+		var hits int
+		for _, rec := range model.Recommend(raterID, seen[raterID], k) {
+			if movies[rec.Title] {
+				hits++
+			}
+		}
+		precision += float64(hits) / float64(k)
+		result.EvaluatedRaters++
+	}
+	if result.EvaluatedRaters > 0 {
+		result.PrecisionAtK = precision / float64(result.EvaluatedRaters)
+	}
+
+	return result
+}
@@ -0,0 +1,109 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Defaults used when the similarity config leaves a value unset
+const (
+	defaultSimilarityTopK        = 20
+	defaultSimilarityMinCoRaters = 3
+	// DefaultSimilarityInterval is how often similarities are recomputed by default
+	DefaultSimilarityInterval = 6 * time.Hour
+)
+
+// ErrSimilaritiesSkipped is returned when similarities are not computed
+// because another instance is computing them or just did
+var ErrSimilaritiesSkipped = errors.New("similarities are computed by another instance")
+
+// SimilarityUseCase handles similar-movie recommendations
+type SimilarityUseCase struct {
+	movieRepo   MovieRepo
+	repo        SimilarityRepo
+	topK        int32
+	minCoRaters int32
+	interval    time.Duration
+	log         *log.Helper
+}
+
+// NewSimilarityUseCase creates a new SimilarityUseCase instance
+func NewSimilarityUseCase(movieRepo MovieRepo, repo SimilarityRepo, c *conf.Similarity, logger log.Logger) *SimilarityUseCase {
+	topK := int32(defaultSimilarityTopK)
+	if c.GetTopK() > 0 {
+		topK = c.GetTopK()
+	}
+	minCoRaters := int32(defaultSimilarityMinCoRaters)
+	if c.GetMinCoRaters() > 0 {
+		minCoRaters = c.GetMinCoRaters()
+	}
+	interval := DefaultSimilarityInterval
+	if c.GetInterval().AsDuration() > 0 {
+		interval = c.GetInterval().AsDuration()
+	}
+	return &SimilarityUseCase{
+		movieRepo:   movieRepo,
+		repo:        repo,
+		topK:        topK,
+		minCoRaters: minCoRaters,
+		interval:    interval,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// GetSimilarMovies returns up to limit movies similar to the given one.
+// Collaborative results come first; content-based matches fill the rest,
+// so cold-start movies without co-ratings still get recommendations.
+func (uc *SimilarityUseCase) GetSimilarMovies(ctx context.Context, movieTitle string, limit int32) ([]*SimilarMovie, error) {
+	movie, err := uc.movieRepo.GetMovieByTitle(ctx, movieTitle)
+	if err != nil {
+		return nil, movieLookupError(err)
+	}
+
+	if limit > uc.topK {
+		limit = uc.topK
+	}
+
+	similar, err := uc.repo.ListSimilar(ctx, movie.Key, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list similar movies: %w", err)
+	}
+	if int32(len(similar)) >= limit {
+		return similar, nil
+	}
+
+	exclude := make([]string, 0, len(similar)+1)
+	exclude = append(exclude, movie.Key)
+	for _, s := range similar {
+		exclude = append(exclude, s.Title)
+	}
+	content, err := uc.repo.ListContentSimilar(ctx, movie, exclude, limit-int32(len(similar)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list content-similar movies: %w", err)
+	}
+
+	return append(similar, content...), nil
+}
+
+// ComputeSimilarities recomputes the top-K similar movies for every movie.
+// Each replica runs it on the same interval; once one has computed them the
+// others skip until half an interval has passed.
+func (uc *SimilarityUseCase) ComputeSimilarities(ctx context.Context) error {
+	start := time.Now()
+	pairs, err := uc.repo.ComputeSimilarities(ctx, uc.topK, uc.minCoRaters, uc.interval/2)
+	if errors.Is(err, ErrSimilaritiesSkipped) {
+		uc.log.Infof("skipped computing movie similarities: %v", err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to compute movie similarities: %w", err)
+	}
+	uc.log.Infof("computed %d movie similarities in %s", pairs, time.Since(start))
+	return nil
+}
@@ -0,0 +1,103 @@
+package biz
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Title errors
+var (
+	ErrAmbiguousTitle         = errors.New("ambiguous movie title")
+	ErrDuplicateTitle         = errors.New("a movie with this title and release year already exists")
+	ErrInvalidAlternateTitle  = errors.New("invalid alternate title")
+	ErrAlternateTitleNotFound = errors.New("alternate title not found")
+)
+
+// AmbiguousTitleError is returned when a title resolves to several movies
+type AmbiguousTitleError struct {
+	Title      string
+	Candidates []*Movie
+}
+
+func (e *AmbiguousTitleError) Error() string {
+	return fmt.Sprintf("title %q matches %d movies", e.Title, len(e.Candidates))
+}
+
+// Is makes errors.Is(err, ErrAmbiguousTitle) match
+func (e *AmbiguousTitleError) Is(target error) bool {
+	return target == ErrAmbiguousTitle
+}
+
+// movieLookupError keeps ambiguity errors from GetMovieByTitle and reports
+// any other failure as ErrMovieNotFound
+func movieLookupError(err error) error {
+	if errors.Is(err, ErrAmbiguousTitle) {
+		return err
+	}
+	return fmt.Errorf("%w: %v", ErrMovieNotFound, err)
+}
+
+// disambiguatedTitlePattern matches "Title (YYYY)"
+var disambiguatedTitlePattern = regexp.MustCompile(`^(.+) \((\d{4})\)$`)
+
+// DisambiguatedTitle returns "Title (YYYY)", which resolves to a single movie
+func DisambiguatedTitle(title string, year int) string {
+	return fmt.Sprintf("%s (%d)", title, year)
+}
+
+// ParseDisambiguatedTitle splits "Title (YYYY)" into its title and year
+func ParseDisambiguatedTitle(s string) (string, int, bool) {
+	m := disambiguatedTitlePattern.FindStringSubmatch(s)
+	if m == nil {
+		return "", 0, false
+	}
+	year, err := strconv.Atoi(m[2])
+	if err != nil {
+		return "", 0, false
+	}
+	return m[1], year, true
+}
+
+// Language tags and region codes accepted on alternate titles
+var (
+	languageTagPattern = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+	regionCodePattern  = regexp.MustCompile(`^([A-Za-z]{2}|[0-9]{3})$`)
+)
+
+// normalizeAlternateTitle validates an alternate title and normalizes the
+// language to lowercase primary subtag and the region to uppercase
+func normalizeAlternateTitle(t *AlternateTitle) error {
+	t.Title = strings.TrimSpace(t.Title)
+	if t.Title == "" {
+		return fmt.Errorf("%w: title is required", ErrInvalidAlternateTitle)
+	}
+	if len(t.Title) > 255 {
+		return fmt.Errorf("%w: title is longer than 255 characters", ErrInvalidAlternateTitle)
+	}
+	if t.Kind == "" {
+		t.Kind = TitleAlternative
+	}
+	if !t.Kind.Valid() {
+		return fmt.Errorf("%w: kind must be one of original, localized, working, alternative", ErrInvalidAlternateTitle)
+	}
+	if t.Language != nil {
+		if !languageTagPattern.MatchString(*t.Language) || len(*t.Language) > 35 {
+			return fmt.Errorf("%w: language must be a BCP 47 tag such as zh-Hans", ErrInvalidAlternateTitle)
+		}
+		parts := strings.Split(*t.Language, "-")
+		parts[0] = strings.ToLower(parts[0])
+		language := strings.Join(parts, "-")
+		t.Language = &language
+	}
+	if t.Region != nil {
+		if !regionCodePattern.MatchString(*t.Region) {
+			return fmt.Errorf("%w: region must be an ISO 3166-1 alpha-2 or UN M49 code", ErrInvalidAlternateTitle)
+		}
+		region := strings.ToUpper(*t.Region)
+		t.Region = &region
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package biz
 
 import (
 	"context"
+	"net/http"
 	"time"
 )
 
@@ -10,11 +11,194 @@ type Movie struct {
 	ID          string
 	Title       string
 	ReleaseDate time.Time
-	Genre       string
+	Genre       string   // Canonical name of the primary genre
+	Genres      []string // Canonical names of all assigned genres, primary first
+	Tags        []string
 	Distributor *string
 	Budget      *int64
 	MPARating   *string
 	BoxOffice   *BoxOffice
+	Credits     []*Credit
+
+	// Key identifies the movie wherever it is referred to by title (ratings,
+	// leaderboards, similarities): the title, or "Title (YYYY)" when another
+	// movie already held the plain title
+	Key             string
+	AlternateTitles []*AlternateTitle
+
+	// Text per locale, and localized genre names by canonical name and locale;
+	// see Localize
+	Translations      []*MovieTranslation
+	GenreTranslations map[string]map[string]string
+
+	Images []*MovieImage // Posters first, then stills, oldest first
+
+	ExternalIDs map[string]string // ID by scheme (SchemeIMDb...), in canonical form
+
+	// Version counts the writes to the movie, starting at 1. Passed to a write,
+	// a non-zero Version is the one the write expects to replace.
+	Version   int64
+	UpdatedAt time.Time // Time of the write that set Version
+}
+
+// AlternateTitleKind tells what an alternate title is used for
+type AlternateTitleKind string
+
+// Alternate title kinds
+const (
+	TitleOriginal    AlternateTitleKind = "original"    // Title in the original language
+	TitleLocalized   AlternateTitleKind = "localized"   // Release title in another language or region
+	TitleWorking     AlternateTitleKind = "working"     // Title used during production
+	TitleAlternative AlternateTitleKind = "alternative" // Any other known title
+)
+
+// Valid reports whether the kind is one of the known alternate title kinds
+func (k AlternateTitleKind) Valid() bool {
+	return k == TitleOriginal || k == TitleLocalized || k == TitleWorking || k == TitleAlternative
+}
+
+// AlternateTitle is another title a movie is known by
+type AlternateTitle struct {
+	ID       int64
+	Title    string
+	Language *string // BCP 47 tag, e.g. zh-Hans
+	Region   *string // ISO 3166-1 alpha-2 or UN M49 code, e.g. CN
+	Kind     AlternateTitleKind
+}
+
+// Genre is an entry of the managed genre taxonomy
+type Genre struct {
+	ID      string // Slug of the name, e.g. science-fiction
+	Name    string
+	Aliases []string // Genre keys that resolve to this genre, including its own
+
+	Translations map[string]string // Localized names by locale
+}
+
+// CreditRole is what a person did on a movie
+type CreditRole string
+
+// Credit roles
+const (
+	CreditDirector CreditRole = "director"
+	CreditWriter   CreditRole = "writer"
+	CreditActor    CreditRole = "actor"
+)
+
+// Valid reports whether the role is one of the known credit roles
+func (r CreditRole) Valid() bool {
+	return r == CreditDirector || r == CreditWriter || r == CreditActor
+}
+
+// Credit links a person to a movie in a role
+type Credit struct {
+	PersonID      string
+	PersonName    string
+	Role          CreditRole
+	CharacterName *string // Actors only
+	BillingOrder  *int32
+}
+
+// Person domain model
+type Person struct {
+	ID        string
+	Name      string
+	BirthDate *time.Time
+	Biography *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// PersonUpdate holds the fields to change on a person; nil fields are kept
+type PersonUpdate struct {
+	Name      *string
+	BirthDate *time.Time
+	Biography *string
+}
+
+// PersonListQuery domain model
+type PersonListQuery struct {
+	Q      *string
+	Limit  int32
+	Cursor *string
+}
+
+// PersonPage domain model
+type PersonPage struct {
+	Items      []*Person
+	NextCursor *string
+}
+
+// PersonCredit is a movie a person worked on, in one role
+type PersonCredit struct {
+	Movie         *Movie
+	Role          CreditRole
+	CharacterName *string
+	BillingOrder  *int32
+}
+
+// CollectionVisibility controls who can see a collection
+type CollectionVisibility string
+
+// Collection visibilities. Private collections are only visible to their owner.
+const (
+	VisibilityPublic  CollectionVisibility = "public"
+	VisibilityPrivate CollectionVisibility = "private"
+)
+
+// Valid reports whether the visibility is one of the known values
+func (v CollectionVisibility) Valid() bool {
+	return v == VisibilityPublic || v == VisibilityPrivate
+}
+
+// Collection is an ordered list of movies, such as a franchise or a curated list
+type Collection struct {
+	ID          string
+	Name        string
+	Description *string
+	OwnerID     string
+	Visibility  CollectionVisibility
+	ItemCount   int32
+	Items       []*CollectionItem    // Only filled in when reading a single collection
+	Rating      *CollectionAggregate // Only filled in when reading a single collection
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// CollectionItem is a movie at a position in a collection
+type CollectionItem struct {
+	Movie    *Movie
+	Position int32
+	AddedAt  time.Time
+}
+
+// CollectionAggregate combines the rating aggregates of a collection's movies
+type CollectionAggregate struct {
+	Average     float64 // Weighted by each movie's rating count
+	Count       int32   // Total ratings across movies
+	RatedMovies int32
+}
+
+// CollectionUpdate holds the fields to change on a collection; nil fields are kept
+type CollectionUpdate struct {
+	Name        *string
+	Description *string
+	Visibility  *CollectionVisibility
+}
+
+// CollectionListQuery domain model
+type CollectionListQuery struct {
+	Q        *string
+	OwnerID  *string
+	ViewerID string // Private collections of the viewer are included
+	Limit    int32
+	Cursor   *string
+}
+
+// CollectionPage domain model
+type CollectionPage struct {
+	Items      []*Collection
+	NextCursor *string
 }
 
 // BoxOffice domain model
@@ -39,19 +223,170 @@ type CreateMovieRequest struct {
 	Distributor *string
 	Budget      *int64
 	MPARating   *string
+	Genres      []string // Additional genres besides Genre
+	Tags        []string
+	Credits     []*Credit
+	ExternalIDs map[string]string // ID by scheme
 }
 
 // Rating domain model
 type Rating struct {
-	MovieTitle string
-	RaterID    string
-	Rating     float64
+	ID               int64
+	MovieTitle       string // Key of the movie
+	RaterID          string
+	Rating           float64
+	Review           *string
+	ModerationStatus ModerationStatus
+	ModerationReason *string
+	ReportCount      int32
+	UpdatedAt        time.Time
+	Version          int64 // Counts the writes to the rating, as Movie.Version does
+}
+
+// ModerationStatus is the moderation state of a rating or review
+type ModerationStatus string
+
+// Moderation states. Ratings start as none; flagged content becomes pending
+// until a moderator approves or hides it.
+const (
+	ModerationNone     ModerationStatus = "none"
+	ModerationPending  ModerationStatus = "pending"
+	ModerationApproved ModerationStatus = "approved"
+	ModerationHidden   ModerationStatus = "hidden"
+)
+
+// ModerationQueueQuery domain model
+type ModerationQueueQuery struct {
+	Status ModerationStatus
+	Limit  int32
+	Cursor *string
+}
+
+// ModerationQueuePage domain model
+type ModerationQueuePage struct {
+	Items      []*Rating
+	NextCursor *string
+}
+
+// ScreenVerdict is the result of automatic content screening
+type ScreenVerdict struct {
+	Flagged bool
+	Reason  string
 }
 
 // RatingAggregate domain model
 type RatingAggregate struct {
+	Average     float64
+	Count       int32
+	UnderReview bool
+	// Latest change to the all-time aggregate: a rating written or moderated,
+	// or a review started or ended. Zero for windowed aggregates, which also
+	// change as ratings leave the window.
+	LastModified time.Time
+}
+
+// RatingEvent is an entry in the append-only rating history
+type RatingEvent struct {
+	ID         int64
+	RatingID   int64
+	MovieTitle string
+	RaterID    string
+	EventType  string // created or updated
+	OldRating  *float64
+	NewRating  float64
+	ClientIP   *string
+	UserAgent  *string
+	CreatedAt  time.Time
+}
+
+// RatingHistoryQuery domain model
+type RatingHistoryQuery struct {
+	MovieTitle string
+	RaterID    *string
+	Since      *time.Time
+	Until      *time.Time
+	Limit      int32
+	Cursor     *string
+}
+
+// RatingHistoryPage domain model
+type RatingHistoryPage struct {
+	Items      []*RatingEvent
+	NextCursor *string
+}
+
+// RankedMovie is an entry in a movie leaderboard
+type RankedMovie struct {
+	Title   string
 	Average float64
 	Count   int32
+	Score   float64 // Trending score; zero for other leaderboards
+}
+
+// RankingQuery domain model
+type RankingQuery struct {
+	Window RatingWindow
+	Limit  int32
+
+	// Optional segments; when several are set a movie must match all of them
+	Genre     *string
+	Year      *int32
+	MPARating *string
+}
+
+// TrendingQuery domain model
+type TrendingQuery struct {
+	Genre *string
+	Year  *int32
+	Limit int32
+}
+
+// SimilaritySource tells how a similar movie was found
+type SimilaritySource string
+
+// Similarity sources. Collaborative results come from co-ratings; content results
+// from shared genre, distributor and MPA rating when co-ratings are too sparse.
+const (
+	SimilarityCollaborative SimilaritySource = "collaborative"
+	SimilarityContent       SimilaritySource = "content"
+)
+
+// SimilarMovie is a movie similar to another one
+type SimilarMovie struct {
+	Title      string
+	Similarity float64
+	CoRaters   int32 // Zero for content-based results
+	Source     SimilaritySource
+}
+
+// RatingAnomaly describes a suspicious burst of ratings for a movie
+type RatingAnomaly struct {
+	MovieTitle      string
+	Reason          string
+	WindowCount     int32
+	WindowAverage   float64
+	BaselineCount   int32
+	BaselineAverage float64
+	DetectedAt      time.Time
+}
+
+// AggregateReview marks a movie's rating aggregate as under review.
+// FrozenAverage, when set, replaces the public average until ExpiresAt.
+type AggregateReview struct {
+	MovieTitle    string
+	Reason        string
+	FrozenAverage *float64
+	StartedAt     time.Time
+	ExpiresAt     time.Time
+}
+
+// AdminAlert is an event raised for administrators
+type AdminAlert struct {
+	Kind       string
+	MovieTitle string
+	Message    string
+	Fields     map[string]interface{}
+	CreatedAt  time.Time
 }
 
 // MovieListQuery domain model
@@ -62,6 +397,9 @@ type MovieListQuery struct {
 	Distributor *string
 	Budget      *int64
 	MPARating   *string
+	Director    *string // Person name, case-insensitive
+	Actor       *string // Person name, case-insensitive
+	Tag         *string
 	Limit       int32
 	Cursor      *string
 }
@@ -72,23 +410,262 @@ type MoviePage struct {
 	NextCursor *string
 }
 
-// MovieRepo defines the repository interface for movies
+// MovieRepo defines the repository interface for movies. Every write to a
+// movie, its taxonomy or its other related data advances the movie's Version.
+// A write given a movie with a non-zero Version fails with a
+// *VersionConflictError unless the movie still has that version.
 type MovieRepo interface {
+	// CreateMovie stores a movie and assigns its Key. Fails with ErrDuplicateTitle
+	// if a movie with the same title and release year exists, or with
+	// ErrDuplicateExternalID if another movie holds one of its external IDs.
 	CreateMovie(ctx context.Context, movie *Movie) error
+	// GetMovieByTitle resolves a title from a /movies/{title} route:
+	//  1. movies with exactly this title; several make the title ambiguous
+	//  2. "Title (YYYY)": the movie with that title released in that year
+	//  3. movies with this alternate title; several make the title ambiguous
+	//  4. the movie with this ID, for the Location a created movie returns
+	// An ambiguous title fails with an *AmbiguousTitleError.
 	GetMovieByTitle(ctx context.Context, title string) (*Movie, error)
+	// GetMovieByID returns the movie without its related data (credits, genres...)
+	GetMovieByID(ctx context.Context, id string) (*Movie, error)
+	// GetMovieByExternalID expects the scheme and ID in canonical form
+	GetMovieByExternalID(ctx context.Context, scheme, id string) (*Movie, error)
+	// BatchGetMovies sets Movie or Err on each lookup, reading cached movies
+	// first and resolving the rest with a few queries for the whole batch
+	BatchGetMovies(ctx context.Context, lookups []*MovieLookup) error
 	ListMovies(ctx context.Context, query *MovieListQuery) (*MoviePage, error)
+	// MatchMovies returns for each movie the ID of the existing movie with the
+	// same title and release year, or "" if there is none
+	MatchMovies(ctx context.Context, movies []*Movie) ([]string, error)
+	// ImportMovies stores a batch of new movies in one transaction, returning an
+	// outcome per movie. A movie matching an existing one is skipped or updates
+	// it, depending on mode; a failing movie does not affect the others. A dry
+	// run rolls the transaction back.
+	ImportMovies(ctx context.Context, movies []*Movie, mode ImportMode, dryRun bool) ([]*ImportOutcome, error)
+	// ExportMovies returns up to limit movies matching the filters of the query,
+	// in ID order after afterID, with their genres, tags, external IDs and
+	// all-time rating aggregate, whose average is the frozen one while an
+	// anomaly review is active. Limit and Cursor of the query are ignored.
+	ExportMovies(ctx context.Context, query *MovieListQuery, afterID string, limit int) ([]*ExportRow, error)
+	// UpdateMovie writes the movie's own fields; the box office figures are
+	// kept unless the movie has some
 	UpdateMovie(ctx context.Context, movie *Movie) error
+	// SetCredits replaces all credits of a movie
+	SetCredits(ctx context.Context, movie *Movie, credits []*Credit) error
+	// SetGenres replaces the genres of a movie, registering names that match no
+	// alias as new genres; the first genre becomes the primary one
+	SetGenres(ctx context.Context, movie *Movie, genres []string) error
+	SetTags(ctx context.Context, movie *Movie, tags []string) error
+	AddAlternateTitle(ctx context.Context, movie *Movie, title *AlternateTitle) error
+	// DeleteAlternateTitle fails with ErrAlternateTitleNotFound if the movie has no such title
+	DeleteAlternateTitle(ctx context.Context, movie *Movie, id int64) error
+	// SetTranslation creates or replaces the movie's text in the translation's locale
+	SetTranslation(ctx context.Context, movie *Movie, translation *MovieTranslation) error
+	// DeleteTranslation fails with ErrTranslationNotFound if the locale has no translation
+	DeleteTranslation(ctx context.Context, movie *Movie, locale string) error
+	// SetExternalID fails with ErrDuplicateExternalID if another movie holds the ID
+	SetExternalID(ctx context.Context, movie *Movie, scheme, id string) error
+	// DeleteExternalID fails with ErrExternalIDNotFound if the movie has no ID in the scheme
+	DeleteExternalID(ctx context.Context, movie *Movie, scheme string) error
+	AddImage(ctx context.Context, movie *Movie, image *MovieImage) error
+	// DeleteImage returns the deleted image, or fails with ErrImageNotFound
+	DeleteImage(ctx context.Context, movie *Movie, imageID string) (*MovieImage, error)
+}
+
+// BlobStore stores image files
+type BlobStore interface {
+	// Put stores data under key and returns the URL it is served from
+	Put(ctx context.Context, key, contentType string, data []byte) (string, error)
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// ServedBlobStore is a BlobStore whose files the HTTP server serves itself
+type ServedBlobStore interface {
+	BlobStore
+	http.Handler
+	// URLPrefix is the path the files are served under
+	URLPrefix() string
+}
+
+// GenreRepo defines the repository interface for the genre taxonomy
+type GenreRepo interface {
+	ListGenres(ctx context.Context) ([]*Genre, error)
+	GetGenre(ctx context.Context, id string) (*Genre, error)
+	// ResolveGenre returns the genre an alias key belongs to, or nil if none does
+	ResolveGenre(ctx context.Context, key string) (*Genre, error)
+	CreateGenre(ctx context.Context, genre *Genre) error
+	AddAlias(ctx context.Context, genreID, key string) error
+	// SetTranslation creates or replaces the genre's name in a locale
+	SetTranslation(ctx context.Context, genreID, locale, name string) error
+	// DeleteTranslation fails with ErrTranslationNotFound if the locale has no translation
+	DeleteTranslation(ctx context.Context, genreID, locale string) error
+}
+
+// PersonRepo defines the repository interface for people
+type PersonRepo interface {
+	CreatePerson(ctx context.Context, person *Person) error
+	GetPerson(ctx context.Context, id string) (*Person, error)
+	// GetPeople returns the people that exist among ids, keyed by ID
+	GetPeople(ctx context.Context, ids []string) (map[string]*Person, error)
+	UpdatePerson(ctx context.Context, id string, update *PersonUpdate) (*Person, error)
+	DeletePerson(ctx context.Context, id string) error
+	ListPeople(ctx context.Context, query *PersonListQuery) (*PersonPage, error)
+	ListPersonCredits(ctx context.Context, id string) ([]*PersonCredit, error)
+}
+
+// CollectionRepo defines the repository interface for collections
+type CollectionRepo interface {
+	CreateCollection(ctx context.Context, collection *Collection) error
+	// GetCollection returns a collection with its items in order
+	GetCollection(ctx context.Context, id string) (*Collection, error)
+	UpdateCollection(ctx context.Context, id string, update *CollectionUpdate) (*Collection, error)
+	DeleteCollection(ctx context.Context, id string) error
+	ListCollections(ctx context.Context, query *CollectionListQuery) (*CollectionPage, error)
+	// AddItem inserts a movie at position, shifting later items; a position past the end appends
+	AddItem(ctx context.Context, id, movieID string, position int32) error
+	// RemoveItem removes a movie and closes the gap in positions
+	RemoveItem(ctx context.Context, id, movieID string) error
+	// ReorderItems assigns positions following the order of movieIDs
+	ReorderItems(ctx context.Context, id string, movieIDs []string) error
 }
 
 // RatingRepo defines the repository interface for ratings
 type RatingRepo interface {
+	// UpsertRating stores the rater's rating of a movie and sets its ID,
+	// Version and moderation state. A rating a moderator hid stays hidden
+	// unless the new one is pending review. A non-zero Version is the one the
+	// rating is expected to have; a mismatch fails with a *VersionConflictError.
 	UpsertRating(ctx context.Context, rating *Rating) error
-	GetRatingAggregate(ctx context.Context, movieTitle string) (*RatingAggregate, error)
+	GetRatingAggregate(ctx context.Context, movieTitle string, window RatingWindow) (*RatingAggregate, error)
+	// BatchGetRatingAggregates returns the aggregate of every movie key, zero
+	// for movies without ratings
+	BatchGetRatingAggregates(ctx context.Context, movieTitles []string, window RatingWindow) (map[string]*RatingAggregate, error)
+	MarkUnderReview(ctx context.Context, review *AggregateReview) (bool, error)
+	ListRatingEvents(ctx context.Context, query *RatingHistoryQuery) (*RatingHistoryPage, error)
+}
+
+// RankingRepo defines the repository interface for movie leaderboards
+type RankingRepo interface {
+	ListTopRated(ctx context.Context, query *RankingQuery) ([]*RankedMovie, error)
+	ListPopular(ctx context.Context, query *RankingQuery) ([]*RankedMovie, error)
+	ListTrending(ctx context.Context, query *TrendingQuery) ([]*RankedMovie, error)
+	// RenormalizeTrending rebases the decayed trending scores to the current time
+	RenormalizeTrending(ctx context.Context) error
+	// RebuildRankings regenerates every leaderboard and segment from SQL
+	// and returns the number of ranked movies
+	RebuildRankings(ctx context.Context) (int, error)
+}
+
+// SimilarityRepo defines the repository interface for similar movies
+type SimilarityRepo interface {
+	// ComputeSimilarities replaces all collaborative similarities with the top-K
+	// per movie and returns the number of stored pairs. It fails with
+	// ErrSimilaritiesSkipped while another instance computes them, or if one
+	// did less than minAge ago.
+	ComputeSimilarities(ctx context.Context, topK, minCoRaters int32, minAge time.Duration) (int64, error)
+	ListSimilar(ctx context.Context, movieTitle string, limit int32) ([]*SimilarMovie, error)
+	// ListContentSimilar ranks movies by shared genre, distributor and MPA rating
+	ListContentSimilar(ctx context.Context, movie *Movie, exclude []string, limit int32) ([]*SimilarMovie, error)
+}
+
+// RecommendationRepo provides rating data for personalized recommendations
+type RecommendationRepo interface {
+	// ListTrainingRatings returns every visible rating of an existing movie
+	ListTrainingRatings(ctx context.Context) ([]*Rating, error)
+	ListRatedTitles(ctx context.Context, raterID string) ([]string, error)
+}
+
+// RecommenderModelStore persists trained recommender models
+type RecommenderModelStore interface {
+	Save(ctx context.Context, model *RecommenderModel) error
+	// Load returns the stored model, or ErrModelNotFound
+	Load(ctx context.Context) (*RecommenderModel, error)
+	// Version returns when the stored model was written, or ErrModelNotFound
+	Version(ctx context.Context) (time.Time, error)
+}
+
+// RatingAnomalyDetector watches rating velocity and distribution per movie
+type RatingAnomalyDetector interface {
+	// Observe records a stored rating and returns a non-nil anomaly when a burst is detected
+	Observe(ctx context.Context, rating *Rating) (*RatingAnomaly, error)
+}
+
+// AlertPublisher emits events for administrators
+type AlertPublisher interface {
+	Publish(ctx context.Context, alert *AdminAlert) error
+}
+
+// Metrics records business events for monitoring
+type Metrics interface {
+	// MovieCreated counts a stored movie by its source, "api" or "import"
+	MovieCreated(source string)
+	// RatingSubmitted counts a stored rating by its moderation status
+	RatingSubmitted(status ModerationStatus)
+}
+
+// ModerationRepo defines the repository interface for review moderation
+type ModerationRepo interface {
+	GetRating(ctx context.Context, id int64) (*Rating, error)
+	AddReport(ctx context.Context, ratingID int64, reporterID string, reason *string) (int32, error)
+	// SetModerationStatus fails with a *VersionConflictError if version is
+	// non-zero and not the rating's
+	SetModerationStatus(ctx context.Context, id, version int64, status ModerationStatus, reason *string) (*Rating, error)
+	ListQueue(ctx context.Context, query *ModerationQueueQuery) (*ModerationQueuePage, error)
+}
+
+// ContentScreener screens submitted ratings and reviews before they are stored
+type ContentScreener interface {
+	Screen(ctx context.Context, rating *Rating) (*ScreenVerdict, error)
+}
+
+// RateLimit describes a token bucket refilled at Rate tokens per second up to Burst
+type RateLimit struct {
+	Rate  float64
+	Burst int64
+}
+
+// RateLimitResult is the outcome of taking a token from a bucket
+type RateLimitResult struct {
+	Allowed    bool
+	Limit      int64
+	Remaining  int64
+	RetryAfter time.Duration // zero when allowed
+	Reset      time.Duration // time until the bucket is full again
+}
+
+// RateLimiter takes tokens from named token buckets
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, limit RateLimit) (*RateLimitResult, error)
+}
+
+// IdempotencyRecord is the stored outcome of a request sent with an
+// Idempotency-Key
+type IdempotencyRecord struct {
+	Principal   string // Caller the key belongs to
+	Key         string
+	RequestHash string // Operation and payload of the first request
+	Response    []byte // Encoded reply; nil while the first request is in progress
+}
+
+// IdempotencyStore keeps the first response per caller and idempotency key
+type IdempotencyStore interface {
+	// Claim reserves the key of a record without a response for ttl. It returns
+	// nil when the caller now holds the key, or the record already stored.
+	Claim(ctx context.Context, rec *IdempotencyRecord, ttl time.Duration) (*IdempotencyRecord, error)
+	// Complete stores the response of a claimed key for ttl
+	Complete(ctx context.Context, rec *IdempotencyRecord, ttl time.Duration) error
+	// Release drops a claimed key so the request can be retried
+	Release(ctx context.Context, principal, key string) error
+	// PurgeExpired deletes records past their ttl where the store does not
+	// expire them itself
+	PurgeExpired(ctx context.Context) error
 }
 
 // BoxOfficeClient defines the interface for box office API client
 type BoxOfficeClient interface {
-	GetBoxOffice(ctx context.Context, title string) (*BoxOfficeData, error)
+	// GetBoxOffice looks the movie up by its preferred external ID when it has
+	// one (see ExternalIDSchemes), by title otherwise
+	GetBoxOffice(ctx context.Context, title string, externalIDs map[string]string) (*BoxOfficeData, error)
 }
 
 // BoxOfficeData represents data from box office API
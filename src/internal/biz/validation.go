@@ -0,0 +1,54 @@
+package biz
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrValidation is matched by every *ValidationError
+var ErrValidation = errors.New("validation failed")
+
+// FieldViolation is why one field of a request is invalid
+type FieldViolation struct {
+	Field       string
+	Description string // Completes the field name, e.g. "is required"
+}
+
+// ValidationError is returned for a request with invalid fields, listing all
+// of them rather than only the first
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+// NewValidationError returns a validation error for one invalid field
+func NewValidationError(field, format string, args ...interface{}) *ValidationError {
+	return (&ValidationError{}).Add(field, format, args...)
+}
+
+// Add records another invalid field
+func (e *ValidationError) Add(field, format string, args ...interface{}) *ValidationError {
+	e.Violations = append(e.Violations, FieldViolation{Field: field, Description: fmt.Sprintf(format, args...)})
+	return e
+}
+
+// Err returns e if it has violations, or nil
+func (e *ValidationError) Err() error {
+	if len(e.Violations) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.Field+" "+v.Description)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Is makes errors.Is(err, ErrValidation) match
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
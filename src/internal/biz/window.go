@@ -0,0 +1,44 @@
+package biz
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidWindow is returned for unsupported aggregate windows
+var ErrInvalidWindow = errors.New("invalid window")
+
+// RatingWindow is a rolling time window for rating aggregates, in days
+type RatingWindow int
+
+// Supported windows. WindowAll covers every rating ever submitted.
+const (
+	WindowAll  RatingWindow = 0
+	Window7d   RatingWindow = 7
+	Window30d  RatingWindow = 30
+	Window365d RatingWindow = 365
+)
+
+// RatingWindows lists every supported window
+var RatingWindows = []RatingWindow{WindowAll, Window7d, Window30d, Window365d}
+
+// String returns the API form of the window (e.g. "7d" or "all")
+func (w RatingWindow) String() string {
+	if w == WindowAll {
+		return "all"
+	}
+	return fmt.Sprintf("%dd", int(w))
+}
+
+// ParseRatingWindow parses "7d", "30d", "365d" or "all" (empty means all)
+func ParseRatingWindow(s string) (RatingWindow, error) {
+	if s == "" {
+		return WindowAll, nil
+	}
+	for _, w := range RatingWindows {
+		if w.String() == s {
+			return w, nil
+		}
+	}
+	return WindowAll, fmt.Errorf("%w: %s", ErrInvalidWindow, s)
+}
@@ -28,6 +28,14 @@ type Bootstrap struct {
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Boxoffice     *BoxOffice             `protobuf:"bytes,3,opt,name=boxoffice,proto3" json:"boxoffice,omitempty"`
 	Auth          *Auth                  `protobuf:"bytes,4,opt,name=auth,proto3" json:"auth,omitempty"`
+	Moderation    *Moderation            `protobuf:"bytes,5,opt,name=moderation,proto3" json:"moderation,omitempty"`
+	Anomaly       *AnomalyDetection      `protobuf:"bytes,6,opt,name=anomaly,proto3" json:"anomaly,omitempty"`
+	Ratelimit     *RateLimit             `protobuf:"bytes,7,opt,name=ratelimit,proto3" json:"ratelimit,omitempty"`
+	Trending      *Trending              `protobuf:"bytes,8,opt,name=trending,proto3" json:"trending,omitempty"`
+	Similarity    *Similarity            `protobuf:"bytes,9,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	Recommender   *Recommender           `protobuf:"bytes,10,opt,name=recommender,proto3" json:"recommender,omitempty"`
+	Media         *Media                 `protobuf:"bytes,11,opt,name=media,proto3" json:"media,omitempty"`
+	Idempotency   *Idempotency           `protobuf:"bytes,12,opt,name=idempotency,proto3" json:"idempotency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,6 +98,62 @@ func (x *Bootstrap) GetAuth() *Auth {
 	return nil
 }
 
+func (x *Bootstrap) GetModeration() *Moderation {
+	if x != nil {
+		return x.Moderation
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetAnomaly() *AnomalyDetection {
+	if x != nil {
+		return x.Anomaly
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetRatelimit() *RateLimit {
+	if x != nil {
+		return x.Ratelimit
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetTrending() *Trending {
+	if x != nil {
+		return x.Trending
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetSimilarity() *Similarity {
+	if x != nil {
+		return x.Similarity
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetRecommender() *Recommender {
+	if x != nil {
+		return x.Recommender
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetMedia() *Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetIdempotency() *Idempotency {
+	if x != nil {
+		return x.Idempotency
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
@@ -306,29 +370,33 @@ func (x *Auth) GetToken() string {
 	return ""
 }
 
-type Server_HTTP struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Moderation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Comma-separated list of words that flag a review for moderation
+	BlockedWords string `protobuf:"bytes,1,opt,name=blocked_words,json=blockedWords,proto3" json:"blocked_words,omitempty"`
+	// Number of distinct reports that move a rating into the queue
+	ReportThreshold int32 `protobuf:"varint,2,opt,name=report_threshold,json=reportThreshold,proto3" json:"report_threshold,omitempty"`
+	// Window and limit for the rating rate-of-change detector
+	ChangeWindow        *durationpb.Duration `protobuf:"bytes,3,opt,name=change_window,json=changeWindow,proto3" json:"change_window,omitempty"`
+	MaxChangesPerWindow int32                `protobuf:"varint,4,opt,name=max_changes_per_window,json=maxChangesPerWindow,proto3" json:"max_changes_per_window,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *Server_HTTP) Reset() {
-	*x = Server_HTTP{}
+func (x *Moderation) Reset() {
+	*x = Moderation{}
 	mi := &file_conf_conf_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server_HTTP) String() string {
+func (x *Moderation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server_HTTP) ProtoMessage() {}
+func (*Moderation) ProtoMessage() {}
 
-func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
+func (x *Moderation) ProtoReflect() protoreflect.Message {
 	mi := &file_conf_conf_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -340,55 +408,72 @@ func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
-func (*Server_HTTP) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use Moderation.ProtoReflect.Descriptor instead.
+func (*Moderation) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Server_HTTP) GetNetwork() string {
+func (x *Moderation) GetBlockedWords() string {
 	if x != nil {
-		return x.Network
+		return x.BlockedWords
 	}
 	return ""
 }
 
-func (x *Server_HTTP) GetAddr() string {
+func (x *Moderation) GetReportThreshold() int32 {
 	if x != nil {
-		return x.Addr
+		return x.ReportThreshold
 	}
-	return ""
+	return 0
 }
 
-func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
+func (x *Moderation) GetChangeWindow() *durationpb.Duration {
 	if x != nil {
-		return x.Timeout
+		return x.ChangeWindow
 	}
 	return nil
 }
 
-type Server_GRPC struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Moderation) GetMaxChangesPerWindow() int32 {
+	if x != nil {
+		return x.MaxChangesPerWindow
+	}
+	return 0
 }
 
-func (x *Server_GRPC) Reset() {
-	*x = Server_GRPC{}
+type AnomalyDetection struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Sliding window used to measure rating velocity and distribution
+	Window *durationpb.Duration `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	// Ratings per window that count as a burst
+	MaxRatingsPerWindow int32 `protobuf:"varint,3,opt,name=max_ratings_per_window,json=maxRatingsPerWindow,proto3" json:"max_ratings_per_window,omitempty"`
+	// Minimum ratings in the window (and before it) for the distribution check
+	MinSamples int32 `protobuf:"varint,4,opt,name=min_samples,json=minSamples,proto3" json:"min_samples,omitempty"`
+	// Shift of the window average from the pre-burst average that triggers a review
+	MaxMeanShift float64 `protobuf:"fixed64,5,opt,name=max_mean_shift,json=maxMeanShift,proto3" json:"max_mean_shift,omitempty"`
+	// Freeze the public average at its pre-burst value while under review
+	FreezeAverage bool `protobuf:"varint,6,opt,name=freeze_average,json=freezeAverage,proto3" json:"freeze_average,omitempty"`
+	// How long a movie stays under review after a trigger
+	ReviewDuration *durationpb.Duration `protobuf:"bytes,7,opt,name=review_duration,json=reviewDuration,proto3" json:"review_duration,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AnomalyDetection) Reset() {
+	*x = AnomalyDetection{}
 	mi := &file_conf_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server_GRPC) String() string {
+func (x *AnomalyDetection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server_GRPC) ProtoMessage() {}
+func (*AnomalyDetection) ProtoMessage() {}
 
-func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
+func (x *AnomalyDetection) ProtoReflect() protoreflect.Message {
 	mi := &file_conf_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -400,54 +485,86 @@ func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server_GRPC.ProtoReflect.Descriptor instead.
-func (*Server_GRPC) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{1, 1}
+// Deprecated: Use AnomalyDetection.ProtoReflect.Descriptor instead.
+func (*AnomalyDetection) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Server_GRPC) GetNetwork() string {
+func (x *AnomalyDetection) GetEnabled() bool {
 	if x != nil {
-		return x.Network
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *Server_GRPC) GetAddr() string {
+func (x *AnomalyDetection) GetWindow() *durationpb.Duration {
 	if x != nil {
-		return x.Addr
+		return x.Window
 	}
-	return ""
+	return nil
 }
 
-func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
+func (x *AnomalyDetection) GetMaxRatingsPerWindow() int32 {
 	if x != nil {
-		return x.Timeout
+		return x.MaxRatingsPerWindow
+	}
+	return 0
+}
+
+func (x *AnomalyDetection) GetMinSamples() int32 {
+	if x != nil {
+		return x.MinSamples
+	}
+	return 0
+}
+
+func (x *AnomalyDetection) GetMaxMeanShift() float64 {
+	if x != nil {
+		return x.MaxMeanShift
+	}
+	return 0
+}
+
+func (x *AnomalyDetection) GetFreezeAverage() bool {
+	if x != nil {
+		return x.FreezeAverage
+	}
+	return false
+}
+
+func (x *AnomalyDetection) GetReviewDuration() *durationpb.Duration {
+	if x != nil {
+		return x.ReviewDuration
 	}
 	return nil
 }
 
-type Data_Database struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
-	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RateLimit struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Global per-IP limit applied to every operation
+	PerIp *RateLimit_Limit  `protobuf:"bytes,2,opt,name=per_ip,json=perIp,proto3" json:"per_ip,omitempty"`
+	Rules []*RateLimit_Rule `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	// Use X-Forwarded-For / X-Real-IP for the client IP (only behind a trusted proxy)
+	TrustProxyHeaders bool `protobuf:"varint,4,opt,name=trust_proxy_headers,json=trustProxyHeaders,proto3" json:"trust_proxy_headers,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Data_Database) Reset() {
-	*x = Data_Database{}
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
 	mi := &file_conf_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data_Database) String() string {
+func (x *RateLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Database) ProtoMessage() {}
+func (*RateLimit) ProtoMessage() {}
 
-func (x *Data_Database) ProtoReflect() protoreflect.Message {
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
 	mi := &file_conf_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -459,49 +576,63 @@ func (x *Data_Database) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
-func (*Data_Database) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Data_Database) GetDriver() string {
+func (x *RateLimit) GetEnabled() bool {
 	if x != nil {
-		return x.Driver
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *Data_Database) GetSource() string {
+func (x *RateLimit) GetPerIp() *RateLimit_Limit {
 	if x != nil {
-		return x.Source
+		return x.PerIp
 	}
-	return ""
+	return nil
 }
 
-type Data_Redis struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	ReadTimeout   *durationpb.Duration   `protobuf:"bytes,3,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
-	WriteTimeout  *durationpb.Duration   `protobuf:"bytes,4,opt,name=write_timeout,json=writeTimeout,proto3" json:"write_timeout,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RateLimit) GetRules() []*RateLimit_Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
 }
 
-func (x *Data_Redis) Reset() {
-	*x = Data_Redis{}
+func (x *RateLimit) GetTrustProxyHeaders() bool {
+	if x != nil {
+		return x.TrustProxyHeaders
+	}
+	return false
+}
+
+type Trending struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Age at which a rating's contribution to the trending score halves
+	HalfLife *durationpb.Duration `protobuf:"bytes,1,opt,name=half_life,json=halfLife,proto3" json:"half_life,omitempty"`
+	// How often trending scores are rebased to keep them in float range
+	RenormalizeInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=renormalize_interval,json=renormalizeInterval,proto3" json:"renormalize_interval,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Trending) Reset() {
+	*x = Trending{}
 	mi := &file_conf_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data_Redis) String() string {
+func (x *Trending) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Redis) ProtoMessage() {}
+func (*Trending) ProtoMessage() {}
 
-func (x *Data_Redis) ProtoReflect() protoreflect.Message {
+func (x *Trending) ProtoReflect() protoreflect.Message {
 	mi := &file_conf_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -513,57 +644,929 @@ func (x *Data_Redis) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Redis.ProtoReflect.Descriptor instead.
-func (*Data_Redis) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 1}
+// Deprecated: Use Trending.ProtoReflect.Descriptor instead.
+func (*Trending) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Data_Redis) GetNetwork() string {
+func (x *Trending) GetHalfLife() *durationpb.Duration {
 	if x != nil {
-		return x.Network
+		return x.HalfLife
 	}
-	return ""
+	return nil
 }
 
-func (x *Data_Redis) GetAddr() string {
+func (x *Trending) GetRenormalizeInterval() *durationpb.Duration {
 	if x != nil {
-		return x.Addr
+		return x.RenormalizeInterval
 	}
-	return ""
+	return nil
 }
 
-func (x *Data_Redis) GetReadTimeout() *durationpb.Duration {
+type Similarity struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Similar movies kept per movie
+	TopK int32 `protobuf:"varint,1,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`
+	// Raters two movies must have in common to be compared
+	MinCoRaters int32 `protobuf:"varint,2,opt,name=min_co_raters,json=minCoRaters,proto3" json:"min_co_raters,omitempty"`
+	// How often similarities are recomputed
+	Interval      *durationpb.Duration `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Similarity) Reset() {
+	*x = Similarity{}
+	mi := &file_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Similarity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Similarity) ProtoMessage() {}
+
+func (x *Similarity) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[9]
 	if x != nil {
-		return x.ReadTimeout
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Similarity.ProtoReflect.Descriptor instead.
+func (*Similarity) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Similarity) GetTopK() int32 {
+	if x != nil {
+		return x.TopK
+	}
+	return 0
+}
+
+func (x *Similarity) GetMinCoRaters() int32 {
+	if x != nil {
+		return x.MinCoRaters
+	}
+	return 0
+}
+
+func (x *Similarity) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
 	}
 	return nil
 }
 
-func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
+type Recommender struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// File the trained model is written to and hot-reloaded from
+	ModelPath string `protobuf:"bytes,1,opt,name=model_path,json=modelPath,proto3" json:"model_path,omitempty"`
+	// Latent factors per rater and movie
+	Factors int32 `protobuf:"varint,2,opt,name=factors,proto3" json:"factors,omitempty"`
+	// Training passes over the ratings
+	Epochs         int32   `protobuf:"varint,3,opt,name=epochs,proto3" json:"epochs,omitempty"`
+	LearningRate   float64 `protobuf:"fixed64,4,opt,name=learning_rate,json=learningRate,proto3" json:"learning_rate,omitempty"`
+	Regularization float64 `protobuf:"fixed64,5,opt,name=regularization,proto3" json:"regularization,omitempty"`
+	// How often the server checks the model file for a newer version
+	ReloadInterval *durationpb.Duration `protobuf:"bytes,6,opt,name=reload_interval,json=reloadInterval,proto3" json:"reload_interval,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Recommender) Reset() {
+	*x = Recommender{}
+	mi := &file_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recommender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recommender) ProtoMessage() {}
+
+func (x *Recommender) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[10]
 	if x != nil {
-		return x.WriteTimeout
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recommender.ProtoReflect.Descriptor instead.
+func (*Recommender) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Recommender) GetModelPath() string {
+	if x != nil {
+		return x.ModelPath
+	}
+	return ""
+}
+
+func (x *Recommender) GetFactors() int32 {
+	if x != nil {
+		return x.Factors
+	}
+	return 0
+}
+
+func (x *Recommender) GetEpochs() int32 {
+	if x != nil {
+		return x.Epochs
+	}
+	return 0
+}
+
+func (x *Recommender) GetLearningRate() float64 {
+	if x != nil {
+		return x.LearningRate
+	}
+	return 0
+}
+
+func (x *Recommender) GetRegularization() float64 {
+	if x != nil {
+		return x.Regularization
+	}
+	return 0
+}
+
+func (x *Recommender) GetReloadInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReloadInterval
 	}
 	return nil
 }
 
-var File_conf_conf_proto protoreflect.FileDescriptor
+type Media struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Blob store for images: local or s3
+	Storage string       `protobuf:"bytes,1,opt,name=storage,proto3" json:"storage,omitempty"`
+	Local   *Media_Local `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
+	S3      *Media_S3    `protobuf:"bytes,3,opt,name=s3,proto3" json:"s3,omitempty"`
+	// Largest accepted upload in bytes
+	MaxUploadBytes int64 `protobuf:"varint,4,opt,name=max_upload_bytes,json=maxUploadBytes,proto3" json:"max_upload_bytes,omitempty"`
+	// Largest accepted image in pixels (width x height)
+	MaxPixels int64 `protobuf:"varint,5,opt,name=max_pixels,json=maxPixels,proto3" json:"max_pixels,omitempty"`
+	// Comma-separated widths of the thumbnails generated at upload
+	ThumbnailWidths string `protobuf:"bytes,6,opt,name=thumbnail_widths,json=thumbnailWidths,proto3" json:"thumbnail_widths,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Media) Reset() {
+	*x = Media{}
+	mi := &file_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-const file_conf_conf_proto_rawDesc = "" +
-	"\n" +
-	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xb8\x01\n" +
-	"\tBootstrap\x12*\n" +
-	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
-	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x123\n" +
+func (x *Media) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Media) ProtoMessage() {}
+
+func (x *Media) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Media.ProtoReflect.Descriptor instead.
+func (*Media) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Media) GetStorage() string {
+	if x != nil {
+		return x.Storage
+	}
+	return ""
+}
+
+func (x *Media) GetLocal() *Media_Local {
+	if x != nil {
+		return x.Local
+	}
+	return nil
+}
+
+func (x *Media) GetS3() *Media_S3 {
+	if x != nil {
+		return x.S3
+	}
+	return nil
+}
+
+func (x *Media) GetMaxUploadBytes() int64 {
+	if x != nil {
+		return x.MaxUploadBytes
+	}
+	return 0
+}
+
+func (x *Media) GetMaxPixels() int64 {
+	if x != nil {
+		return x.MaxPixels
+	}
+	return 0
+}
+
+func (x *Media) GetThumbnailWidths() string {
+	if x != nil {
+		return x.ThumbnailWidths
+	}
+	return ""
+}
+
+type Idempotency struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How long the response to a request with an Idempotency-Key is replayed
+	Ttl           *durationpb.Duration `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Idempotency) Reset() {
+	*x = Idempotency{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Idempotency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Idempotency) ProtoMessage() {}
+
+func (x *Idempotency) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Idempotency.ProtoReflect.Descriptor instead.
+func (*Idempotency) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Idempotency) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type Server_HTTP struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Network string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr    string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Cache-Control per route; the first matching rule applies
+	CacheRules    []*Server_HTTP_CacheRule `protobuf:"bytes,4,rep,name=cache_rules,json=cacheRules,proto3" json:"cache_rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_HTTP) Reset() {
+	*x = Server_HTTP{}
+	mi := &file_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_HTTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_HTTP) ProtoMessage() {}
+
+func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
+func (*Server_HTTP) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Server_HTTP) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Server_HTTP) GetCacheRules() []*Server_HTTP_CacheRule {
+	if x != nil {
+		return x.CacheRules
+	}
+	return nil
+}
+
+type Server_GRPC struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_GRPC) Reset() {
+	*x = Server_GRPC{}
+	mi := &file_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_GRPC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_GRPC) ProtoMessage() {}
+
+func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_GRPC.ProtoReflect.Descriptor instead.
+func (*Server_GRPC) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Server_GRPC) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Server_GRPC) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type Server_HTTP_CacheRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Route of GET requests, with {name} matching one path segment, e.g. /movies/{title}/rating
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Cache-Control of its successful responses, e.g. public, max-age=60
+	CacheControl  string `protobuf:"bytes,2,opt,name=cache_control,json=cacheControl,proto3" json:"cache_control,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_HTTP_CacheRule) Reset() {
+	*x = Server_HTTP_CacheRule{}
+	mi := &file_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_HTTP_CacheRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_HTTP_CacheRule) ProtoMessage() {}
+
+func (x *Server_HTTP_CacheRule) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_HTTP_CacheRule.ProtoReflect.Descriptor instead.
+func (*Server_HTTP_CacheRule) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 0, 0}
+}
+
+func (x *Server_HTTP_CacheRule) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Server_HTTP_CacheRule) GetCacheControl() string {
+	if x != nil {
+		return x.CacheControl
+	}
+	return ""
+}
+
+type Data_Database struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Database) Reset() {
+	*x = Data_Database{}
+	mi := &file_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Database) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Database) ProtoMessage() {}
+
+func (x *Data_Database) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
+func (*Data_Database) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Data_Database) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Database) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type Data_Redis struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	ReadTimeout   *durationpb.Duration   `protobuf:"bytes,3,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
+	WriteTimeout  *durationpb.Duration   `protobuf:"bytes,4,opt,name=write_timeout,json=writeTimeout,proto3" json:"write_timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Redis) Reset() {
+	*x = Data_Redis{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Redis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Redis) ProtoMessage() {}
+
+func (x *Data_Redis) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Redis.ProtoReflect.Descriptor instead.
+func (*Data_Redis) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Data_Redis) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Data_Redis) GetReadTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ReadTimeout
+	}
+	return nil
+}
+
+func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.WriteTimeout
+	}
+	return nil
+}
+
+type RateLimit_Limit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Requests allowed per period (the sustained rate)
+	Requests int32                `protobuf:"varint,1,opt,name=requests,proto3" json:"requests,omitempty"`
+	Period   *durationpb.Duration `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	// Bucket size; defaults to requests
+	Burst         int32 `protobuf:"varint,3,opt,name=burst,proto3" json:"burst,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimit_Limit) Reset() {
+	*x = RateLimit_Limit{}
+	mi := &file_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimit_Limit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit_Limit) ProtoMessage() {}
+
+func (x *RateLimit_Limit) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit_Limit.ProtoReflect.Descriptor instead.
+func (*RateLimit_Limit) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *RateLimit_Limit) GetRequests() int32 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *RateLimit_Limit) GetPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *RateLimit_Limit) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+type RateLimit_Rule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Full operation name, e.g. /api.movie.v1.MovieService/SubmitRating
+	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Bucket key: rater (X-Rater-Id), api_key (Bearer token) or ip
+	Key           string           `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Limit         *RateLimit_Limit `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimit_Rule) Reset() {
+	*x = RateLimit_Rule{}
+	mi := &file_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimit_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit_Rule) ProtoMessage() {}
+
+func (x *RateLimit_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit_Rule.ProtoReflect.Descriptor instead.
+func (*RateLimit_Rule) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *RateLimit_Rule) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *RateLimit_Rule) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RateLimit_Rule) GetLimit() *RateLimit_Limit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+type Media_Local struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Directory images are written to
+	Dir string `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	// URL path the HTTP server serves the directory under, e.g. /media/
+	UrlPrefix     string `protobuf:"bytes,2,opt,name=url_prefix,json=urlPrefix,proto3" json:"url_prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Media_Local) Reset() {
+	*x = Media_Local{}
+	mi := &file_conf_conf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Media_Local) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Media_Local) ProtoMessage() {}
+
+func (x *Media_Local) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Media_Local.ProtoReflect.Descriptor instead.
+func (*Media_Local) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *Media_Local) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+func (x *Media_Local) GetUrlPrefix() string {
+	if x != nil {
+		return x.UrlPrefix
+	}
+	return ""
+}
+
+type Media_S3 struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// S3-compatible endpoint, e.g. https://s3.us-east-1.amazonaws.com or http://minio:9000
+	Endpoint  string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Region    string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Bucket    string `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	AccessKey string `protobuf:"bytes,4,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	SecretKey string `protobuf:"bytes,5,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	// Address the bucket as endpoint/bucket instead of bucket.endpoint (needed for MinIO)
+	PathStyle bool `protobuf:"varint,6,opt,name=path_style,json=pathStyle,proto3" json:"path_style,omitempty"`
+	// Base URL images are served from (e.g. a CDN); defaults to the bucket URL
+	PublicUrl     string               `protobuf:"bytes,7,opt,name=public_url,json=publicUrl,proto3" json:"public_url,omitempty"`
+	Timeout       *durationpb.Duration `protobuf:"bytes,8,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Media_S3) Reset() {
+	*x = Media_S3{}
+	mi := &file_conf_conf_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Media_S3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Media_S3) ProtoMessage() {}
+
+func (x *Media_S3) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Media_S3.ProtoReflect.Descriptor instead.
+func (*Media_S3) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{11, 1}
+}
+
+func (x *Media_S3) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Media_S3) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Media_S3) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *Media_S3) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *Media_S3) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *Media_S3) GetPathStyle() bool {
+	if x != nil {
+		return x.PathStyle
+	}
+	return false
+}
+
+func (x *Media_S3) GetPublicUrl() string {
+	if x != nil {
+		return x.PublicUrl
+	}
+	return ""
+}
+
+func (x *Media_S3) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+var File_conf_conf_proto protoreflect.FileDescriptor
+
+const file_conf_conf_proto_rawDesc = "" +
+	"\n" +
+	"\x0fconf/conf.proto\x12\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xe6\x04\n" +
+	"\tBootstrap\x12*\n" +
+	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
+	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x123\n" +
 	"\tboxoffice\x18\x03 \x01(\v2\x15.kratos.api.BoxOfficeR\tboxoffice\x12$\n" +
-	"\x04auth\x18\x04 \x01(\v2\x10.kratos.api.AuthR\x04auth\"\xb8\x02\n" +
+	"\x04auth\x18\x04 \x01(\v2\x10.kratos.api.AuthR\x04auth\x126\n" +
+	"\n" +
+	"moderation\x18\x05 \x01(\v2\x16.kratos.api.ModerationR\n" +
+	"moderation\x126\n" +
+	"\aanomaly\x18\x06 \x01(\v2\x1c.kratos.api.AnomalyDetectionR\aanomaly\x123\n" +
+	"\tratelimit\x18\a \x01(\v2\x15.kratos.api.RateLimitR\tratelimit\x120\n" +
+	"\btrending\x18\b \x01(\v2\x14.kratos.api.TrendingR\btrending\x126\n" +
+	"\n" +
+	"similarity\x18\t \x01(\v2\x16.kratos.api.SimilarityR\n" +
+	"similarity\x129\n" +
+	"\vrecommender\x18\n" +
+	" \x01(\v2\x17.kratos.api.RecommenderR\vrecommender\x12'\n" +
+	"\x05media\x18\v \x01(\v2\x11.kratos.api.MediaR\x05media\x129\n" +
+	"\vidempotency\x18\f \x01(\v2\x17.kratos.api.IdempotencyR\vidempotency\"\xc3\x03\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1a\xf3\x01\n" +
 	"\x04HTTP\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12B\n" +
+	"\vcache_rules\x18\x04 \x03(\v2!.kratos.api.Server.HTTP.CacheRuleR\n" +
+	"cacheRules\x1aD\n" +
+	"\tCacheRule\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12#\n" +
+	"\rcache_control\x18\x02 \x01(\tR\fcacheControl\x1ai\n" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
@@ -586,7 +1589,78 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\vmax_retries\x18\x04 \x01(\x05R\n" +
 	"maxRetries\"\x1c\n" +
 	"\x04Auth\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05tokenB\x18Z\x16src/internal/conf;confb\x06proto3"
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xd1\x01\n" +
+	"\n" +
+	"Moderation\x12#\n" +
+	"\rblocked_words\x18\x01 \x01(\tR\fblockedWords\x12)\n" +
+	"\x10report_threshold\x18\x02 \x01(\x05R\x0freportThreshold\x12>\n" +
+	"\rchange_window\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\fchangeWindow\x123\n" +
+	"\x16max_changes_per_window\x18\x04 \x01(\x05R\x13maxChangesPerWindow\"\xc6\x02\n" +
+	"\x10AnomalyDetection\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x121\n" +
+	"\x06window\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06window\x123\n" +
+	"\x16max_ratings_per_window\x18\x03 \x01(\x05R\x13maxRatingsPerWindow\x12\x1f\n" +
+	"\vmin_samples\x18\x04 \x01(\x05R\n" +
+	"minSamples\x12$\n" +
+	"\x0emax_mean_shift\x18\x05 \x01(\x01R\fmaxMeanShift\x12%\n" +
+	"\x0efreeze_average\x18\x06 \x01(\bR\rfreezeAverage\x12B\n" +
+	"\x0freview_duration\x18\a \x01(\v2\x19.google.protobuf.DurationR\x0ereviewDuration\"\x94\x03\n" +
+	"\tRateLimit\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x122\n" +
+	"\x06per_ip\x18\x02 \x01(\v2\x1b.kratos.api.RateLimit.LimitR\x05perIp\x120\n" +
+	"\x05rules\x18\x03 \x03(\v2\x1a.kratos.api.RateLimit.RuleR\x05rules\x12.\n" +
+	"\x13trust_proxy_headers\x18\x04 \x01(\bR\x11trustProxyHeaders\x1al\n" +
+	"\x05Limit\x12\x1a\n" +
+	"\brequests\x18\x01 \x01(\x05R\brequests\x121\n" +
+	"\x06period\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06period\x12\x14\n" +
+	"\x05burst\x18\x03 \x01(\x05R\x05burst\x1ai\n" +
+	"\x04Rule\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x121\n" +
+	"\x05limit\x18\x03 \x01(\v2\x1b.kratos.api.RateLimit.LimitR\x05limit\"\x90\x01\n" +
+	"\bTrending\x126\n" +
+	"\thalf_life\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\bhalfLife\x12L\n" +
+	"\x14renormalize_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x13renormalizeInterval\"|\n" +
+	"\n" +
+	"Similarity\x12\x13\n" +
+	"\x05top_k\x18\x01 \x01(\x05R\x04topK\x12\"\n" +
+	"\rmin_co_raters\x18\x02 \x01(\x05R\vminCoRaters\x125\n" +
+	"\binterval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\binterval\"\xef\x01\n" +
+	"\vRecommender\x12\x1d\n" +
+	"\n" +
+	"model_path\x18\x01 \x01(\tR\tmodelPath\x12\x18\n" +
+	"\afactors\x18\x02 \x01(\x05R\afactors\x12\x16\n" +
+	"\x06epochs\x18\x03 \x01(\x05R\x06epochs\x12#\n" +
+	"\rlearning_rate\x18\x04 \x01(\x01R\flearningRate\x12&\n" +
+	"\x0eregularization\x18\x05 \x01(\x01R\x0eregularization\x12B\n" +
+	"\x0freload_interval\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x0ereloadInterval\"\xa8\x04\n" +
+	"\x05Media\x12\x18\n" +
+	"\astorage\x18\x01 \x01(\tR\astorage\x12-\n" +
+	"\x05local\x18\x02 \x01(\v2\x17.kratos.api.Media.LocalR\x05local\x12$\n" +
+	"\x02s3\x18\x03 \x01(\v2\x14.kratos.api.Media.S3R\x02s3\x12(\n" +
+	"\x10max_upload_bytes\x18\x04 \x01(\x03R\x0emaxUploadBytes\x12\x1d\n" +
+	"\n" +
+	"max_pixels\x18\x05 \x01(\x03R\tmaxPixels\x12)\n" +
+	"\x10thumbnail_widths\x18\x06 \x01(\tR\x0fthumbnailWidths\x1a8\n" +
+	"\x05Local\x12\x10\n" +
+	"\x03dir\x18\x01 \x01(\tR\x03dir\x12\x1d\n" +
+	"\n" +
+	"url_prefix\x18\x02 \x01(\tR\turlPrefix\x1a\x81\x02\n" +
+	"\x02S3\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x16\n" +
+	"\x06bucket\x18\x03 \x01(\tR\x06bucket\x12\x1d\n" +
+	"\n" +
+	"access_key\x18\x04 \x01(\tR\taccessKey\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x05 \x01(\tR\tsecretKey\x12\x1d\n" +
+	"\n" +
+	"path_style\x18\x06 \x01(\bR\tpathStyle\x12\x1d\n" +
+	"\n" +
+	"public_url\x18\a \x01(\tR\tpublicUrl\x123\n" +
+	"\atimeout\x18\b \x01(\v2\x19.google.protobuf.DurationR\atimeout\":\n" +
+	"\vIdempotency\x12+\n" +
+	"\x03ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x03ttlB\x18Z\x16src/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -600,38 +1674,75 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Server)(nil),              // 1: kratos.api.Server
-	(*Data)(nil),                // 2: kratos.api.Data
-	(*BoxOffice)(nil),           // 3: kratos.api.BoxOffice
-	(*Auth)(nil),                // 4: kratos.api.Auth
-	(*Server_HTTP)(nil),         // 5: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 6: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 7: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 8: kratos.api.Data.Redis
-	(*durationpb.Duration)(nil), // 9: google.protobuf.Duration
+	(*Bootstrap)(nil),             // 0: kratos.api.Bootstrap
+	(*Server)(nil),                // 1: kratos.api.Server
+	(*Data)(nil),                  // 2: kratos.api.Data
+	(*BoxOffice)(nil),             // 3: kratos.api.BoxOffice
+	(*Auth)(nil),                  // 4: kratos.api.Auth
+	(*Moderation)(nil),            // 5: kratos.api.Moderation
+	(*AnomalyDetection)(nil),      // 6: kratos.api.AnomalyDetection
+	(*RateLimit)(nil),             // 7: kratos.api.RateLimit
+	(*Trending)(nil),              // 8: kratos.api.Trending
+	(*Similarity)(nil),            // 9: kratos.api.Similarity
+	(*Recommender)(nil),           // 10: kratos.api.Recommender
+	(*Media)(nil),                 // 11: kratos.api.Media
+	(*Idempotency)(nil),           // 12: kratos.api.Idempotency
+	(*Server_HTTP)(nil),           // 13: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),           // 14: kratos.api.Server.GRPC
+	(*Server_HTTP_CacheRule)(nil), // 15: kratos.api.Server.HTTP.CacheRule
+	(*Data_Database)(nil),         // 16: kratos.api.Data.Database
+	(*Data_Redis)(nil),            // 17: kratos.api.Data.Redis
+	(*RateLimit_Limit)(nil),       // 18: kratos.api.RateLimit.Limit
+	(*RateLimit_Rule)(nil),        // 19: kratos.api.RateLimit.Rule
+	(*Media_Local)(nil),           // 20: kratos.api.Media.Local
+	(*Media_S3)(nil),              // 21: kratos.api.Media.S3
+	(*durationpb.Duration)(nil),   // 22: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
 	3,  // 2: kratos.api.Bootstrap.boxoffice:type_name -> kratos.api.BoxOffice
 	4,  // 3: kratos.api.Bootstrap.auth:type_name -> kratos.api.Auth
-	5,  // 4: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	6,  // 5: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	7,  // 6: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	8,  // 7: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	9,  // 8: kratos.api.BoxOffice.timeout:type_name -> google.protobuf.Duration
-	9,  // 9: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	9,  // 10: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	9,  // 11: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	9,  // 12: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	5,  // 4: kratos.api.Bootstrap.moderation:type_name -> kratos.api.Moderation
+	6,  // 5: kratos.api.Bootstrap.anomaly:type_name -> kratos.api.AnomalyDetection
+	7,  // 6: kratos.api.Bootstrap.ratelimit:type_name -> kratos.api.RateLimit
+	8,  // 7: kratos.api.Bootstrap.trending:type_name -> kratos.api.Trending
+	9,  // 8: kratos.api.Bootstrap.similarity:type_name -> kratos.api.Similarity
+	10, // 9: kratos.api.Bootstrap.recommender:type_name -> kratos.api.Recommender
+	11, // 10: kratos.api.Bootstrap.media:type_name -> kratos.api.Media
+	12, // 11: kratos.api.Bootstrap.idempotency:type_name -> kratos.api.Idempotency
+	13, // 12: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	14, // 13: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	16, // 14: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	17, // 15: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	22, // 16: kratos.api.BoxOffice.timeout:type_name -> google.protobuf.Duration
+	22, // 17: kratos.api.Moderation.change_window:type_name -> google.protobuf.Duration
+	22, // 18: kratos.api.AnomalyDetection.window:type_name -> google.protobuf.Duration
+	22, // 19: kratos.api.AnomalyDetection.review_duration:type_name -> google.protobuf.Duration
+	18, // 20: kratos.api.RateLimit.per_ip:type_name -> kratos.api.RateLimit.Limit
+	19, // 21: kratos.api.RateLimit.rules:type_name -> kratos.api.RateLimit.Rule
+	22, // 22: kratos.api.Trending.half_life:type_name -> google.protobuf.Duration
+	22, // 23: kratos.api.Trending.renormalize_interval:type_name -> google.protobuf.Duration
+	22, // 24: kratos.api.Similarity.interval:type_name -> google.protobuf.Duration
+	22, // 25: kratos.api.Recommender.reload_interval:type_name -> google.protobuf.Duration
+	20, // 26: kratos.api.Media.local:type_name -> kratos.api.Media.Local
+	21, // 27: kratos.api.Media.s3:type_name -> kratos.api.Media.S3
+	22, // 28: kratos.api.Idempotency.ttl:type_name -> google.protobuf.Duration
+	22, // 29: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	15, // 30: kratos.api.Server.HTTP.cache_rules:type_name -> kratos.api.Server.HTTP.CacheRule
+	22, // 31: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	22, // 32: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	22, // 33: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	22, // 34: kratos.api.RateLimit.Limit.period:type_name -> google.protobuf.Duration
+	18, // 35: kratos.api.RateLimit.Rule.limit:type_name -> kratos.api.RateLimit.Limit
+	22, // 36: kratos.api.Media.S3.timeout:type_name -> google.protobuf.Duration
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -645,7 +1756,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,64 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"src/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// adminAlertStream is the Redis stream consumed by admin tooling
+const adminAlertStream = "alerts:admin"
+
+// adminAlertStreamMaxLen caps the stream length (approximate trimming)
+const adminAlertStreamMaxLen = 10000
+
+type alertPublisher struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAlertPublisher creates a publisher for admin alert events.
+// Alerts are always logged and appended to a Redis stream when Redis is available.
+func NewAlertPublisher(data *Data, logger log.Logger) biz.AlertPublisher {
+	return &alertPublisher{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (p *alertPublisher) Publish(ctx context.Context, alert *biz.AdminAlert) error {
+	fields, err := json.Marshal(alert.Fields)
+	if err != nil {
+		return fmt.Errorf("failed to marshal alert fields: %w", err)
+	}
+
+	p.log.Warnf("admin alert [%s] movie=%s: %s %s", alert.Kind, alert.MovieTitle, alert.Message, fields)
+
+	if p.data.rdb == nil {
+		return nil
+	}
+
+	err = p.data.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: adminAlertStream,
+		MaxLen: adminAlertStreamMaxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"kind":        alert.Kind,
+			"movie_title": alert.MovieTitle,
+			"message":     alert.Message,
+			"fields":      string(fields),
+			"created_at":  alert.CreatedAt.UTC().Format(time.RFC3339),
+		},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("failed to publish alert: %w", err)
+	}
+
+	return nil
+}
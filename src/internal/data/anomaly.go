@@ -0,0 +1,175 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"src/internal/biz"
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultAnomalyWindow       = 10 * time.Minute
+	defaultMaxRatingsPerWindow = 100
+	defaultAnomalyMinSamples   = 20
+	defaultMaxMeanShift        = 1.5
+)
+
+type ratingAnomalyDetector struct {
+	data                *Data
+	window              time.Duration
+	maxRatingsPerWindow int32
+	minSamples          int32
+	maxMeanShift        float64
+	log                 *log.Helper
+}
+
+// NewRatingAnomalyDetector creates a detector for rating bursts (review-bombing).
+// Sliding windows are kept in Redis ZSets, with a SQL fallback when Redis is absent.
+func NewRatingAnomalyDetector(c *conf.AnomalyDetection, data *Data, logger log.Logger) biz.RatingAnomalyDetector {
+	d := &ratingAnomalyDetector{
+		data:                data,
+		window:              defaultAnomalyWindow,
+		maxRatingsPerWindow: defaultMaxRatingsPerWindow,
+		minSamples:          defaultAnomalyMinSamples,
+		maxMeanShift:        defaultMaxMeanShift,
+		log:                 log.NewHelper(logger),
+	}
+	if c.GetWindow() != nil && c.GetWindow().AsDuration() > 0 {
+		d.window = c.GetWindow().AsDuration()
+	}
+	if c.GetMaxRatingsPerWindow() > 0 {
+		d.maxRatingsPerWindow = c.GetMaxRatingsPerWindow()
+	}
+	if c.GetMinSamples() > 0 {
+		d.minSamples = c.GetMinSamples()
+	}
+	if c.GetMaxMeanShift() > 0 {
+		d.maxMeanShift = c.GetMaxMeanShift()
+	}
+	return d
+}
+
+// windowStats summarizes ratings inside or before the window
+type windowStats struct {
+	Count   int32
+	Average float64
+}
+
+func (d *ratingAnomalyDetector) Observe(ctx context.Context, rating *biz.Rating) (*biz.RatingAnomaly, error) {
+	now := time.Now().UTC()
+	windowStart := now.Add(-d.window)
+
+	current, err := d.windowStats(ctx, rating, now, windowStart)
+	if err != nil {
+		return nil, err
+	}
+
+	velocity := current.Count >= d.maxRatingsPerWindow
+	if !velocity && current.Count < d.minSamples {
+		return nil, nil
+	}
+
+	// The baseline is everything rated before the window (the pre-burst aggregate)
+	baseline, err := d.baselineStats(ctx, rating.MovieTitle, windowStart)
+	if err != nil {
+		return nil, err
+	}
+
+	shift := math.Abs(current.Average - baseline.Average)
+	var reason string
+	switch {
+	case velocity:
+		reason = fmt.Sprintf("rating velocity: %d ratings within %s", current.Count, d.window)
+	case baseline.Count >= d.minSamples && shift >= d.maxMeanShift:
+		reason = fmt.Sprintf("rating distribution shift: window average %.1f vs %.1f before", current.Average, baseline.Average)
+	default:
+		return nil, nil
+	}
+
+	return &biz.RatingAnomaly{
+		MovieTitle:      rating.MovieTitle,
+		Reason:          reason,
+		WindowCount:     current.Count,
+		WindowAverage:   current.Average,
+		BaselineCount:   baseline.Count,
+		BaselineAverage: math.Round(baseline.Average*10) / 10,
+		DetectedAt:      now,
+	}, nil
+}
+
+// windowStats records the rating in the sliding window and returns its stats
+func (d *ratingAnomalyDetector) windowStats(ctx context.Context, rating *biz.Rating, now, windowStart time.Time) (*windowStats, error) {
+	if d.data.rdb == nil {
+		return d.windowStatsSQL(ctx, rating.MovieTitle, windowStart)
+	}
+
+	key := fmt.Sprintf("anomaly:ratings:%s", rating.MovieTitle)
+	// Each submission is a separate member so re-rates count towards velocity
+	member := fmt.Sprintf("%s|%.1f|%d", rating.RaterID, rating.Rating, now.UnixNano())
+	minScore := strconv.FormatInt(windowStart.UnixMilli(), 10)
+
+	pipe := d.data.rdb.TxPipeline()
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(now.UnixMilli()), Member: member})
+	pipe.ZRemRangeByScore(ctx, key, "-inf", "("+minScore)
+	members := pipe.ZRangeByScore(ctx, key, &redis.ZRangeBy{Min: minScore, Max: "+inf"})
+	pipe.Expire(ctx, key, d.window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		d.log.Warnf("failed to update rating window in redis, falling back to SQL: %v", err)
+		return d.windowStatsSQL(ctx, rating.MovieTitle, windowStart)
+	}
+
+	stats := &windowStats{}
+	var sum float64
+	for _, m := range members.Val() {
+		parts := strings.Split(m, "|")
+		if len(parts) != 3 {
+			continue
+		}
+		value, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			continue
+		}
+		stats.Count++
+		sum += value
+	}
+	if stats.Count > 0 {
+		stats.Average = sum / float64(stats.Count)
+	}
+	return stats, nil
+}
+
+// windowStatsSQL computes window stats from rating timestamps
+func (d *ratingAnomalyDetector) windowStatsSQL(ctx context.Context, movieTitle string, windowStart time.Time) (*windowStats, error) {
+	var stats windowStats
+	err := d.data.db.WithContext(ctx).
+		Model(&Rating{}).
+		Select("COALESCE(AVG(rating), 0) as average, COUNT(*) as count").
+		Where("movie_title = ? AND updated_at >= ? AND moderation_status <> ?", movieTitle, windowStart, biz.ModerationHidden).
+		Scan(&stats).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rating window stats: %w", err)
+	}
+	return &stats, nil
+}
+
+// baselineStats computes the aggregate of ratings last changed before the window
+func (d *ratingAnomalyDetector) baselineStats(ctx context.Context, movieTitle string, windowStart time.Time) (*windowStats, error) {
+	var stats windowStats
+	err := d.data.db.WithContext(ctx).
+		Model(&Rating{}).
+		Select("COALESCE(AVG(rating), 0) as average, COUNT(*) as count").
+		Where("movie_title = ? AND updated_at < ? AND moderation_status <> ?", movieTitle, windowStart, biz.ModerationHidden).
+		Scan(&stats).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rating baseline stats: %w", err)
+	}
+	return &stats, nil
+}
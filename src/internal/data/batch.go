@@ -0,0 +1,255 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"src/internal/biz"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func (r *movieRepo) BatchGetMovies(ctx context.Context, lookups []*biz.MovieLookup) error {
+	var titles, ids []string
+	for _, lookup := range lookups {
+		if lookup.ID != "" {
+			ids = append(ids, lookup.ID)
+		} else {
+			titles = append(titles, lookup.Title)
+		}
+	}
+
+	byTitle := r.cachedMovies(ctx, titles)
+	var misses []string
+	for _, title := range titles {
+		if _, ok := byTitle[title]; !ok && !slices.Contains(misses, title) {
+			misses = append(misses, title)
+		}
+	}
+
+	// Cache misses and IDs are resolved from the database and their related
+	// data loaded for all of them at once
+	resolved, err := r.resolveTitles(ctx, misses)
+	if err != nil {
+		return fmt.Errorf("failed to resolve titles: %w", err)
+	}
+	var dbMovies []Movie
+	if len(ids) > 0 {
+		if err := r.data.db.WithContext(ctx).Where("id IN ?", ids).Find(&dbMovies).Error; err != nil {
+			return fmt.Errorf("failed to get movies: %w", err)
+		}
+	}
+
+	var loaded []*biz.Movie
+	var exact []string // Titles to cache, as GetMovieByTitle would
+	for _, title := range misses {
+		res, ok := resolved[title]
+		if !ok || res.err != nil {
+			continue
+		}
+		movie := r.modelToBiz(res.movie)
+		byTitle[title] = movie
+		loaded = append(loaded, movie)
+		if res.exact {
+			exact = append(exact, title)
+		}
+	}
+	byID := make(map[string]*biz.Movie, len(dbMovies))
+	for i := range dbMovies {
+		movie := r.modelToBiz(&dbMovies[i])
+		byID[movie.ID] = movie
+		loaded = append(loaded, movie)
+	}
+	if err := loadTaxonomy(ctx, r.data.db, loaded); err != nil {
+		return err
+	}
+	if err := loadTranslations(ctx, r.data.db, loaded); err != nil {
+		return err
+	}
+	if err := loadImages(ctx, r.data.db, loaded); err != nil {
+		return err
+	}
+	if err := loadExternalIDs(ctx, r.data.db, loaded); err != nil {
+		return err
+	}
+	if err := loadCredits(ctx, r.data.db, loaded); err != nil {
+		return err
+	}
+	if err := loadAlternateTitles(ctx, r.data.db, loaded); err != nil {
+		return err
+	}
+	r.cacheMovies(ctx, exact, byTitle)
+
+	for _, lookup := range lookups {
+		if lookup.ID != "" {
+			lookup.Movie = byID[lookup.ID]
+		} else if res, ok := resolved[lookup.Title]; ok && res.err != nil {
+			lookup.Err = res.err
+			continue
+		} else {
+			lookup.Movie = byTitle[lookup.Title]
+		}
+		if lookup.Movie == nil {
+			lookup.Err = biz.ErrMovieNotFound
+		}
+	}
+	return nil
+}
+
+// cachedMovies reads the cached movies of the titles with a single MGET
+func (r *movieRepo) cachedMovies(ctx context.Context, titles []string) map[string]*biz.Movie {
+	movies := make(map[string]*biz.Movie, len(titles))
+	if r.data.rdb == nil || len(titles) == 0 {
+		return movies
+	}
+
+	keys := make([]string, 0, len(titles))
+	for _, title := range titles {
+		keys = append(keys, fmt.Sprintf("movie:title:%s", title))
+	}
+	values, err := r.data.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		r.log.Warnf("failed to read cached movies: %v", err)
+		recordCacheLookups(cacheMovie, 0, len(titles))
+		return movies
+	}
+	for i, value := range values {
+		cached, ok := value.(string)
+		if !ok {
+			continue
+		}
+		var movie biz.Movie
+		if err := json.Unmarshal([]byte(cached), &movie); err != nil {
+			r.log.Warnf("failed to unmarshal cached movie %s: %v", titles[i], err)
+		} else if movie.Key != "" && movie.Version != 0 { // Entries cached before movies had keys or versions are stale
+			movies[titles[i]] = &movie
+		}
+	}
+	recordCacheLookups(cacheMovie, len(movies), len(titles)-len(movies))
+	return movies
+}
+
+// cacheMovies caches the movies of the titles in one round trip
+func (r *movieRepo) cacheMovies(ctx context.Context, titles []string, movies map[string]*biz.Movie) {
+	if r.data.rdb == nil || len(titles) == 0 {
+		return
+	}
+	_, err := r.data.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, title := range titles {
+			data, err := json.Marshal(movies[title])
+			if err != nil {
+				r.log.Warnf("failed to marshal movie %s: %v", title, err)
+				continue
+			}
+			pipe.Set(ctx, fmt.Sprintf("movie:title:%s", title), data, 15*time.Minute)
+		}
+		return nil
+	})
+	if err != nil {
+		r.log.Warnf("failed to cache movies: %v", err)
+	}
+}
+
+func (r *ratingRepo) BatchGetRatingAggregates(ctx context.Context, movieTitles []string, window biz.RatingWindow) (map[string]*biz.RatingAggregate, error) {
+	aggregates := make(map[string]*biz.RatingAggregate, len(movieTitles))
+	if len(movieTitles) == 0 {
+		return aggregates, nil
+	}
+
+	// Cached aggregates with a single MGET
+	if r.data.rdb != nil {
+		keys := make([]string, 0, len(movieTitles))
+		for _, title := range movieTitles {
+			keys = append(keys, aggregateCacheKey(title, window))
+		}
+		values, err := r.data.rdb.MGet(ctx, keys...).Result()
+		if err != nil {
+			r.log.Warnf("failed to read cached rating aggregates: %v", err)
+		}
+		for i, value := range values {
+			cached, ok := value.(string)
+			if !ok {
+				continue
+			}
+			var agg biz.RatingAggregate
+			if err := json.Unmarshal([]byte(cached), &agg); err == nil {
+				aggregates[movieTitles[i]] = &agg
+			}
+		}
+		recordCacheLookups(cacheRatingAggregate, len(aggregates), len(movieTitles)-len(aggregates))
+	}
+	var misses []string
+	for _, title := range movieTitles {
+		if _, ok := aggregates[title]; !ok {
+			aggregates[title] = &biz.RatingAggregate{}
+			misses = append(misses, title)
+		}
+	}
+	if len(misses) == 0 {
+		return aggregates, nil
+	}
+
+	// The misses with one GROUP BY query, computed as GetRatingAggregate does
+	var results []struct {
+		MovieTitle string
+		Average    float64
+		Count      int32
+	}
+	var err error
+	if window == biz.WindowAll {
+		err = r.data.db.WithContext(ctx).
+			Model(&Rating{}).
+			Select("movie_title, ROUND(AVG(rating)::numeric, 1) as average, COUNT(*) as count").
+			Where("movie_title IN ? AND moderation_status <> ?", misses, biz.ModerationHidden).
+			Group("movie_title").
+			Scan(&results).Error
+	} else {
+		err = r.data.db.WithContext(ctx).
+			Model(&RatingDailyStat{}).
+			Select("movie_title, COALESCE(ROUND((SUM(rating_sum) / NULLIF(SUM(rating_count), 0))::numeric, 1), 0) as average, COALESCE(SUM(rating_count), 0) as count").
+			Where("movie_title IN ? AND day > ?", misses, windowStartDay(window, time.Now())).
+			Group("movie_title").
+			Scan(&results).Error
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rating aggregates: %w", err)
+	}
+	for _, result := range results {
+		aggregates[result.MovieTitle].Average = result.Average
+		aggregates[result.MovieTitle].Count = result.Count
+	}
+
+	// Apply active anomaly reviews
+	var reviews []RatingAnomalyReview
+	err = r.data.db.WithContext(ctx).
+		Where("movie_title IN ? AND expires_at > ?", misses, time.Now().UTC()).
+		Find(&reviews).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get anomaly reviews: %w", err)
+	}
+	for _, review := range reviews {
+		agg := aggregates[review.MovieTitle]
+		agg.UnderReview = true
+		if review.FrozenAverage != nil && window == biz.WindowAll {
+			agg.Average = *review.FrozenAverage
+		}
+	}
+
+	if r.data.rdb != nil {
+		_, err := r.data.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for _, title := range misses {
+				if data, err := json.Marshal(aggregates[title]); err == nil {
+					pipe.Set(ctx, aggregateCacheKey(title, window), data, 15*time.Minute)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			r.log.Warnf("failed to cache rating aggregates: %v", err)
+		}
+	}
+	return aggregates, nil
+}
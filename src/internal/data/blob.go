@@ -0,0 +1,127 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"src/internal/biz"
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Defaults for the local blob store
+const (
+	defaultMediaDir       = "/tmp/media"
+	defaultMediaURLPrefix = "/media/"
+)
+
+// NewBlobStore creates the blob store selected by the media configuration
+func NewBlobStore(c *conf.Media, logger log.Logger) (biz.BlobStore, error) {
+	switch c.GetStorage() {
+	case "", "local":
+		return newLocalBlobStore(c.GetLocal(), logger)
+	case "s3":
+		return newS3BlobStore(c.GetS3(), logger)
+	}
+	return nil, fmt.Errorf("unknown media storage %q, expected local or s3", c.GetStorage())
+}
+
+// localBlobStore keeps files in a directory that the HTTP server serves
+type localBlobStore struct {
+	dir    string
+	prefix string
+	log    *log.Helper
+}
+
+func newLocalBlobStore(c *conf.Media_Local, logger log.Logger) (*localBlobStore, error) {
+	s := &localBlobStore{
+		dir:    c.GetDir(),
+		prefix: c.GetUrlPrefix(),
+		log:    log.NewHelper(logger),
+	}
+	if s.dir == "" {
+		s.dir = defaultMediaDir
+	}
+	if s.prefix == "" {
+		s.prefix = defaultMediaURLPrefix
+	}
+	if !strings.HasSuffix(s.prefix, "/") {
+		s.prefix += "/"
+	}
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create media directory: %w", err)
+	}
+	return s, nil
+}
+
+func (s *localBlobStore) Put(ctx context.Context, key, contentType string, data []byte) (string, error) {
+	if !fs.ValidPath(key) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	path := filepath.Join(s.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	// Write to a temporary file first so readers never see a partial file
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("failed to create blob file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to store blob: %w", err)
+	}
+	return s.prefix + key, nil
+}
+
+func (s *localBlobStore) Delete(ctx context.Context, keys ...string) error {
+	var errs []error
+	for _, key := range keys {
+		if !fs.ValidPath(key) {
+			errs = append(errs, fmt.Errorf("invalid blob key %q", key))
+			continue
+		}
+		err := os.Remove(filepath.Join(s.dir, filepath.FromSlash(key)))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *localBlobStore) URLPrefix() string {
+	return s.prefix
+}
+
+// ServeHTTP serves stored files. Keys are never reused, so files may be
+// cached indefinitely; directories are not listed.
+func (s *localBlobStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, s.prefix)
+	if !fs.ValidPath(key) || key == "." || strings.HasSuffix(key, "/") {
+		http.NotFound(w, r)
+		return
+	}
+	info, err := os.Stat(filepath.Join(s.dir, filepath.FromSlash(key)))
+	if err != nil || info.IsDir() {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeFileFS(w, r, os.DirFS(s.dir), key)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"src/internal/biz"
@@ -34,8 +35,28 @@ func NewBoxOfficeClient(c *conf.BoxOffice, logger log.Logger) biz.BoxOfficeClien
 	}
 }
 
-func (c *boxOfficeClient) GetBoxOffice(ctx context.Context, title string) (*biz.BoxOfficeData, error) {
+// boxOfficeIDParams maps external ID schemes to the provider's query parameters
+var boxOfficeIDParams = map[string]string{
+	biz.SchemeIMDb: "imdbId",
+	biz.SchemeTMDB: "tmdbId",
+	biz.SchemeEIDR: "eidr",
+}
+
+func (c *boxOfficeClient) GetBoxOffice(ctx context.Context, title string, externalIDs map[string]string) (*biz.BoxOfficeData, error) {
 	var lastErr error
+	outcome := "failed" // Unless the API knows no such movie
+
+	// An external ID names the movie unambiguously; the title is the fallback
+	query := url.Values{}
+	for _, scheme := range biz.ExternalIDSchemes {
+		if id, ok := externalIDs[scheme]; ok {
+			query.Set(boxOfficeIDParams[scheme], id)
+			break
+		}
+	}
+	if len(query) == 0 {
+		query.Set("title", title)
+	}
 
 	// Retry logic with exponential backoff
 	for attempt := 0; attempt <= c.maxRetries; attempt++ {
@@ -43,11 +64,15 @@ func (c *boxOfficeClient) GetBoxOffice(ctx context.Context, title string) (*biz.
 			// Exponential backoff
 			backoff := time.Duration(attempt) * 100 * time.Millisecond
 			time.Sleep(backoff)
-			c.log.Infof("retrying box office request for '%s', attempt %d/%d", title, attempt, c.maxRetries)
+			c.log.Infof("retrying box office request for '%s', attempt %d/%d", query.Encode(), attempt, c.maxRetries)
+			boxOfficeRetries.Inc()
 		}
 
-		data, err := c.doRequest(ctx, title)
+		start := time.Now()
+		data, err := c.doRequest(ctx, query)
 		if err == nil {
+			boxOfficeRequestSeconds.WithLabelValues("ok").Observe(time.Since(start).Seconds())
+			boxOfficeLookups.WithLabelValues("found").Inc()
 			return data, nil
 		}
 
@@ -55,19 +80,23 @@ func (c *boxOfficeClient) GetBoxOffice(ctx context.Context, title string) (*biz.
 
 		// Don't retry on 404
 		if err.Error() == "not found" {
+			boxOfficeRequestSeconds.WithLabelValues("not_found").Observe(time.Since(start).Seconds())
+			outcome = "not_found"
 			break
 		}
+		boxOfficeRequestSeconds.WithLabelValues("error").Observe(time.Since(start).Seconds())
 	}
 
 	// Return nil on failure (non-blocking)
+	boxOfficeLookups.WithLabelValues(outcome).Inc()
 	c.log.Warnf("box office request failed after %d attempts: %v", c.maxRetries+1, lastErr)
 	return nil, lastErr
 }
 
-func (c *boxOfficeClient) doRequest(ctx context.Context, title string) (*biz.BoxOfficeData, error) {
-	url := fmt.Sprintf("%s/boxoffice?title=%s", c.baseURL, title)
+func (c *boxOfficeClient) doRequest(ctx context.Context, query url.Values) (*biz.BoxOfficeData, error) {
+	requestURL := fmt.Sprintf("%s/boxoffice?%s", c.baseURL, query.Encode())
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
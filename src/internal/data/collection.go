@@ -0,0 +1,293 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"src/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type collectionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCollectionRepo creates a new collection repository
+func NewCollectionRepo(data *Data, logger log.Logger) biz.CollectionRepo {
+	return &collectionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// collectionRow is a collection with its number of items
+type collectionRow struct {
+	Collection
+	ItemCount int32
+}
+
+// collectionItemCount counts the items of the collection in the current row
+const collectionItemCount = "(SELECT COUNT(*) FROM collection_items WHERE collection_items.collection_id = collections.id) AS item_count"
+
+func (r *collectionRepo) CreateCollection(ctx context.Context, collection *biz.Collection) error {
+	dbCollection := &Collection{
+		ID:          collection.ID,
+		Name:        collection.Name,
+		Description: collection.Description,
+		OwnerID:     collection.OwnerID,
+		Visibility:  string(collection.Visibility),
+	}
+	if err := r.data.db.WithContext(ctx).Create(dbCollection).Error; err != nil {
+		return fmt.Errorf("failed to create collection: %w", err)
+	}
+
+	collection.CreatedAt = dbCollection.CreatedAt
+	collection.UpdatedAt = dbCollection.UpdatedAt
+	return nil
+}
+
+func (r *collectionRepo) GetCollection(ctx context.Context, id string) (*biz.Collection, error) {
+	var dbCollection Collection
+	if err := r.data.db.WithContext(ctx).Where("id = ?", id).First(&dbCollection).Error; err != nil {
+		return nil, fmt.Errorf("collection not found: %w", err)
+	}
+
+	// Soft-deleted movies drop out of the collection
+	var dbItems []CollectionItem
+	err := r.data.db.WithContext(ctx).
+		InnerJoins("Movie").
+		Where("collection_items.collection_id = ?", id).
+		Order("collection_items.position, collection_items.added_at").
+		Find(&dbItems).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to load collection items: %w", err)
+	}
+
+	mr := &movieRepo{data: r.data, log: r.log}
+	collection := collectionToBiz(&dbCollection)
+	collection.Items = make([]*biz.CollectionItem, 0, len(dbItems))
+	movies := make([]*biz.Movie, 0, len(dbItems))
+	for i := range dbItems {
+		item := &dbItems[i]
+		movie := mr.modelToBiz(&item.Movie)
+		movies = append(movies, movie)
+		collection.Items = append(collection.Items, &biz.CollectionItem{
+			Movie:    movie,
+			Position: int32(i),
+			AddedAt:  item.AddedAt,
+		})
+	}
+	collection.ItemCount = int32(len(collection.Items))
+
+	if err := loadTaxonomy(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadTranslations(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadImages(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadExternalIDs(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadCredits(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
+func (r *collectionRepo) UpdateCollection(ctx context.Context, id string, update *biz.CollectionUpdate) (*biz.Collection, error) {
+	updates := map[string]interface{}{}
+	if update.Name != nil {
+		updates["name"] = *update.Name
+	}
+	if update.Description != nil {
+		updates["description"] = *update.Description
+	}
+	if update.Visibility != nil {
+		updates["visibility"] = string(*update.Visibility)
+	}
+
+	if len(updates) > 0 {
+		result := r.data.db.WithContext(ctx).Model(&Collection{}).Where("id = ?", id).Updates(updates)
+		if result.Error != nil {
+			return nil, fmt.Errorf("failed to update collection: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil, fmt.Errorf("collection %s not found", id)
+		}
+	}
+
+	return r.GetCollection(ctx, id)
+}
+
+func (r *collectionRepo) DeleteCollection(ctx context.Context, id string) error {
+	result := r.data.db.WithContext(ctx).Where("id = ?", id).Delete(&Collection{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete collection: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("collection %s not found", id)
+	}
+	return nil
+}
+
+func (r *collectionRepo) ListCollections(ctx context.Context, query *biz.CollectionListQuery) (*biz.CollectionPage, error) {
+	// Decode cursor to get offset
+	offset := 0
+	if query.Cursor != nil && *query.Cursor != "" {
+		var err error
+		offset, err = decodeCursor(*query.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor: %w", err)
+		}
+	}
+
+	db := r.data.db.WithContext(ctx).Model(&Collection{}).Select("collections.*, " + collectionItemCount)
+
+	// Public collections plus the viewer's own private ones
+	if query.ViewerID != "" {
+		db = db.Where("visibility = ? OR owner_id = ?", biz.VisibilityPublic, query.ViewerID)
+	} else {
+		db = db.Where("visibility = ?", biz.VisibilityPublic)
+	}
+	if query.OwnerID != nil && *query.OwnerID != "" {
+		db = db.Where("owner_id = ?", *query.OwnerID)
+	}
+	if query.Q != nil && *query.Q != "" {
+		db = db.Where("name ILIKE ?", fmt.Sprintf("%%%s%%", *query.Q))
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	// Fetch limit+1 to detect if there are more pages
+	var rows []collectionRow
+	if err := db.Order("name, id").Offset(offset).Limit(int(limit + 1)).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+
+	hasMore := len(rows) > int(limit)
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	result := &biz.CollectionPage{
+		Items: make([]*biz.Collection, 0, len(rows)),
+	}
+	for i := range rows {
+		collection := collectionToBiz(&rows[i].Collection)
+		collection.ItemCount = rows[i].ItemCount
+		result.Items = append(result.Items, collection)
+	}
+	if hasMore {
+		nextCursor := encodeCursor(offset + int(limit))
+		result.NextCursor = &nextCursor
+	}
+
+	return result, nil
+}
+
+func (r *collectionRepo) AddItem(ctx context.Context, id, movieID string, position int32) error {
+	return r.changeItems(ctx, id, func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&CollectionItem{}).Where("collection_id = ?", id).Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to count collection items: %w", err)
+		}
+		position = min(position, int32(count))
+
+		// Make room at the position
+		err := tx.Model(&CollectionItem{}).
+			Where("collection_id = ? AND position >= ?", id, position).
+			Update("position", gorm.Expr("position + 1")).Error
+		if err != nil {
+			return fmt.Errorf("failed to shift collection items: %w", err)
+		}
+
+		item := &CollectionItem{CollectionID: id, MovieID: movieID, Position: position}
+		if err := tx.Omit(clause.Associations).Create(item).Error; err != nil {
+			return fmt.Errorf("failed to add collection item: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *collectionRepo) RemoveItem(ctx context.Context, id, movieID string) error {
+	return r.changeItems(ctx, id, func(tx *gorm.DB) error {
+		var removed []CollectionItem
+		err := tx.Clauses(clause.Returning{}).
+			Where("collection_id = ? AND movie_id = ?", id, movieID).
+			Delete(&removed).Error
+		if err != nil {
+			return fmt.Errorf("failed to remove collection item: %w", err)
+		}
+		if len(removed) == 0 {
+			return fmt.Errorf("movie %s is not in collection %s", movieID, id)
+		}
+
+		// Close the gap
+		err = tx.Model(&CollectionItem{}).
+			Where("collection_id = ? AND position > ?", id, removed[0].Position).
+			Update("position", gorm.Expr("position - 1")).Error
+		if err != nil {
+			return fmt.Errorf("failed to shift collection items: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *collectionRepo) ReorderItems(ctx context.Context, id string, movieIDs []string) error {
+	return r.changeItems(ctx, id, func(tx *gorm.DB) error {
+		for i, movieID := range movieIDs {
+			err := tx.Model(&CollectionItem{}).
+				Where("collection_id = ? AND movie_id = ?", id, movieID).
+				Update("position", i).Error
+			if err != nil {
+				return fmt.Errorf("failed to reorder collection items: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// changeItems runs fn in a transaction holding a lock on the collection row,
+// so concurrent item changes keep positions contiguous, and bumps updated_at
+func (r *collectionRepo) changeItems(ctx context.Context, id string, fn func(tx *gorm.DB) error) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var dbCollection Collection
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&dbCollection).Error
+		if err != nil {
+			return fmt.Errorf("collection not found: %w", err)
+		}
+
+		if err := fn(tx); err != nil {
+			return err
+		}
+
+		err = tx.Model(&Collection{}).Where("id = ?", id).Update("updated_at", gorm.Expr("CURRENT_TIMESTAMP")).Error
+		if err != nil {
+			return fmt.Errorf("failed to touch collection: %w", err)
+		}
+		return nil
+	})
+}
+
+// collectionToBiz converts data.Collection to biz.Collection
+func collectionToBiz(m *Collection) *biz.Collection {
+	return &biz.Collection{
+		ID:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		OwnerID:     m.OwnerID,
+		Visibility:  biz.CollectionVisibility(m.Visibility),
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
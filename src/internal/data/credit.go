@@ -0,0 +1,85 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"src/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// creditOrder lists directors, then writers, then actors by billing order
+const creditOrder = "CASE movie_credits.role WHEN 'director' THEN 0 WHEN 'writer' THEN 1 ELSE 2 END, movie_credits.billing_order NULLS LAST, people.name"
+
+// creditRow is a credit joined with the credited person's name
+type creditRow struct {
+	MovieCredit
+	PersonName string
+}
+
+// loadCredits fills in the credits of the given movies with one query
+func loadCredits(ctx context.Context, db *gorm.DB, movies []*biz.Movie) error {
+	if len(movies) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*biz.Movie, len(movies))
+	ids := make([]string, 0, len(movies))
+	for _, m := range movies {
+		byID[m.ID] = m
+		ids = append(ids, m.ID)
+	}
+
+	var rows []creditRow
+	err := db.WithContext(ctx).
+		Table("movie_credits").
+		Select("movie_credits.*, people.name as person_name").
+		Joins("JOIN people ON people.id = movie_credits.person_id").
+		Where("movie_credits.movie_id IN ?", ids).
+		Order(creditOrder).
+		Scan(&rows).Error
+	if err != nil {
+		return fmt.Errorf("failed to load credits: %w", err)
+	}
+
+	for i := range rows {
+		row := &rows[i]
+		movie := byID[row.MovieID]
+		movie.Credits = append(movie.Credits, &biz.Credit{
+			PersonID:      row.PersonID,
+			PersonName:    row.PersonName,
+			Role:          biz.CreditRole(row.Role),
+			CharacterName: row.CharacterName,
+			BillingOrder:  row.BillingOrder,
+		})
+	}
+	return nil
+}
+
+// insertCredits stores the credits of a movie
+func insertCredits(tx *gorm.DB, movieID string, credits []*biz.Credit) error {
+	if len(credits) == 0 {
+		return nil
+	}
+
+	rows := make([]MovieCredit, 0, len(credits))
+	for _, c := range credits {
+		rows = append(rows, MovieCredit{
+			MovieID:       movieID,
+			PersonID:      c.PersonID,
+			Role:          string(c.Role),
+			CharacterName: c.CharacterName,
+			BillingOrder:  c.BillingOrder,
+		})
+	}
+	return tx.Omit("Movie", "Person").Create(&rows).Error
+}
+
+// creditedMovieIDs is a subquery of movies where a person with the name has the role
+func creditedMovieIDs(db *gorm.DB, role biz.CreditRole, name string) *gorm.DB {
+	return db.Table("movie_credits").
+		Select("movie_credits.movie_id").
+		Joins("JOIN people ON people.id = movie_credits.person_id").
+		Where("movie_credits.role = ? AND LOWER(people.name) = LOWER(?)", role, name)
+}
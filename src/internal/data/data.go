@@ -2,12 +2,16 @@ package data
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"src/internal/biz"
 	"src/internal/conf"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -17,7 +21,22 @@ import (
 var ProviderSet = wire.NewSet(
 	NewData,
 	NewMovieRepo,
+	NewPersonRepo,
+	NewCollectionRepo,
+	NewGenreRepo,
 	NewRatingRepo,
+	NewModerationRepo,
+	NewRankingRepo,
+	NewSimilarityRepo,
+	NewRecommendationRepo,
+	NewRecommenderModelStore,
+	NewBlobStore,
+	NewContentScreener,
+	NewRatingAnomalyDetector,
+	NewAlertPublisher,
+	NewMetrics,
+	NewRateLimiter,
+	NewIdempotencyStore,
 	NewBoxOfficeClient,
 )
 
@@ -76,9 +95,11 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 		rdb: rdb,
 		log: l,
 	}
+	unregisterMetrics := registerPoolMetrics(sqlDB, rdb, l)
 
 	cleanup := func() {
 		l.Info("closing data resources")
+		unregisterMetrics()
 		if data.rdb != nil {
 			if err := data.rdb.Close(); err != nil {
 				l.Errorf("failed to close redis: %v", err)
@@ -93,3 +114,33 @@ func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
 
 	return data, cleanup, nil
 }
+
+// pgUniqueViolation is the SQLSTATE of an insert or update breaking a unique
+// constraint
+const pgUniqueViolation = "23505"
+
+// duplicateErrors are the biz errors of the unique constraints a concurrent
+// writer can break after the checks before a write passed
+var duplicateErrors = map[string]error{
+	"uq_movies_title_key":                biz.ErrDuplicateTitle,
+	"uq_movies_title_year":               biz.ErrDuplicateTitle,
+	"uq_movie_external_ids_scheme_value": biz.ErrDuplicateExternalID,
+}
+
+// duplicateError reports a unique violation of a constraint in
+// duplicateErrors as its biz error about subject, and returns other errors
+// unchanged
+func duplicateError(err error, subject string) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != pgUniqueViolation {
+		return err
+	}
+	target, ok := duplicateErrors[pgErr.ConstraintName]
+	if !ok {
+		return err
+	}
+	if subject == "" {
+		return target
+	}
+	return fmt.Errorf("%w: %s", target, subject)
+}
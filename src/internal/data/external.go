@@ -0,0 +1,164 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"src/internal/biz"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (r *movieRepo) GetMovieByExternalID(ctx context.Context, scheme, id string) (*biz.Movie, error) {
+	var dbMovie Movie
+	err := r.data.db.WithContext(ctx).
+		Where("id = (?)", r.data.db.WithContext(ctx).Model(&MovieExternalID{}).Select("movie_id").Where("scheme = ? AND value = ?", scheme, id)).
+		First(&dbMovie).Error
+	if err != nil {
+		return nil, fmt.Errorf("movie not found: %w", err)
+	}
+
+	movie := r.modelToBiz(&dbMovie)
+	movies := []*biz.Movie{movie}
+	if err := loadTaxonomy(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadTranslations(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadImages(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadExternalIDs(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadCredits(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadAlternateTitles(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	return movie, nil
+}
+
+func (r *movieRepo) SetExternalID(ctx context.Context, movie *biz.Movie, scheme, id string) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpVersion(tx, movie); err != nil {
+			return err
+		}
+		return setExternalID(tx, movie.ID, scheme, id)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set external ID: %w", err)
+	}
+
+	invalidateMovieCache(ctx, r.data, movie.Title)
+	return nil
+}
+
+func (r *movieRepo) DeleteExternalID(ctx context.Context, movie *biz.Movie, scheme string) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpVersion(tx, movie); err != nil {
+			return err
+		}
+		result := tx.Where("movie_id = ? AND scheme = ?", movie.ID, scheme).Delete(&MovieExternalID{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: %s", biz.ErrExternalIDNotFound, scheme)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete external ID: %w", err)
+	}
+
+	invalidateMovieCache(ctx, r.data, movie.Title)
+	return nil
+}
+
+// setExternalID sets or replaces the ID of a movie in a scheme
+func setExternalID(tx *gorm.DB, movieID, scheme, id string) error {
+	if err := checkExternalIDFree(tx, movieID, scheme, id); err != nil {
+		return err
+	}
+	err := tx.Omit("Movie").
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "movie_id"}, {Name: "scheme"}},
+			DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at"}),
+		}).
+		Create(&MovieExternalID{MovieID: movieID, Scheme: scheme, Value: id}).Error
+	return duplicateError(err, scheme+" "+id)
+}
+
+// insertExternalIDs stores the external IDs of a new movie
+func insertExternalIDs(tx *gorm.DB, movieID string, ids map[string]string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	rows := make([]MovieExternalID, 0, len(ids))
+	for _, scheme := range biz.ExternalIDSchemes {
+		id, ok := ids[scheme]
+		if !ok {
+			continue
+		}
+		if err := checkExternalIDFree(tx, movieID, scheme, id); err != nil {
+			return err
+		}
+		rows = append(rows, MovieExternalID{MovieID: movieID, Scheme: scheme, Value: id})
+	}
+	return duplicateError(tx.Omit("Movie").Create(&rows).Error, "")
+}
+
+// checkExternalIDFree fails with ErrDuplicateExternalID if a movie other than
+// movieID holds the ID, deleted or not. The unique index still guards against
+// concurrent writers.
+func checkExternalIDFree(tx *gorm.DB, movieID, scheme, id string) error {
+	var holder Movie
+	err := tx.Unscoped().Model(&Movie{}).
+		Select("movies.title, movies.release_date").
+		Joins("JOIN movie_external_ids ON movie_external_ids.movie_id = movies.id").
+		Where("movie_external_ids.scheme = ? AND movie_external_ids.value = ? AND movies.id <> ?", scheme, id, movieID).
+		Take(&holder).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: %s %s is %s", biz.ErrDuplicateExternalID, scheme, id,
+		biz.DisambiguatedTitle(holder.Title, holder.ReleaseDate.Year()))
+}
+
+// loadExternalIDs fills in the external IDs of the given movies
+func loadExternalIDs(ctx context.Context, db *gorm.DB, movies []*biz.Movie) error {
+	if len(movies) == 0 {
+		return nil
+	}
+
+	byID := make(map[string][]*biz.Movie, len(movies))
+	ids := make([]string, 0, len(movies))
+	for _, m := range movies {
+		if _, ok := byID[m.ID]; !ok {
+			ids = append(ids, m.ID)
+		}
+		byID[m.ID] = append(byID[m.ID], m)
+	}
+
+	var rows []MovieExternalID
+	if err := db.WithContext(ctx).Where("movie_id IN ?", ids).Find(&rows).Error; err != nil {
+		return fmt.Errorf("failed to load external IDs: %w", err)
+	}
+	for _, row := range rows {
+		for _, m := range byID[row.MovieID] {
+			if m.ExternalIDs == nil {
+				m.ExternalIDs = make(map[string]string)
+			}
+			m.ExternalIDs[row.Scheme] = row.Value
+		}
+	}
+	return nil
+}
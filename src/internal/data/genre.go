@@ -0,0 +1,286 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"src/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type genreRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewGenreRepo creates a new genre repository
+func NewGenreRepo(data *Data, logger log.Logger) biz.GenreRepo {
+	return &genreRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *genreRepo) ListGenres(ctx context.Context) ([]*biz.Genre, error) {
+	var dbGenres []Genre
+	if err := r.data.db.WithContext(ctx).Order("name").Find(&dbGenres).Error; err != nil {
+		return nil, fmt.Errorf("failed to list genres: %w", err)
+	}
+
+	var aliases []GenreAlias
+	if err := r.data.db.WithContext(ctx).Order("alias").Find(&aliases).Error; err != nil {
+		return nil, fmt.Errorf("failed to list genre aliases: %w", err)
+	}
+
+	genres := make([]*biz.Genre, 0, len(dbGenres))
+	byID := make(map[string]*biz.Genre, len(dbGenres))
+	for i := range dbGenres {
+		genre := genreToBiz(&dbGenres[i])
+		genres = append(genres, genre)
+		byID[genre.ID] = genre
+	}
+	for _, a := range aliases {
+		if genre, ok := byID[a.GenreID]; ok {
+			genre.Aliases = append(genre.Aliases, a.Alias)
+		}
+	}
+	if err := loadGenreTranslations(ctx, r.data.db, genres); err != nil {
+		return nil, err
+	}
+	return genres, nil
+}
+
+func (r *genreRepo) GetGenre(ctx context.Context, id string) (*biz.Genre, error) {
+	var dbGenre Genre
+	if err := r.data.db.WithContext(ctx).Where("id = ?", id).First(&dbGenre).Error; err != nil {
+		return nil, fmt.Errorf("genre not found: %w", err)
+	}
+
+	genre := genreToBiz(&dbGenre)
+	err := r.data.db.WithContext(ctx).
+		Model(&GenreAlias{}).
+		Where("genre_id = ?", id).
+		Order("alias").
+		Pluck("alias", &genre.Aliases).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get genre aliases: %w", err)
+	}
+	if err := loadGenreTranslations(ctx, r.data.db, []*biz.Genre{genre}); err != nil {
+		return nil, err
+	}
+	return genre, nil
+}
+
+func (r *genreRepo) ResolveGenre(ctx context.Context, key string) (*biz.Genre, error) {
+	var alias GenreAlias
+	err := r.data.db.WithContext(ctx).InnerJoins("Genre").Where("genre_aliases.alias = ?", key).First(&alias).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve genre: %w", err)
+	}
+	return genreToBiz(&alias.Genre), nil
+}
+
+func (r *genreRepo) CreateGenre(ctx context.Context, genre *biz.Genre) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&Genre{ID: genre.ID, Name: genre.Name}).Error; err != nil {
+			return err
+		}
+		aliases := make([]GenreAlias, 0, len(genre.Aliases))
+		for _, alias := range genre.Aliases {
+			aliases = append(aliases, GenreAlias{Alias: alias, GenreID: genre.ID})
+		}
+		return tx.Omit("Genre").Create(&aliases).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create genre: %w", err)
+	}
+	return nil
+}
+
+func (r *genreRepo) AddAlias(ctx context.Context, genreID, key string) error {
+	alias := &GenreAlias{Alias: key, GenreID: genreID}
+	if err := r.data.db.WithContext(ctx).Omit("Genre").Create(alias).Error; err != nil {
+		return fmt.Errorf("failed to add genre alias: %w", err)
+	}
+	return nil
+}
+
+// resolveGenres looks up the genre of each name through the aliases. Names
+// matching no alias are registered as new genres so that free-text genres on
+// movie creation keep working; aliases can later be added by an administrator.
+// Names without ASCII letters or digits are rejected, as they would all share
+// the empty key.
+func resolveGenres(tx *gorm.DB, names []string) ([]Genre, error) {
+	genres := make([]Genre, 0, len(names))
+	for _, name := range names {
+		key := biz.GenreKey(name)
+		if key == "" {
+			return nil, fmt.Errorf("%w: %q has no letters or digits", biz.ErrInvalidGenre, name)
+		}
+
+		var alias GenreAlias
+		err := tx.InnerJoins("Genre").Where("genre_aliases.alias = ?", key).First(&alias).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			alias.Genre = Genre{ID: biz.GenreSlug(key), Name: name}
+			err = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&alias.Genre).Error
+			if err == nil {
+				err = tx.Clauses(clause.OnConflict{DoNothing: true}).Omit("Genre").
+					Create(&GenreAlias{Alias: key, GenreID: alias.Genre.ID}).Error
+			}
+			if err == nil {
+				// Read back in case a concurrent insert won
+				err = tx.InnerJoins("Genre").Where("genre_aliases.alias = ?", key).First(&alias).Error
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve genre %q: %w", name, err)
+		}
+		genres = append(genres, alias.Genre)
+	}
+	return genres, nil
+}
+
+// insertGenres resolves and stores the genres of a movie in order and
+// returns their canonical names. Names resolving to the same genre are stored once.
+func insertGenres(tx *gorm.DB, movieID string, names []string) ([]string, error) {
+	genres, err := resolveGenres(tx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]MovieGenre, 0, len(genres))
+	canonical := make([]string, 0, len(genres))
+	seen := make(map[string]bool, len(genres))
+	for _, g := range genres {
+		if seen[g.ID] {
+			continue
+		}
+		seen[g.ID] = true
+		rows = append(rows, MovieGenre{MovieID: movieID, GenreID: g.ID, Position: int32(len(rows))})
+		canonical = append(canonical, g.Name)
+	}
+	if len(rows) == 0 {
+		return canonical, nil
+	}
+	if err := tx.Omit("Movie", "Genre").Create(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to store movie genres: %w", err)
+	}
+	return canonical, nil
+}
+
+// insertTags stores the tags of a movie
+func insertTags(tx *gorm.DB, movieID string, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	rows := make([]MovieTag, 0, len(tags))
+	for _, tag := range tags {
+		rows = append(rows, MovieTag{MovieID: movieID, Tag: tag})
+	}
+	if err := tx.Omit("Movie").Create(&rows).Error; err != nil {
+		return fmt.Errorf("failed to store movie tags: %w", err)
+	}
+	return nil
+}
+
+// loadTaxonomy fills in the genres and tags of the given movies.
+// A movie may appear several times, e.g. in a person's credits.
+func loadTaxonomy(ctx context.Context, db *gorm.DB, movies []*biz.Movie) error {
+	if len(movies) == 0 {
+		return nil
+	}
+
+	byID := make(map[string][]*biz.Movie, len(movies))
+	ids := make([]string, 0, len(movies))
+	for _, m := range movies {
+		if _, ok := byID[m.ID]; !ok {
+			ids = append(ids, m.ID)
+		}
+		byID[m.ID] = append(byID[m.ID], m)
+	}
+
+	var genres []struct {
+		MovieID string
+		Name    string
+	}
+	err := db.WithContext(ctx).
+		Table("movie_genres").
+		Select("movie_genres.movie_id, genres.name").
+		Joins("JOIN genres ON genres.id = movie_genres.genre_id").
+		Where("movie_genres.movie_id IN ?", ids).
+		Order("movie_genres.position, genres.name").
+		Scan(&genres).Error
+	if err != nil {
+		return fmt.Errorf("failed to load genres: %w", err)
+	}
+	for _, g := range genres {
+		for _, m := range byID[g.MovieID] {
+			m.Genres = append(m.Genres, g.Name)
+		}
+	}
+
+	var tags []MovieTag
+	if err := db.WithContext(ctx).Where("movie_id IN ?", ids).Order("tag").Find(&tags).Error; err != nil {
+		return fmt.Errorf("failed to load tags: %w", err)
+	}
+	for _, t := range tags {
+		for _, m := range byID[t.MovieID] {
+			m.Tags = append(m.Tags, t.Tag)
+		}
+	}
+	return nil
+}
+
+// genreMovieIDs is a subquery of movies assigned the genre, matched through its aliases
+func genreMovieIDs(db *gorm.DB, genre string) *gorm.DB {
+	return db.Table("movie_genres").
+		Select("movie_genres.movie_id").
+		Joins("JOIN genre_aliases ON genre_aliases.genre_id = movie_genres.genre_id").
+		Where("genre_aliases.alias = ?", biz.GenreKey(genre))
+}
+
+// primaryGenreMovieIDs is a subquery of movies whose primary genre is the
+// genre, matched through its aliases
+func primaryGenreMovieIDs(db *gorm.DB, genre string) *gorm.DB {
+	return genreMovieIDs(db, genre).Where("movie_genres.position = 0")
+}
+
+// genreID returns the ID of the genre a name or alias belongs to. A name
+// matching no alias gets the ID a genre of that name would have, which no
+// movie has.
+func genreID(ctx context.Context, db *gorm.DB, name string) (string, error) {
+	key := biz.GenreKey(name)
+	var ids []string
+	err := db.WithContext(ctx).Model(&GenreAlias{}).Where("alias = ?", key).Limit(1).Pluck("genre_id", &ids).Error
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve genre: %w", err)
+	}
+	if len(ids) == 0 {
+		return biz.GenreSlug(key), nil
+	}
+	return ids[0], nil
+}
+
+// taggedMovieIDs is a subquery of movies with the tag
+func taggedMovieIDs(db *gorm.DB, tag string) *gorm.DB {
+	return db.Table("movie_tags").
+		Select("movie_id").
+		Where("tag = ?", strings.ToLower(strings.Join(strings.Fields(tag), " ")))
+}
+
+// genreToBiz converts data.Genre to biz.Genre
+func genreToBiz(m *Genre) *biz.Genre {
+	return &biz.Genre{
+		ID:   m.ID,
+		Name: m.Name,
+	}
+}
@@ -0,0 +1,169 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"src/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// idempotencyClaimAttempts bounds the retries of a claim racing the expiry
+// of the record it found
+const idempotencyClaimAttempts = 3
+
+type idempotencyStore struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewIdempotencyStore creates the store of idempotent responses. Records live
+// in Redis with their TTL, or in the idempotency_keys table when Redis is not
+// configured.
+func NewIdempotencyStore(data *Data, logger log.Logger) biz.IdempotencyStore {
+	return &idempotencyStore{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// idempotencyEntry is a record as stored in Redis
+type idempotencyEntry struct {
+	RequestHash string `json:"request_hash"`
+	Response    []byte `json:"response,omitempty"`
+}
+
+func idempotencyCacheKey(principal, key string) string {
+	return fmt.Sprintf("idempotency:%s:%s", principal, key)
+}
+
+func (s *idempotencyStore) Claim(ctx context.Context, rec *biz.IdempotencyRecord, ttl time.Duration) (*biz.IdempotencyRecord, error) {
+	for range idempotencyClaimAttempts {
+		var existing *biz.IdempotencyRecord
+		var err error
+		if s.data.rdb != nil {
+			existing, err = s.claimRedis(ctx, rec, ttl)
+		} else {
+			existing, err = s.claimSQL(ctx, rec, ttl)
+		}
+		if !errors.Is(err, errIdempotencyRecordGone) {
+			return existing, err
+		}
+	}
+	return nil, fmt.Errorf("failed to claim idempotency key %q: %w", rec.Key, errIdempotencyRecordGone)
+}
+
+// errIdempotencyRecordGone means the record blocking a claim expired before
+// it could be read, so the claim is tried again
+var errIdempotencyRecordGone = errors.New("idempotency record expired during claim")
+
+func (s *idempotencyStore) claimRedis(ctx context.Context, rec *biz.IdempotencyRecord, ttl time.Duration) (*biz.IdempotencyRecord, error) {
+	key := idempotencyCacheKey(rec.Principal, rec.Key)
+	data, err := json.Marshal(&idempotencyEntry{RequestHash: rec.RequestHash})
+	if err != nil {
+		return nil, err
+	}
+	claimed, err := s.data.rdb.SetNX(ctx, key, data, ttl).Result()
+	if err != nil {
+		return nil, err
+	}
+	if claimed {
+		return nil, nil
+	}
+
+	stored, err := s.data.rdb.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, errIdempotencyRecordGone
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entry idempotencyEntry
+	if err := json.Unmarshal(stored, &entry); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal idempotency record: %w", err)
+	}
+	return &biz.IdempotencyRecord{Principal: rec.Principal, Key: rec.Key, RequestHash: entry.RequestHash, Response: entry.Response}, nil
+}
+
+func (s *idempotencyStore) claimSQL(ctx context.Context, rec *biz.IdempotencyRecord, ttl time.Duration) (*biz.IdempotencyRecord, error) {
+	now := time.Now().UTC()
+	// Insert, or take over an expired record
+	result := s.data.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "principal"}, {Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"request_hash", "response", "expires_at", "created_at"}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: "idempotency_keys.expires_at <= ?", Vars: []interface{}{now}},
+		}},
+	}).Create(&IdempotencyKey{
+		Principal:   rec.Principal,
+		Key:         rec.Key,
+		RequestHash: rec.RequestHash,
+		ExpiresAt:   now.Add(ttl),
+	})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil, nil
+	}
+
+	var existing IdempotencyKey
+	err := s.data.db.WithContext(ctx).
+		Where("principal = ? AND key = ? AND expires_at > ?", rec.Principal, rec.Key, now).
+		Take(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errIdempotencyRecordGone
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &biz.IdempotencyRecord{Principal: rec.Principal, Key: rec.Key, RequestHash: existing.RequestHash, Response: existing.Response}, nil
+}
+
+func (s *idempotencyStore) Complete(ctx context.Context, rec *biz.IdempotencyRecord, ttl time.Duration) error {
+	if s.data.rdb != nil {
+		data, err := json.Marshal(&idempotencyEntry{RequestHash: rec.RequestHash, Response: rec.Response})
+		if err != nil {
+			return err
+		}
+		return s.data.rdb.Set(ctx, idempotencyCacheKey(rec.Principal, rec.Key), data, ttl).Err()
+	}
+
+	return s.data.db.WithContext(ctx).
+		Model(&IdempotencyKey{}).
+		Where("principal = ? AND key = ?", rec.Principal, rec.Key).
+		Updates(map[string]interface{}{
+			"response":   rec.Response,
+			"expires_at": time.Now().UTC().Add(ttl),
+		}).Error
+}
+
+func (s *idempotencyStore) Release(ctx context.Context, principal, key string) error {
+	if s.data.rdb != nil {
+		return s.data.rdb.Del(ctx, idempotencyCacheKey(principal, key)).Err()
+	}
+	return s.data.db.WithContext(ctx).
+		Where("principal = ? AND key = ? AND response IS NULL", principal, key).
+		Delete(&IdempotencyKey{}).Error
+}
+
+func (s *idempotencyStore) PurgeExpired(ctx context.Context) error {
+	// Redis expires records itself; rows may remain from before it was configured
+	result := s.data.db.WithContext(ctx).
+		Where("expires_at <= ?", time.Now().UTC()).
+		Delete(&IdempotencyKey{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to purge idempotency keys: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		s.log.Infof("purged %d expired idempotency keys", result.RowsAffected)
+	}
+	return nil
+}
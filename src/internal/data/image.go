@@ -0,0 +1,167 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"src/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+func (r *movieRepo) GetMovieByID(ctx context.Context, id string) (*biz.Movie, error) {
+	var dbMovie Movie
+	if err := r.data.db.WithContext(ctx).Where("id = ?", id).First(&dbMovie).Error; err != nil {
+		return nil, fmt.Errorf("movie not found: %w", err)
+	}
+	return r.modelToBiz(&dbMovie), nil
+}
+
+func (r *movieRepo) AddImage(ctx context.Context, movie *biz.Movie, image *biz.MovieImage) error {
+	row := &MovieImage{
+		ID:          image.ID,
+		MovieID:     movie.ID,
+		Kind:        string(image.Kind),
+		ContentType: image.ContentType,
+		Width:       image.Width,
+		Height:      image.Height,
+		SizeBytes:   image.SizeBytes,
+		BlobKey:     image.Key,
+		URL:         image.URL,
+	}
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpVersion(tx, movie); err != nil {
+			return err
+		}
+		if err := tx.Omit("Movie").Create(row).Error; err != nil {
+			return err
+		}
+		if len(image.Thumbnails) == 0 {
+			return nil
+		}
+		thumbs := make([]MovieImageThumbnail, 0, len(image.Thumbnails))
+		for _, t := range image.Thumbnails {
+			thumbs = append(thumbs, MovieImageThumbnail{
+				ImageID: image.ID,
+				Width:   t.Width,
+				Height:  t.Height,
+				BlobKey: t.Key,
+				URL:     t.URL,
+			})
+		}
+		return tx.Omit("Image").Create(&thumbs).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add image: %w", err)
+	}
+	image.CreatedAt = row.CreatedAt
+
+	invalidateMovieCache(ctx, r.data, movie.Title)
+	return nil
+}
+
+func (r *movieRepo) DeleteImage(ctx context.Context, movie *biz.Movie, imageID string) (*biz.MovieImage, error) {
+	var image *biz.MovieImage
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpVersion(tx, movie); err != nil {
+			return err
+		}
+		var row MovieImage
+		err := tx.Where("id = ? AND movie_id = ?", imageID, movie.ID).First(&row).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: %s", biz.ErrImageNotFound, imageID)
+		}
+		if err != nil {
+			return err
+		}
+		var thumbs []MovieImageThumbnail
+		if err := tx.Where("image_id = ?", imageID).Order("width").Find(&thumbs).Error; err != nil {
+			return err
+		}
+		image = imageToBiz(&row, thumbs)
+
+		// Thumbnails go with the image (ON DELETE CASCADE)
+		return tx.Delete(&row).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete image: %w", err)
+	}
+
+	invalidateMovieCache(ctx, r.data, movie.Title)
+	return image, nil
+}
+
+// loadImages fills in the images of the given movies with their thumbnails
+func loadImages(ctx context.Context, db *gorm.DB, movies []*biz.Movie) error {
+	if len(movies) == 0 {
+		return nil
+	}
+
+	byID := make(map[string][]*biz.Movie, len(movies))
+	ids := make([]string, 0, len(movies))
+	for _, m := range movies {
+		if _, ok := byID[m.ID]; !ok {
+			ids = append(ids, m.ID)
+		}
+		byID[m.ID] = append(byID[m.ID], m)
+	}
+
+	var rows []MovieImage
+	err := db.WithContext(ctx).
+		Where("movie_id IN ?", ids).
+		Order("CASE kind WHEN 'poster' THEN 0 ELSE 1 END, created_at, id").
+		Find(&rows).Error
+	if err != nil {
+		return fmt.Errorf("failed to load images: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	imageIDs := make([]string, 0, len(rows))
+	for i := range rows {
+		imageIDs = append(imageIDs, rows[i].ID)
+	}
+	var thumbs []MovieImageThumbnail
+	if err := db.WithContext(ctx).Where("image_id IN ?", imageIDs).Order("width").Find(&thumbs).Error; err != nil {
+		return fmt.Errorf("failed to load thumbnails: %w", err)
+	}
+	thumbsByImage := make(map[string][]MovieImageThumbnail, len(rows))
+	for _, t := range thumbs {
+		thumbsByImage[t.ImageID] = append(thumbsByImage[t.ImageID], t)
+	}
+
+	for i := range rows {
+		row := &rows[i]
+		image := imageToBiz(row, thumbsByImage[row.ID])
+		for _, m := range byID[row.MovieID] {
+			m.Images = append(m.Images, image)
+		}
+	}
+	return nil
+}
+
+// imageToBiz converts data.MovieImage and its thumbnails to biz.MovieImage
+func imageToBiz(m *MovieImage, thumbs []MovieImageThumbnail) *biz.MovieImage {
+	image := &biz.MovieImage{
+		ID:          m.ID,
+		Kind:        biz.ImageKind(m.Kind),
+		ContentType: m.ContentType,
+		Width:       m.Width,
+		Height:      m.Height,
+		SizeBytes:   m.SizeBytes,
+		Key:         m.BlobKey,
+		URL:         m.URL,
+		CreatedAt:   m.CreatedAt,
+	}
+	for _, t := range thumbs {
+		image.Thumbnails = append(image.Thumbnails, &biz.Thumbnail{
+			Width:  t.Width,
+			Height: t.Height,
+			Key:    t.BlobKey,
+			URL:    t.URL,
+		})
+	}
+	return image
+}
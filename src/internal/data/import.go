@@ -0,0 +1,188 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"src/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// errImportDryRun rolls back the transaction of a dry-run batch
+var errImportDryRun = errors.New("dry run")
+
+func (r *movieRepo) MatchMovies(ctx context.Context, movies []*biz.Movie) ([]string, error) {
+	ids := make([]string, len(movies))
+	if len(movies) == 0 {
+		return ids, nil
+	}
+
+	titles := make([]string, 0, len(movies))
+	for _, m := range movies {
+		titles = append(titles, m.Title)
+	}
+	var rows []Movie
+	if err := r.data.db.WithContext(ctx).Select("id", "title", "release_date").Where("title IN ?", titles).Order("id").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to match movies: %w", err)
+	}
+
+	existing := make(map[string]string, len(rows))
+	for _, row := range rows {
+		key := biz.DisambiguatedTitle(row.Title, row.ReleaseDate.Year())
+		if _, ok := existing[key]; !ok {
+			existing[key] = row.ID
+		}
+	}
+	for i, m := range movies {
+		ids[i] = existing[biz.DisambiguatedTitle(m.Title, m.ReleaseDate.Year())]
+	}
+	return ids, nil
+}
+
+func (r *movieRepo) ImportMovies(ctx context.Context, movies []*biz.Movie, mode biz.ImportMode, dryRun bool) ([]*biz.ImportOutcome, error) {
+	outcomes := make([]*biz.ImportOutcome, len(movies))
+	var oldMovies, newMovies []*Movie // Updated movies, whose leaderboard entries may move
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, movie := range movies {
+			outcome := &biz.ImportOutcome{}
+			outcomes[i] = outcome
+
+			// Each movie gets a savepoint so a failing one does not abort the batch
+			err := tx.Transaction(func(tx *gorm.DB) error {
+				var existing Movie
+				err := tx.Where("title = ? AND EXTRACT(YEAR FROM release_date) = ?", movie.Title, movie.ReleaseDate.Year()).
+					Order("id").
+					First(&existing).Error
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					outcome.Status, outcome.MovieID = biz.ImportCreated, movie.ID
+					return insertMovie(tx, r.bizToModel(movie), movie)
+				}
+				if err != nil {
+					return err
+				}
+
+				outcome.MovieID = existing.ID
+				if mode == biz.ImportSkipExisting {
+					outcome.Status = biz.ImportSkipped
+					return nil
+				}
+				outcome.Status = biz.ImportUpdated
+				old := existing
+				updated, err := r.updateImportedMovie(tx, &existing, movie)
+				if err != nil {
+					return err
+				}
+				oldMovies, newMovies = append(oldMovies, &old), append(newMovies, updated)
+				return nil
+			})
+			if err != nil {
+				outcome.Status, outcome.MovieID, outcome.Err = biz.ImportFailed, "", err
+			}
+		}
+		if dryRun {
+			return errImportDryRun
+		}
+		return nil
+	})
+	if dryRun && errors.Is(err, errImportDryRun) {
+		// IDs of movies that would have been created do not exist
+		for _, outcome := range outcomes {
+			if outcome.Status == biz.ImportCreated {
+				outcome.MovieID = ""
+			}
+		}
+		return outcomes, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to import movies: %w", err)
+	}
+
+	titles := make([]string, 0, len(movies))
+	for i, movie := range movies {
+		if outcomes[i].Status == biz.ImportCreated || outcomes[i].Status == biz.ImportUpdated {
+			titles = append(titles, movie.Title)
+		}
+	}
+	invalidateMovieCache(ctx, r.data, titles...)
+
+	if r.data.rdb != nil {
+		for i := range oldMovies {
+			if err := moveRankingSegments(ctx, r.data.rdb, oldMovies[i], newMovies[i]); err != nil {
+				r.log.Warnf("failed to move ranking segments for movie %s: %v", oldMovies[i].Title, err)
+			}
+		}
+	}
+	return outcomes, nil
+}
+
+// updateImportedMovie updates an existing movie from an import record: the
+// release date, genres and tags are replaced, and the other fields, external
+// IDs and credits the record has are set. Returns the updated row.
+func (r *movieRepo) updateImportedMovie(tx *gorm.DB, existing *Movie, movie *biz.Movie) (*Movie, error) {
+	// Fails the row if the movie changed since it was matched
+	version, err := bumpMovieVersion(tx, existing.ID, existing.Version)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Where("movie_id = ?", existing.ID).Delete(&MovieGenre{}).Error; err != nil {
+		return nil, err
+	}
+	genres, err := insertGenres(tx, existing.ID, movie.Genres)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Where("movie_id = ?", existing.ID).Delete(&MovieTag{}).Error; err != nil {
+		return nil, err
+	}
+	if err := insertTags(tx, existing.ID, movie.Tags); err != nil {
+		return nil, err
+	}
+	for _, scheme := range biz.ExternalIDSchemes {
+		if id, ok := movie.ExternalIDs[scheme]; ok {
+			if err := setExternalID(tx, existing.ID, scheme, id); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(movie.Credits) > 0 {
+		if err := tx.Where("movie_id = ?", existing.ID).Delete(&MovieCredit{}).Error; err != nil {
+			return nil, err
+		}
+		if err := insertCredits(tx, existing.ID, movie.Credits); err != nil {
+			return nil, err
+		}
+	}
+
+	// The primary genre column follows the first genre, as in SetGenres
+	updated := *existing
+	updated.Version = version
+	updated.ReleaseDate = movie.ReleaseDate
+	updated.Genre = genres[0]
+	if movie.Distributor != nil {
+		updated.Distributor = movie.Distributor
+	}
+	if movie.Budget != nil {
+		updated.Budget = movie.Budget
+	}
+	if movie.MPARating != nil {
+		updated.MPARating = movie.MPARating
+	}
+	if movie.BoxOffice != nil {
+		boxOffice := r.bizToModel(movie)
+		updated.BoxOfficeWorldwide = boxOffice.BoxOfficeWorldwide
+		updated.BoxOfficeOpeningUSA = boxOffice.BoxOfficeOpeningUSA
+		updated.BoxOfficeCurrency = boxOffice.BoxOfficeCurrency
+		updated.BoxOfficeSource = boxOffice.BoxOfficeSource
+		updated.BoxOfficeLastUpdated = boxOffice.BoxOfficeLastUpdated
+	}
+	err = tx.Model(existing).
+		Select("release_date", "genre", "distributor", "budget", "mpa_rating",
+			"box_office_worldwide", "box_office_opening_usa", "box_office_currency", "box_office_source", "box_office_last_updated").
+		Updates(&updated).Error
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
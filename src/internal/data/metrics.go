@@ -0,0 +1,137 @@
+package data
+
+import (
+	"database/sql"
+
+	"src/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/redis/go-redis/v9"
+)
+
+// Caches counted by cache_lookups_total
+const (
+	cacheMovie           = "movie"            // movie:title:*
+	cacheRatingAggregate = "rating_aggregate" // rating:agg:* and rating:agg_<window>:*
+)
+
+var (
+	cacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_lookups_total",
+		Help: "Redis cache lookups, by cache and result (hit or miss). Unusable entries count as misses.",
+	}, []string{"cache", "result"})
+
+	boxOfficeRequestSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "box_office_request_duration_seconds",
+		Help:    "Time taken by requests to the box-office API, by result (ok, not_found or error).",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"result"})
+
+	boxOfficeRetries = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "box_office_retries_total",
+		Help: "Box-office API requests retried after a failed attempt.",
+	})
+
+	boxOfficeLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "box_office_lookups_total",
+		Help: "Box-office lookups after any retries, by outcome (found, not_found or failed).",
+	}, []string{"outcome"})
+
+	moviesCreated = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "movies_created_total",
+		Help: "Movies created, by source (api or import). Dry runs are not counted.",
+	}, []string{"source"})
+
+	ratingsSubmitted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ratings_submitted_total",
+		Help: "Ratings submitted, by the moderation status they were stored with (none, pending, or hidden for a resubmitted hidden review).",
+	}, []string{"moderation_status"})
+)
+
+// businessMetrics records the business events of the biz layer in the
+// counters above
+type businessMetrics struct{}
+
+// NewMetrics creates the recorder of business events
+func NewMetrics() biz.Metrics {
+	return businessMetrics{}
+}
+
+func (businessMetrics) MovieCreated(source string) {
+	moviesCreated.WithLabelValues(source).Inc()
+}
+
+func (businessMetrics) RatingSubmitted(status biz.ModerationStatus) {
+	ratingsSubmitted.WithLabelValues(string(status)).Inc()
+}
+
+// recordCacheLookups counts the hits and misses of lookups in a cache
+func recordCacheLookups(cache string, hits, misses int) {
+	if hits > 0 {
+		cacheLookups.WithLabelValues(cache, "hit").Add(float64(hits))
+	}
+	if misses > 0 {
+		cacheLookups.WithLabelValues(cache, "miss").Add(float64(misses))
+	}
+}
+
+// registerPoolMetrics exports the statistics of the database connection pool
+// and, if Redis is configured, its pool. The returned func unregisters them.
+func registerPoolMetrics(sqlDB *sql.DB, rdb *redis.Client, l *log.Helper) func() {
+	cs := []prometheus.Collector{collectors.NewDBStatsCollector(sqlDB, "postgres")}
+	if rdb != nil {
+		cs = append(cs, &redisPoolCollector{rdb: rdb})
+	}
+	registered := make([]prometheus.Collector, 0, len(cs))
+	for _, c := range cs {
+		if err := prometheus.Register(c); err != nil {
+			l.Warnf("failed to register pool metrics: %v", err)
+			continue
+		}
+		registered = append(registered, c)
+	}
+	return func() {
+		for _, c := range registered {
+			prometheus.Unregister(c)
+		}
+	}
+}
+
+var (
+	redisPoolHitsDesc = prometheus.NewDesc("redis_pool_hits_total",
+		"Times a free connection was found in the Redis pool.", nil, nil)
+	redisPoolMissesDesc = prometheus.NewDesc("redis_pool_misses_total",
+		"Times a free connection was not found in the Redis pool.", nil, nil)
+	redisPoolTimeoutsDesc = prometheus.NewDesc("redis_pool_timeouts_total",
+		"Times waiting for a Redis connection timed out.", nil, nil)
+	redisPoolConnsDesc = prometheus.NewDesc("redis_pool_connections",
+		"Connections in the Redis pool, by state (idle or in_use).", []string{"state"}, nil)
+	redisPoolStaleDesc = prometheus.NewDesc("redis_pool_stale_connections_total",
+		"Stale connections removed from the Redis pool.", nil, nil)
+)
+
+// redisPoolCollector exports the pool statistics of a Redis client
+type redisPoolCollector struct {
+	rdb *redis.Client
+}
+
+func (c *redisPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- redisPoolHitsDesc
+	ch <- redisPoolMissesDesc
+	ch <- redisPoolTimeoutsDesc
+	ch <- redisPoolConnsDesc
+	ch <- redisPoolStaleDesc
+}
+
+func (c *redisPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.rdb.PoolStats()
+	ch <- prometheus.MustNewConstMetric(redisPoolHitsDesc, prometheus.CounterValue, float64(stats.Hits))
+	ch <- prometheus.MustNewConstMetric(redisPoolMissesDesc, prometheus.CounterValue, float64(stats.Misses))
+	ch <- prometheus.MustNewConstMetric(redisPoolTimeoutsDesc, prometheus.CounterValue, float64(stats.Timeouts))
+	ch <- prometheus.MustNewConstMetric(redisPoolConnsDesc, prometheus.GaugeValue, float64(stats.IdleConns), "idle")
+	ch <- prometheus.MustNewConstMetric(redisPoolConnsDesc, prometheus.GaugeValue, float64(stats.TotalConns-stats.IdleConns), "in_use")
+	ch <- prometheus.MustNewConstMetric(redisPoolStaleDesc, prometheus.CounterValue, float64(stats.StaleConns))
+}
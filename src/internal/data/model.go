@@ -9,7 +9,7 @@ import (
 // Movie represents the movies table
 type Movie struct {
 	ID          string    `gorm:"primaryKey;size:64"`
-	Title       string    `gorm:"uniqueIndex;not null;size:255"`
+	Title       string    `gorm:"not null;size:255;index:idx_movies_title"`
 	ReleaseDate time.Time `gorm:"not null;type:date"`
 	Genre       string    `gorm:"not null;size:100;index:idx_movies_genre,expression:LOWER(genre)"`
 	Distributor *string   `gorm:"size:255;index:idx_movies_distributor,expression:LOWER(distributor)"`
@@ -23,6 +23,12 @@ type Movie struct {
 	BoxOfficeSource      *string    `gorm:"column:box_office_source;size:100"`
 	BoxOfficeLastUpdated *time.Time `gorm:"column:box_office_last_updated;type:timestamptz"`
 
+	// Stable key that other tables reference the movie by (see biz.Movie.Key)
+	TitleKey string `gorm:"column:title_key;not null;size:255;uniqueIndex:uq_movies_title_key"`
+
+	// Advanced by every write to the movie or its related rows (see bumpMovieVersion)
+	Version int64 `gorm:"not null;default:1"`
+
 	CreatedAt time.Time      `gorm:"autoCreateTime"`
 	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
 	DeletedAt gorm.DeletedAt `gorm:"index"`
@@ -39,11 +45,19 @@ type Rating struct {
 	MovieTitle string    `gorm:"not null;size:255;uniqueIndex:uq_rating_movie_rater;index:idx_ratings_movie_title"`
 	RaterID    string    `gorm:"not null;size:100;uniqueIndex:uq_rating_movie_rater"`
 	Rating     float64   `gorm:"not null;type:decimal(2,1);check:rating >= 0.5 AND rating <= 5.0 AND MOD(rating * 10, 5) = 0"`
+	Review     *string   `gorm:"type:text"`
+	RatedAt    time.Time `gorm:"not null;type:timestamptz"`
 	CreatedAt  time.Time `gorm:"autoCreateTime"`
 	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
 
+	// Moderation fields
+	ModerationStatus string  `gorm:"column:moderation_status;not null;size:16;default:none"`
+	ModerationReason *string `gorm:"column:moderation_reason;size:255"`
+
+	Version int64 `gorm:"not null;default:1"`
+
 	// Foreign key
-	Movie Movie `gorm:"foreignKey:MovieTitle;references:Title;constraint:OnDelete:CASCADE"`
+	Movie Movie `gorm:"foreignKey:MovieTitle;references:TitleKey;constraint:OnDelete:CASCADE"`
 }
 
 // TableName overrides the table name
@@ -51,6 +65,335 @@ func (Rating) TableName() string {
 	return "ratings"
 }
 
+// RatingReport represents the rating_reports table
+type RatingReport struct {
+	ID         uint      `gorm:"primaryKey"`
+	RatingID   uint      `gorm:"not null;uniqueIndex:uq_rating_report_reporter"`
+	ReporterID string    `gorm:"not null;size:100;uniqueIndex:uq_rating_report_reporter"`
+	Reason     *string   `gorm:"size:500"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+
+	// Foreign key
+	Rating Rating `gorm:"foreignKey:RatingID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName overrides the table name
+func (RatingReport) TableName() string {
+	return "rating_reports"
+}
+
+// RatingEvent represents the append-only rating_events table
+type RatingEvent struct {
+	ID         int64     `gorm:"primaryKey"`
+	RatingID   uint      `gorm:"not null"`
+	MovieTitle string    `gorm:"not null;size:255;index:idx_rating_events_movie_title"`
+	RaterID    string    `gorm:"not null;size:100;index:idx_rating_events_rater_id"`
+	EventType  string    `gorm:"not null;size:16"`
+	OldRating  *float64  `gorm:"type:decimal(2,1)"`
+	NewRating  float64   `gorm:"not null;type:decimal(2,1)"`
+	ClientIP   *string   `gorm:"column:client_ip;size:64"`
+	UserAgent  *string   `gorm:"size:512"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName overrides the table name
+func (RatingEvent) TableName() string {
+	return "rating_events"
+}
+
+// RatingAnomalyReview represents the rating_anomaly_reviews table
+type RatingAnomalyReview struct {
+	MovieTitle    string    `gorm:"primaryKey;size:255"`
+	Reason        string    `gorm:"not null;size:255"`
+	FrozenAverage *float64  `gorm:"type:decimal(2,1)"`
+	StartedAt     time.Time `gorm:"not null;type:timestamptz"`
+	ExpiresAt     time.Time `gorm:"not null;type:timestamptz"`
+
+	// Foreign key
+	Movie Movie `gorm:"foreignKey:MovieTitle;references:TitleKey;constraint:OnDelete:CASCADE"`
+}
+
+// TableName overrides the table name
+func (RatingAnomalyReview) TableName() string {
+	return "rating_anomaly_reviews"
+}
+
+// RatingDailyStat represents the rating_daily_stats rollup table.
+// Rows are maintained by a database trigger on ratings.
+type RatingDailyStat struct {
+	MovieTitle  string    `gorm:"primaryKey;size:255"`
+	Day         time.Time `gorm:"primaryKey;type:date;index:idx_rating_daily_stats_day"`
+	RatingSum   float64   `gorm:"not null;type:decimal(12,1)"`
+	RatingCount int32     `gorm:"not null"`
+}
+
+// TableName overrides the table name
+func (RatingDailyStat) TableName() string {
+	return "rating_daily_stats"
+}
+
+// MovieSimilarity represents the movie_similarities table.
+// Rows are replaced wholesale by the similarity job.
+type MovieSimilarity struct {
+	MovieTitle   string    `gorm:"primaryKey;size:255;index:idx_movie_similarities_rank,priority:1"`
+	SimilarTitle string    `gorm:"primaryKey;size:255"`
+	Similarity   float64   `gorm:"not null;index:idx_movie_similarities_rank,priority:2,sort:desc"`
+	CoRaters     int32     `gorm:"not null"`
+	ComputedAt   time.Time `gorm:"not null;type:timestamptz"`
+}
+
+// TableName overrides the table name
+func (MovieSimilarity) TableName() string {
+	return "movie_similarities"
+}
+
+// Person represents the people table
+type Person struct {
+	ID        string     `gorm:"primaryKey;size:64"`
+	Name      string     `gorm:"not null;size:255;index:idx_people_name,expression:LOWER(name)"`
+	BirthDate *time.Time `gorm:"type:date"`
+	Biography *string    `gorm:"type:text"`
+	CreatedAt time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt time.Time  `gorm:"autoUpdateTime"`
+}
+
+// TableName overrides the table name
+func (Person) TableName() string {
+	return "people"
+}
+
+// MovieCredit represents the movie_credits table
+type MovieCredit struct {
+	ID            int64   `gorm:"primaryKey"`
+	MovieID       string  `gorm:"not null;size:64;index:idx_movie_credits_movie_id"`
+	PersonID      string  `gorm:"not null;size:64;index:idx_movie_credits_person_id"`
+	Role          string  `gorm:"not null;size:16;check:role IN ('director', 'writer', 'actor')"`
+	CharacterName *string `gorm:"size:255"`
+	BillingOrder  *int32
+
+	// Foreign keys
+	Movie  Movie  `gorm:"foreignKey:MovieID;constraint:OnDelete:CASCADE"`
+	Person Person `gorm:"foreignKey:PersonID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName overrides the table name
+func (MovieCredit) TableName() string {
+	return "movie_credits"
+}
+
+// Collection represents the collections table
+type Collection struct {
+	ID          string    `gorm:"primaryKey;size:64"`
+	Name        string    `gorm:"not null;size:255;index:idx_collections_name,expression:LOWER(name)"`
+	Description *string   `gorm:"type:text"`
+	OwnerID     string    `gorm:"not null;size:100;index:idx_collections_owner_id"`
+	Visibility  string    `gorm:"not null;size:16;default:public;check:visibility IN ('public', 'private')"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName overrides the table name
+func (Collection) TableName() string {
+	return "collections"
+}
+
+// CollectionItem represents the collection_items table
+type CollectionItem struct {
+	CollectionID string    `gorm:"primaryKey;size:64;index:idx_collection_items_position,priority:1"`
+	MovieID      string    `gorm:"primaryKey;size:64;index:idx_collection_items_movie_id"`
+	Position     int32     `gorm:"not null;index:idx_collection_items_position,priority:2"`
+	AddedAt      time.Time `gorm:"autoCreateTime"`
+
+	// Foreign keys
+	Collection Collection `gorm:"foreignKey:CollectionID;constraint:OnDelete:CASCADE"`
+	Movie      Movie      `gorm:"foreignKey:MovieID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName overrides the table name
+func (CollectionItem) TableName() string {
+	return "collection_items"
+}
+
+// Genre represents the genres table
+type Genre struct {
+	ID        string    `gorm:"primaryKey;size:100"`
+	Name      string    `gorm:"not null;size:100;uniqueIndex"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName overrides the table name
+func (Genre) TableName() string {
+	return "genres"
+}
+
+// GenreAlias represents the genre_aliases table.
+// Aliases are genre keys (see biz.GenreKey).
+type GenreAlias struct {
+	Alias   string `gorm:"primaryKey;size:100"`
+	GenreID string `gorm:"not null;size:100;index:idx_genre_aliases_genre_id"`
+
+	// Foreign key
+	Genre Genre `gorm:"foreignKey:GenreID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName overrides the table name
+func (GenreAlias) TableName() string {
+	return "genre_aliases"
+}
+
+// MovieGenre represents the movie_genres table
+type MovieGenre struct {
+	MovieID  string `gorm:"primaryKey;size:64"`
+	GenreID  string `gorm:"primaryKey;size:100;index:idx_movie_genres_genre_id"`
+	Position int32  `gorm:"not null;default:0"`
+
+	// Foreign keys
+	Movie Movie `gorm:"foreignKey:MovieID;constraint:OnDelete:CASCADE"`
+	Genre Genre `gorm:"foreignKey:GenreID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName overrides the table name
+func (MovieGenre) TableName() string {
+	return "movie_genres"
+}
+
+// MovieTag represents the movie_tags table
+type MovieTag struct {
+	MovieID string `gorm:"primaryKey;size:64"`
+	Tag     string `gorm:"primaryKey;size:50;index:idx_movie_tags_tag"`
+
+	// Foreign key
+	Movie Movie `gorm:"foreignKey:MovieID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName overrides the table name
+func (MovieTag) TableName() string {
+	return "movie_tags"
+}
+
+// AlternateTitle represents the alternate_titles table
+type AlternateTitle struct {
+	ID        int64     `gorm:"primaryKey"`
+	MovieID   string    `gorm:"not null;size:64;index:idx_alternate_titles_movie_id"`
+	Title     string    `gorm:"not null;size:255;index:idx_alternate_titles_title"`
+	Language  *string   `gorm:"size:35"`
+	Region    *string   `gorm:"size:3"`
+	Kind      string    `gorm:"not null;size:16;default:alternative;check:kind IN ('original', 'localized', 'working', 'alternative')"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	// Foreign key
+	Movie Movie `gorm:"foreignKey:MovieID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName overrides the table name
+func (AlternateTitle) TableName() string {
+	return "alternate_titles"
+}
+
+// MovieTranslation represents the movie_translations table
+type MovieTranslation struct {
+	MovieID   string    `gorm:"primaryKey;size:64"`
+	Locale    string    `gorm:"primaryKey;size:35"`
+	Synopsis  *string   `gorm:"type:text"`
+	Tagline   *string   `gorm:"size:500"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+
+	// Foreign key
+	Movie Movie `gorm:"foreignKey:MovieID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName overrides the table name
+func (MovieTranslation) TableName() string {
+	return "movie_translations"
+}
+
+// GenreTranslation represents the genre_translations table
+type GenreTranslation struct {
+	GenreID string `gorm:"primaryKey;size:100"`
+	Locale  string `gorm:"primaryKey;size:35"`
+	Name    string `gorm:"not null;size:100"`
+
+	// Foreign key
+	Genre Genre `gorm:"foreignKey:GenreID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName overrides the table name
+func (GenreTranslation) TableName() string {
+	return "genre_translations"
+}
+
+// MovieImage represents the movie_images table
+type MovieImage struct {
+	ID          string    `gorm:"primaryKey;size:64"`
+	MovieID     string    `gorm:"not null;size:64;index:idx_movie_images_movie_id"`
+	Kind        string    `gorm:"not null;size:16;check:kind IN ('poster', 'still')"`
+	ContentType string    `gorm:"not null;size:50"`
+	Width       int32     `gorm:"not null"`
+	Height      int32     `gorm:"not null"`
+	SizeBytes   int64     `gorm:"not null"`
+	BlobKey     string    `gorm:"not null;size:512"`
+	URL         string    `gorm:"column:url;not null;type:text"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+
+	// Foreign key
+	Movie Movie `gorm:"foreignKey:MovieID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName overrides the table name
+func (MovieImage) TableName() string {
+	return "movie_images"
+}
+
+// MovieImageThumbnail represents the movie_image_thumbnails table
+type MovieImageThumbnail struct {
+	ImageID string `gorm:"primaryKey;size:64"`
+	Width   int32  `gorm:"primaryKey"`
+	Height  int32  `gorm:"not null"`
+	BlobKey string `gorm:"not null;size:512"`
+	URL     string `gorm:"column:url;not null;type:text"`
+
+	// Foreign key
+	Image MovieImage `gorm:"foreignKey:ImageID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName overrides the table name
+func (MovieImageThumbnail) TableName() string {
+	return "movie_image_thumbnails"
+}
+
+// MovieExternalID represents the movie_external_ids table
+type MovieExternalID struct {
+	MovieID   string    `gorm:"primaryKey;size:64"`
+	Scheme    string    `gorm:"primaryKey;size:16;uniqueIndex:uq_movie_external_ids_scheme_value;check:scheme IN ('imdb', 'tmdb', 'eidr')"`
+	Value     string    `gorm:"not null;size:64;uniqueIndex:uq_movie_external_ids_scheme_value"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+
+	// Foreign key
+	Movie Movie `gorm:"foreignKey:MovieID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName overrides the table name
+func (MovieExternalID) TableName() string {
+	return "movie_external_ids"
+}
+
+// IdempotencyKey represents the idempotency_keys table
+type IdempotencyKey struct {
+	Principal   string    `gorm:"primaryKey;size:128"`
+	Key         string    `gorm:"primaryKey;size:255"`
+	RequestHash string    `gorm:"not null;size:64"`
+	Response    []byte    `gorm:"type:bytea"`
+	ExpiresAt   time.Time `gorm:"not null;index:idx_idempotency_keys_expires_at"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName overrides the table name
+func (IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
+
 // RatingAggregate represents the aggregated rating result
 type RatingAggregate struct {
 	Average float64
@@ -0,0 +1,190 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"src/internal/biz"
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type moderationRepo struct {
+	data    *Data
+	ratings *ratingRepo
+	log     *log.Helper
+}
+
+// NewModerationRepo creates a new moderation repository
+func NewModerationRepo(data *Data, trending *conf.Trending, logger log.Logger) biz.ModerationRepo {
+	return &moderationRepo{
+		data:    data,
+		ratings: newRatingRepo(data, trending, logger),
+		log:     log.NewHelper(logger),
+	}
+}
+
+func (r *moderationRepo) GetRating(ctx context.Context, id int64) (*biz.Rating, error) {
+	var dbRating Rating
+	if err := r.data.db.WithContext(ctx).Where("id = ?", id).First(&dbRating).Error; err != nil {
+		return nil, fmt.Errorf("rating not found: %w", err)
+	}
+
+	rating := ratingToBiz(&dbRating)
+
+	counts, err := r.reportCounts(ctx, []uint{dbRating.ID})
+	if err != nil {
+		return nil, err
+	}
+	rating.ReportCount = counts[dbRating.ID]
+
+	return rating, nil
+}
+
+func (r *moderationRepo) AddReport(ctx context.Context, ratingID int64, reporterID string, reason *string) (int32, error) {
+	report := &RatingReport{
+		RatingID:   uint(ratingID),
+		ReporterID: reporterID,
+		Reason:     reason,
+	}
+
+	// Repeated reports from the same reporter are ignored
+	if err := r.data.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(report).Error; err != nil {
+		return 0, fmt.Errorf("failed to add report: %w", err)
+	}
+
+	counts, err := r.reportCounts(ctx, []uint{uint(ratingID)})
+	if err != nil {
+		return 0, err
+	}
+
+	return counts[uint(ratingID)], nil
+}
+
+func (r *moderationRepo) SetModerationStatus(ctx context.Context, id, version int64, status biz.ModerationStatus, reason *string) (*biz.Rating, error) {
+	updates := map[string]interface{}{
+		"moderation_status": string(status),
+		"version":           gorm.Expr("version + 1"),
+	}
+	// Keep the original flag reason unless a new one is given
+	if reason != nil {
+		updates["moderation_reason"] = *reason
+	}
+
+	db := r.data.db.WithContext(ctx).Model(&Rating{}).Where("id = ?", id)
+	if version != 0 {
+		db = db.Where("version = ?", version)
+	}
+	result := db.Updates(updates)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to update moderation status: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		var current Rating
+		if err := r.data.db.WithContext(ctx).Select("version").Where("id = ?", id).First(&current).Error; err != nil {
+			return nil, fmt.Errorf("rating %d not found", id)
+		}
+		return nil, &biz.VersionConflictError{Resource: "rating", ID: strconv.FormatInt(id, 10), Expected: version, Current: current.Version}
+	}
+
+	rating, err := r.GetRating(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hidden ratings are excluded from aggregates and rankings
+	r.ratings.invalidateAggregate(ctx, rating.MovieTitle)
+
+	return rating, nil
+}
+
+func (r *moderationRepo) ListQueue(ctx context.Context, query *biz.ModerationQueueQuery) (*biz.ModerationQueuePage, error) {
+	// Decode cursor to get offset
+	offset := 0
+	if query.Cursor != nil && *query.Cursor != "" {
+		var err error
+		offset, err = decodeCursor(*query.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor: %w", err)
+		}
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	// Fetch limit+1 to detect if there are more pages (oldest first)
+	var dbRatings []Rating
+	err := r.data.db.WithContext(ctx).
+		Where("moderation_status = ?", string(query.Status)).
+		Order("updated_at, id").
+		Offset(offset).Limit(int(limit + 1)).
+		Find(&dbRatings).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list moderation queue: %w", err)
+	}
+
+	hasMore := len(dbRatings) > int(limit)
+	if hasMore {
+		dbRatings = dbRatings[:limit]
+	}
+
+	ids := make([]uint, 0, len(dbRatings))
+	for i := range dbRatings {
+		ids = append(ids, dbRatings[i].ID)
+	}
+	counts, err := r.reportCounts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*biz.Rating, 0, len(dbRatings))
+	for i := range dbRatings {
+		rating := ratingToBiz(&dbRatings[i])
+		rating.ReportCount = counts[dbRatings[i].ID]
+		items = append(items, rating)
+	}
+
+	result := &biz.ModerationQueuePage{
+		Items: items,
+	}
+
+	if hasMore {
+		nextCursor := encodeCursor(offset + int(limit))
+		result.NextCursor = &nextCursor
+	}
+
+	return result, nil
+}
+
+// reportCounts returns the number of reports for each rating ID
+func (r *moderationRepo) reportCounts(ctx context.Context, ids []uint) (map[uint]int32, error) {
+	counts := make(map[uint]int32, len(ids))
+	if len(ids) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		RatingID uint
+		Count    int32
+	}
+	err := r.data.db.WithContext(ctx).
+		Model(&RatingReport{}).
+		Select("rating_id, COUNT(*) as count").
+		Where("rating_id IN ?", ids).
+		Group("rating_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to count reports: %w", err)
+	}
+
+	for _, row := range rows {
+		counts[row.RatingID] = row.Count
+	}
+	return counts, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -11,6 +12,8 @@ import (
 	"src/internal/biz"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type movieRepo struct {
@@ -30,15 +33,54 @@ func (r *movieRepo) CreateMovie(ctx context.Context, movie *biz.Movie) error {
 	// Convert biz.Movie to data.Movie
 	dbMovie := r.bizToModel(movie)
 
-	// Save to database
-	if err := r.data.db.WithContext(ctx).Create(dbMovie).Error; err != nil {
+	// Save the movie with its genres, tags, external IDs and credits together
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return insertMovie(tx, dbMovie, movie)
+	})
+	if err != nil {
 		return fmt.Errorf("failed to create movie: %w", err)
 	}
 
-	// No need to invalidate cache for new movie:
-	// - UUID v7 is newly generated, cannot have existing cache
-	// - Title has UNIQUE constraint, duplicate will be rejected by DB
+	// A cached movie with the same title no longer resolves the title alone
+	invalidateMovieCache(ctx, r.data, movie.Title)
+
+	return nil
+}
 
+// insertMovie stores a new movie with its genres, tags, external IDs and
+// credits, and sets its Key and canonical Genre and Genres
+func insertMovie(tx *gorm.DB, dbMovie *Movie, movie *biz.Movie) error {
+	if err := assignTitleKey(tx, dbMovie); err != nil {
+		return err
+	}
+	// The checks of assignTitleKey may race a concurrent insert of the title
+	if err := tx.Create(dbMovie).Error; err != nil {
+		return duplicateError(err, biz.DisambiguatedTitle(dbMovie.Title, dbMovie.ReleaseDate.Year()))
+	}
+	genres, err := insertGenres(tx, dbMovie.ID, movie.Genres)
+	if err != nil {
+		return err
+	}
+	// The primary genre column holds the canonical name, as in SetGenres
+	if genres[0] != dbMovie.Genre {
+		dbMovie.Genre = genres[0]
+		if err := tx.Model(&Movie{}).Where("id = ?", dbMovie.ID).Update("genre", dbMovie.Genre).Error; err != nil {
+			return err
+		}
+	}
+	if err := insertTags(tx, dbMovie.ID, movie.Tags); err != nil {
+		return err
+	}
+	if err := insertExternalIDs(tx, dbMovie.ID, movie.ExternalIDs); err != nil {
+		return err
+	}
+	if err := insertCredits(tx, dbMovie.ID, movie.Credits); err != nil {
+		return err
+	}
+	movie.Key = dbMovie.TitleKey
+	movie.Genre = dbMovie.Genre
+	movie.Genres = genres
+	movie.Version = dbMovie.Version
 	return nil
 }
 
@@ -50,27 +92,51 @@ func (r *movieRepo) GetMovieByTitle(ctx context.Context, title string) (*biz.Mov
 		cached, err := r.data.rdb.Get(ctx, cacheKey).Result()
 		if err == nil {
 			var movie biz.Movie
-			if err := json.Unmarshal([]byte(cached), &movie); err == nil {
+			if err := json.Unmarshal([]byte(cached), &movie); err != nil {
+				r.log.Warnf("failed to unmarshal cached movie %s: %v", title, err)
+			} else if movie.Key != "" && movie.Version != 0 { // Entries cached before movies had keys or versions are stale
 				r.log.Debugf("cache hit for movie: %s", title)
+				recordCacheLookups(cacheMovie, 1, 0)
 				return &movie, nil
-			} else {
-				r.log.Warnf("failed to unmarshal cached movie %s: %v", title, err)
 			}
 		}
+		recordCacheLookups(cacheMovie, 0, 1)
 	}
 
 	// Query from database
-	var dbMovie Movie
-	if err := r.data.db.WithContext(ctx).Where("title = ?", title).First(&dbMovie).Error; err != nil {
+	dbMovie, exact, err := r.resolveTitle(ctx, title)
+	if err != nil {
+		if errors.Is(err, biz.ErrAmbiguousTitle) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("movie not found: %w", err)
 	}
 
 	// Convert to biz model
-	movie := r.modelToBiz(&dbMovie)
+	movie := r.modelToBiz(dbMovie)
+	if err := loadTaxonomy(ctx, r.data.db, []*biz.Movie{movie}); err != nil {
+		return nil, err
+	}
+	if err := loadTranslations(ctx, r.data.db, []*biz.Movie{movie}); err != nil {
+		return nil, err
+	}
+	if err := loadImages(ctx, r.data.db, []*biz.Movie{movie}); err != nil {
+		return nil, err
+	}
+	if err := loadExternalIDs(ctx, r.data.db, []*biz.Movie{movie}); err != nil {
+		return nil, err
+	}
+	if err := loadCredits(ctx, r.data.db, []*biz.Movie{movie}); err != nil {
+		return nil, err
+	}
+	if err := loadAlternateTitles(ctx, r.data.db, []*biz.Movie{movie}); err != nil {
+		return nil, err
+	}
 
 	// Cache result if Redis is available
-	// Only cache by title since there's no GetMovieByID method (YAGNI principle)
-	if r.data.rdb != nil {
+	// Only cache by title since there's no GetMovieByID method (YAGNI principle).
+	// Year-qualified and alternate titles are not cached: invalidation goes by title.
+	if r.data.rdb != nil && exact {
 		if data, err := json.Marshal(movie); err == nil {
 			titleCacheKey := fmt.Sprintf("movie:title:%s", title)
 			if err := r.data.rdb.Set(ctx, titleCacheKey, data, 15*time.Minute).Err(); err != nil {
@@ -95,34 +161,7 @@ func (r *movieRepo) ListMovies(ctx context.Context, query *biz.MovieListQuery) (
 		}
 	}
 
-	// Build query
-	db := r.data.db.WithContext(ctx).Model(&Movie{})
-
-	// Apply filters
-	if query.Q != nil && *query.Q != "" {
-		searchTerm := fmt.Sprintf("%%%s%%", *query.Q)
-		db = db.Where("title ILIKE ?", searchTerm)
-	}
-
-	if query.Year != nil {
-		db = db.Where("EXTRACT(YEAR FROM release_date) = ?", *query.Year)
-	}
-
-	if query.Genre != nil {
-		db = db.Where("LOWER(genre) = LOWER(?)", *query.Genre)
-	}
-
-	if query.Distributor != nil {
-		db = db.Where("LOWER(distributor) = LOWER(?)", *query.Distributor)
-	}
-
-	if query.Budget != nil {
-		db = db.Where("budget <= ?", *query.Budget)
-	}
-
-	if query.MPARating != nil {
-		db = db.Where("mpa_rating = ?", *query.MPARating)
-	}
+	db := r.filterMovies(ctx, query)
 
 	// Apply pagination - fetch limit+1 to detect if there are more pages
 	limit := query.Limit
@@ -147,6 +186,24 @@ func (r *movieRepo) ListMovies(ctx context.Context, query *biz.MovieListQuery) (
 	for i := range dbMovies {
 		movies = append(movies, r.modelToBiz(&dbMovies[i]))
 	}
+	if err := loadTaxonomy(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadTranslations(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadImages(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadExternalIDs(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadCredits(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadAlternateTitles(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
 
 	// Prepare result
 	result := &biz.MoviePage{
@@ -163,38 +220,226 @@ func (r *movieRepo) ListMovies(ctx context.Context, query *biz.MovieListQuery) (
 	return result, nil
 }
 
+// filterMovies builds the movie query for the filters of a list query,
+// shared by ListMovies and ExportMovies
+func (r *movieRepo) filterMovies(ctx context.Context, query *biz.MovieListQuery) *gorm.DB {
+	db := r.data.db.WithContext(ctx).Model(&Movie{})
+
+	if query.Q != nil && *query.Q != "" {
+		searchTerm := fmt.Sprintf("%%%s%%", *query.Q)
+		db = db.Where("(title ILIKE ? OR id IN (?))", searchTerm, alternateTitleMovieIDs(r.data.db.WithContext(ctx), searchTerm))
+	}
+
+	if query.Year != nil {
+		db = db.Where("EXTRACT(YEAR FROM release_date) = ?", *query.Year)
+	}
+
+	// Genres match any assigned genre through its aliases
+	if query.Genre != nil {
+		db = db.Where("id IN (?)", genreMovieIDs(r.data.db.WithContext(ctx), *query.Genre))
+	}
+
+	if query.Tag != nil {
+		db = db.Where("id IN (?)", taggedMovieIDs(r.data.db.WithContext(ctx), *query.Tag))
+	}
+
+	if query.Distributor != nil {
+		db = db.Where("LOWER(distributor) = LOWER(?)", *query.Distributor)
+	}
+
+	if query.Budget != nil {
+		db = db.Where("budget <= ?", *query.Budget)
+	}
+
+	if query.MPARating != nil {
+		db = db.Where("mpa_rating = ?", *query.MPARating)
+	}
+
+	if query.Director != nil {
+		db = db.Where("id IN (?)", creditedMovieIDs(r.data.db.WithContext(ctx), biz.CreditDirector, *query.Director))
+	}
+
+	if query.Actor != nil {
+		db = db.Where("id IN (?)", creditedMovieIDs(r.data.db.WithContext(ctx), biz.CreditActor, *query.Actor))
+	}
+
+	return db
+}
+
 func (r *movieRepo) UpdateMovie(ctx context.Context, movie *biz.Movie) error {
-	// Get old title before update to invalidate old cache
+	dbMovie := r.bizToModel(movie)
+
+	// The key never changes once assigned. Without box office data the figures
+	// stored, e.g. by a refresh, are kept rather than cleared.
+	columns := []string{"title", "release_date", "genre", "distributor", "budget", "mpa_rating"}
+	if movie.BoxOffice != nil {
+		columns = append(columns, "box_office_worldwide", "box_office_opening_usa", "box_office_currency",
+			"box_office_source", "box_office_last_updated")
+	}
+
 	var oldMovie Movie
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpVersion(tx, movie); err != nil {
+			return err
+		}
+		// The row is locked now, so this is the state the update replaces
+		if err := tx.Where("id = ?", movie.ID).First(&oldMovie).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(dbMovie).Select(columns).Updates(dbMovie).Error; err != nil {
+			return duplicateError(err, biz.DisambiguatedTitle(movie.Title, movie.ReleaseDate.Year()))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update movie: %w", err)
+	}
+
+	// Move leaderboard entries if the edit changed the movie's segments
 	if r.data.rdb != nil {
-		if err := r.data.db.WithContext(ctx).Where("id = ?", movie.ID).First(&oldMovie).Error; err == nil {
-			// Delete old title cache if title changed
-			if oldMovie.Title != movie.Title {
-				oldTitleCacheKey := fmt.Sprintf("movie:title:%s", oldMovie.Title)
-				if err := r.data.rdb.Del(ctx, oldTitleCacheKey).Err(); err != nil {
-					r.log.Warnf("failed to delete old title cache %s: %v", oldMovie.Title, err)
-				}
-			}
+		if err := moveRankingSegments(ctx, r.data.rdb, &oldMovie, dbMovie); err != nil {
+			r.log.Warnf("failed to move ranking segments for movie %s: %v", movie.Title, err)
 		}
 	}
 
-	dbMovie := r.bizToModel(movie)
+	// Drop the movie under its old title too, if the edit changed it
+	invalidateMovieCache(ctx, r.data, oldMovie.Title, movie.Title)
 
-	if err := r.data.db.WithContext(ctx).Save(dbMovie).Error; err != nil {
-		return fmt.Errorf("failed to update movie: %w", err)
+	return nil
+}
+
+func (r *movieRepo) SetCredits(ctx context.Context, movie *biz.Movie, credits []*biz.Credit) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpVersion(tx, movie); err != nil {
+			return err
+		}
+		if err := tx.Where("movie_id = ?", movie.ID).Delete(&MovieCredit{}).Error; err != nil {
+			return err
+		}
+		return insertCredits(tx, movie.ID, credits)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set credits: %w", err)
 	}
 
-	// Invalidate current title cache
+	invalidateMovieCache(ctx, r.data, movie.Title)
+	return nil
+}
+
+func (r *movieRepo) SetGenres(ctx context.Context, movie *biz.Movie, genres []string) error {
+	var oldMovie, newMovie Movie
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpVersion(tx, movie); err != nil {
+			return err
+		}
+		if err := tx.Where("id = ?", movie.ID).First(&oldMovie).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("movie_id = ?", movie.ID).Delete(&MovieGenre{}).Error; err != nil {
+			return err
+		}
+		canonical, err := insertGenres(tx, movie.ID, genres)
+		if err != nil {
+			return err
+		}
+
+		// The primary genre column follows the first genre
+		newMovie = oldMovie
+		newMovie.Genre = canonical[0]
+		return tx.Model(&Movie{}).Where("id = ?", movie.ID).Update("genre", newMovie.Genre).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set genres: %w", err)
+	}
+
+	// Move leaderboard entries if the primary genre changed
 	if r.data.rdb != nil {
-		titleCacheKey := fmt.Sprintf("movie:title:%s", movie.Title)
-		if err := r.data.rdb.Del(ctx, titleCacheKey).Err(); err != nil {
-			r.log.Warnf("failed to delete title cache for movie %s: %v", movie.Title, err)
+		if err := moveRankingSegments(ctx, r.data.rdb, &oldMovie, &newMovie); err != nil {
+			r.log.Warnf("failed to move ranking segments for movie %s: %v", movie.Title, err)
 		}
 	}
 
+	invalidateMovieCache(ctx, r.data, movie.Title)
+	return nil
+}
+
+func (r *movieRepo) SetTags(ctx context.Context, movie *biz.Movie, tags []string) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpVersion(tx, movie); err != nil {
+			return err
+		}
+		if err := tx.Where("movie_id = ?", movie.ID).Delete(&MovieTag{}).Error; err != nil {
+			return err
+		}
+		return insertTags(tx, movie.ID, tags)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set tags: %w", err)
+	}
+
+	invalidateMovieCache(ctx, r.data, movie.Title)
+	return nil
+}
+
+// bumpMovieVersion advances the version of a movie as part of a write to it,
+// which keeps the row locked until the transaction ends. A non-zero expected
+// version must be the current one, or the write fails with a
+// *biz.VersionConflictError. Returns the new version.
+func bumpMovieVersion(tx *gorm.DB, id string, expected int64) (int64, error) {
+	var row Movie
+	db := tx.Model(&row).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "version"}}}).
+		Where("id = ?", id)
+	if expected != 0 {
+		db = db.Where("version = ?", expected)
+	}
+	result := db.Updates(map[string]interface{}{
+		"version":    gorm.Expr("version + 1"),
+		"updated_at": time.Now().UTC(),
+	})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return row.Version, nil
+	}
+
+	var current Movie
+	err := tx.Select("version").Where("id = ?", id).First(&current).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, fmt.Errorf("%w: %s", biz.ErrMovieNotFound, id)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return 0, &biz.VersionConflictError{Resource: "movie", ID: id, Expected: expected, Current: current.Version}
+}
+
+// bumpVersion advances the version of the movie a write is given, expecting
+// the version it has (see biz.MovieRepo), and sets it to the new one
+func bumpVersion(tx *gorm.DB, movie *biz.Movie) error {
+	version, err := bumpMovieVersion(tx, movie.ID, movie.Version)
+	if err != nil {
+		return err
+	}
+	movie.Version = version
 	return nil
 }
 
+// invalidateMovieCache drops cached movies, e.g. after their credits changed
+func invalidateMovieCache(ctx context.Context, data *Data, titles ...string) {
+	if data.rdb == nil || len(titles) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(titles))
+	for _, title := range titles {
+		keys = append(keys, fmt.Sprintf("movie:title:%s", title))
+	}
+	if err := data.rdb.Del(ctx, keys...).Err(); err != nil {
+		data.log.Warnf("failed to invalidate movie cache: %v", err)
+	}
+}
+
 // Helper: Convert biz.Movie to data.Movie
 func (r *movieRepo) bizToModel(biz *biz.Movie) *Movie {
 	m := &Movie{
@@ -205,6 +450,8 @@ func (r *movieRepo) bizToModel(biz *biz.Movie) *Movie {
 		Distributor: biz.Distributor,
 		Budget:      biz.Budget,
 		MPARating:   biz.MPARating,
+		TitleKey:    biz.Key,
+		Version:     biz.Version,
 	}
 
 	if biz.BoxOffice != nil {
@@ -238,6 +485,9 @@ func (r *movieRepo) modelToBiz(m *Movie) *biz.Movie {
 		Distributor: m.Distributor,
 		Budget:      m.Budget,
 		MPARating:   m.MPARating,
+		Key:         m.TitleKey,
+		Version:     m.Version,
+		UpdatedAt:   m.UpdatedAt,
 	}
 
 	if m.BoxOfficeWorldwide != nil {
@@ -281,3 +531,78 @@ func decodeCursor(cursor string) (int, error) {
 
 	return offset, nil
 }
+
+func (r *movieRepo) ExportMovies(ctx context.Context, query *biz.MovieListQuery, afterID string, limit int) ([]*biz.ExportRow, error) {
+	db := r.filterMovies(ctx, query)
+	if afterID != "" {
+		db = db.Where("id > ?", afterID)
+	}
+
+	var dbMovies []Movie
+	if err := db.Order("id").Limit(limit).Find(&dbMovies).Error; err != nil {
+		return nil, fmt.Errorf("failed to export movies: %w", err)
+	}
+	if len(dbMovies) == 0 {
+		return nil, nil
+	}
+
+	movies := make([]*biz.Movie, 0, len(dbMovies))
+	keys := make([]string, 0, len(dbMovies))
+	for i := range dbMovies {
+		movie := r.modelToBiz(&dbMovies[i])
+		movies = append(movies, movie)
+		keys = append(keys, movie.Key)
+	}
+	if err := loadTaxonomy(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadExternalIDs(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+
+	// All-time aggregates of the page in one query, as GetAggregate computes them
+	var aggregates []struct {
+		MovieTitle string
+		Average    float64
+		Count      int32
+	}
+	err := r.data.db.WithContext(ctx).
+		Model(&Rating{}).
+		Select("movie_title, ROUND(AVG(rating)::numeric, 1) as average, COUNT(*) as count").
+		Where("movie_title IN ? AND moderation_status <> ?", keys, biz.ModerationHidden).
+		Group("movie_title").
+		Scan(&aggregates).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rating aggregates: %w", err)
+	}
+	byKey := make(map[string]int, len(aggregates))
+	for i, a := range aggregates {
+		byKey[a.MovieTitle] = i
+	}
+
+	// Movies under anomaly review show their frozen average, as in GetAggregate
+	var reviews []RatingAnomalyReview
+	err = r.data.db.WithContext(ctx).
+		Where("movie_title IN ? AND expires_at > ? AND frozen_average IS NOT NULL", keys, time.Now().UTC()).
+		Find(&reviews).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to load anomaly reviews: %w", err)
+	}
+	frozen := make(map[string]float64, len(reviews))
+	for _, review := range reviews {
+		frozen[review.MovieTitle] = *review.FrozenAverage
+	}
+
+	rows := make([]*biz.ExportRow, 0, len(movies))
+	for _, movie := range movies {
+		row := &biz.ExportRow{Movie: movie}
+		if i, ok := byKey[movie.Key]; ok {
+			row.RatingAverage, row.RatingCount = aggregates[i].Average, aggregates[i].Count
+		}
+		if average, ok := frozen[movie.Key]; ok {
+			row.RatingAverage = average
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
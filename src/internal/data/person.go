@@ -0,0 +1,233 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"src/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type personRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPersonRepo creates a new person repository
+func NewPersonRepo(data *Data, logger log.Logger) biz.PersonRepo {
+	return &personRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *personRepo) CreatePerson(ctx context.Context, person *biz.Person) error {
+	dbPerson := &Person{
+		ID:        person.ID,
+		Name:      person.Name,
+		BirthDate: person.BirthDate,
+		Biography: person.Biography,
+	}
+	if err := r.data.db.WithContext(ctx).Create(dbPerson).Error; err != nil {
+		return fmt.Errorf("failed to create person: %w", err)
+	}
+
+	person.CreatedAt = dbPerson.CreatedAt
+	person.UpdatedAt = dbPerson.UpdatedAt
+	return nil
+}
+
+func (r *personRepo) GetPerson(ctx context.Context, id string) (*biz.Person, error) {
+	var dbPerson Person
+	if err := r.data.db.WithContext(ctx).Where("id = ?", id).First(&dbPerson).Error; err != nil {
+		return nil, fmt.Errorf("person not found: %w", err)
+	}
+	return personToBiz(&dbPerson), nil
+}
+
+func (r *personRepo) GetPeople(ctx context.Context, ids []string) (map[string]*biz.Person, error) {
+	var dbPeople []Person
+	if err := r.data.db.WithContext(ctx).Where("id IN ?", ids).Find(&dbPeople).Error; err != nil {
+		return nil, fmt.Errorf("failed to get people: %w", err)
+	}
+
+	people := make(map[string]*biz.Person, len(dbPeople))
+	for i := range dbPeople {
+		people[dbPeople[i].ID] = personToBiz(&dbPeople[i])
+	}
+	return people, nil
+}
+
+func (r *personRepo) UpdatePerson(ctx context.Context, id string, update *biz.PersonUpdate) (*biz.Person, error) {
+	updates := map[string]interface{}{}
+	if update.Name != nil {
+		updates["name"] = *update.Name
+	}
+	if update.BirthDate != nil {
+		updates["birth_date"] = *update.BirthDate
+	}
+	if update.Biography != nil {
+		updates["biography"] = *update.Biography
+	}
+
+	if len(updates) > 0 {
+		result := r.data.db.WithContext(ctx).Model(&Person{}).Where("id = ?", id).Updates(updates)
+		if result.Error != nil {
+			return nil, fmt.Errorf("failed to update person: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil, fmt.Errorf("person %s not found", id)
+		}
+
+		// Cached movies embed the person's name
+		if update.Name != nil {
+			r.invalidateCreditedMovies(ctx, id)
+		}
+	}
+
+	return r.GetPerson(ctx, id)
+}
+
+func (r *personRepo) DeletePerson(ctx context.Context, id string) error {
+	// Collect credited movies before the credits cascade away
+	titles, err := r.creditedTitles(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	result := r.data.db.WithContext(ctx).Where("id = ?", id).Delete(&Person{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete person: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("person %s not found", id)
+	}
+
+	invalidateMovieCache(ctx, r.data, titles...)
+	return nil
+}
+
+func (r *personRepo) ListPeople(ctx context.Context, query *biz.PersonListQuery) (*biz.PersonPage, error) {
+	// Decode cursor to get offset
+	offset := 0
+	if query.Cursor != nil && *query.Cursor != "" {
+		var err error
+		offset, err = decodeCursor(*query.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor: %w", err)
+		}
+	}
+
+	db := r.data.db.WithContext(ctx).Model(&Person{})
+	if query.Q != nil && *query.Q != "" {
+		db = db.Where("name ILIKE ?", fmt.Sprintf("%%%s%%", *query.Q))
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	// Fetch limit+1 to detect if there are more pages
+	var dbPeople []Person
+	if err := db.Order("name, id").Offset(offset).Limit(int(limit + 1)).Find(&dbPeople).Error; err != nil {
+		return nil, fmt.Errorf("failed to list people: %w", err)
+	}
+
+	hasMore := len(dbPeople) > int(limit)
+	if hasMore {
+		dbPeople = dbPeople[:limit]
+	}
+
+	result := &biz.PersonPage{
+		Items: make([]*biz.Person, 0, len(dbPeople)),
+	}
+	for i := range dbPeople {
+		result.Items = append(result.Items, personToBiz(&dbPeople[i]))
+	}
+	if hasMore {
+		nextCursor := encodeCursor(offset + int(limit))
+		result.NextCursor = &nextCursor
+	}
+
+	return result, nil
+}
+
+func (r *personRepo) ListPersonCredits(ctx context.Context, id string) ([]*biz.PersonCredit, error) {
+	var dbCredits []MovieCredit
+	err := r.data.db.WithContext(ctx).
+		InnerJoins("Movie").
+		Where("movie_credits.person_id = ?", id).
+		Order(`"Movie"."release_date" DESC, movie_credits.role, movie_credits.billing_order NULLS LAST`).
+		Find(&dbCredits).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list person credits: %w", err)
+	}
+
+	mr := &movieRepo{data: r.data, log: r.log}
+	credits := make([]*biz.PersonCredit, 0, len(dbCredits))
+	movies := make([]*biz.Movie, 0, len(dbCredits))
+	for i := range dbCredits {
+		c := &dbCredits[i]
+		movie := mr.modelToBiz(&c.Movie)
+		movies = append(movies, movie)
+		credits = append(credits, &biz.PersonCredit{
+			Movie:         movie,
+			Role:          biz.CreditRole(c.Role),
+			CharacterName: c.CharacterName,
+			BillingOrder:  c.BillingOrder,
+		})
+	}
+	if err := loadTaxonomy(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadTranslations(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadImages(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	if err := loadExternalIDs(ctx, r.data.db, movies); err != nil {
+		return nil, err
+	}
+	return credits, nil
+}
+
+// creditedTitles returns the titles of movies the person is credited on
+func (r *personRepo) creditedTitles(ctx context.Context, id string) ([]string, error) {
+	var titles []string
+	err := r.data.db.WithContext(ctx).
+		Model(&Movie{}).
+		Where("id IN (?)", r.data.db.Table("movie_credits").Select("movie_id").Where("person_id = ?", id)).
+		Pluck("title", &titles).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credited movies: %w", err)
+	}
+	return titles, nil
+}
+
+// invalidateCreditedMovies drops the cache of every movie the person is credited on
+func (r *personRepo) invalidateCreditedMovies(ctx context.Context, id string) {
+	if r.data.rdb == nil {
+		return
+	}
+	titles, err := r.creditedTitles(ctx, id)
+	if err != nil {
+		r.log.Warnf("failed to invalidate movies of person %s: %v", id, err)
+		return
+	}
+	invalidateMovieCache(ctx, r.data, titles...)
+}
+
+// personToBiz converts data.Person to biz.Person
+func personToBiz(m *Person) *biz.Person {
+	return &biz.Person{
+		ID:        m.ID,
+		Name:      m.Name,
+		BirthDate: m.BirthDate,
+		Biography: m.Biography,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
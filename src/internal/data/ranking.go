@@ -0,0 +1,451 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"src/internal/biz"
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// windowedRankingTTL is how long windowed leaderboards computed from rollups are cached
+const windowedRankingTTL = 5 * time.Minute
+
+// Leaderboard orderings
+const (
+	rankingTopRated = "top"
+	rankingPopular  = "popular"
+)
+
+// trendingScanBatch is how many trending entries are read per round while filtering
+const trendingScanBatch = 200
+
+type rankingRepo struct {
+	data     *Data
+	halfLife time.Duration
+	log      *log.Helper
+}
+
+// NewRankingRepo creates a new ranking repository
+func NewRankingRepo(data *Data, trending *conf.Trending, logger log.Logger) biz.RankingRepo {
+	return &rankingRepo{
+		data:     data,
+		halfLife: trendingHalfLife(trending),
+		log:      log.NewHelper(logger),
+	}
+}
+
+func (r *rankingRepo) ListTopRated(ctx context.Context, query *biz.RankingQuery) ([]*biz.RankedMovie, error) {
+	if query.Window == biz.WindowAll && r.data.rdb != nil {
+		movies, err := r.fromZSets(ctx, topRankingKey, popularRankingKey, query, false)
+		if err == nil {
+			return movies, nil
+		}
+		r.log.Warnf("failed to read top-rated ranking from redis: %v", err)
+	}
+	return r.windowed(ctx, rankingTopRated, query)
+}
+
+func (r *rankingRepo) ListPopular(ctx context.Context, query *biz.RankingQuery) ([]*biz.RankedMovie, error) {
+	if query.Window == biz.WindowAll && r.data.rdb != nil {
+		movies, err := r.fromZSets(ctx, popularRankingKey, topRankingKey, query, true)
+		if err == nil {
+			return movies, nil
+		}
+		r.log.Warnf("failed to read popular ranking from redis: %v", err)
+	}
+	return r.windowed(ctx, rankingPopular, query)
+}
+
+// fromZSets reads the all-time leaderboard from the ranking ZSets maintained on
+// rating changes; the global companion ZSet supplies the other half of each entry
+func (r *rankingRepo) fromZSets(ctx context.Context, base, companion string, query *biz.RankingQuery, byCount bool) ([]*biz.RankedMovie, error) {
+	segments, err := querySegments(ctx, r.data.db, query)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := r.leaderboardEntries(ctx, base, segments, query.Limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return []*biz.RankedMovie{}, nil
+	}
+
+	titles := make([]string, 0, len(entries))
+	for _, e := range entries {
+		titles = append(titles, e.Member.(string))
+	}
+	others, err := r.data.rdb.ZMScore(ctx, companion, titles...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ranking %s: %w", companion, err)
+	}
+
+	movies := make([]*biz.RankedMovie, 0, len(entries))
+	for i, e := range entries {
+		movie := &biz.RankedMovie{Title: titles[i]}
+		if byCount {
+			movie.Count = int32(e.Score)
+			movie.Average = math.Round(others[i]*10) / 10
+		} else {
+			movie.Average = math.Round(e.Score*10) / 10
+			movie.Count = int32(others[i])
+		}
+		movies = append(movies, movie)
+	}
+	return movies, nil
+}
+
+// leaderboardEntries reads the highest positive scores of a leaderboard. With
+// several segments the segment ZSets are intersected, keeping the first one's scores.
+func (r *rankingRepo) leaderboardEntries(ctx context.Context, base string, segments []string, limit int32) ([]redis.Z, error) {
+	if len(segments) <= 1 {
+		key := rankingKeys(base, segments)[len(segments)]
+		entries, err := r.data.rdb.ZRevRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{
+			Min:   "(0",
+			Max:   "+inf",
+			Count: int64(limit),
+		}).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ranking %s: %w", key, err)
+		}
+		return entries, nil
+	}
+
+	keys := segmentKeys(base, segments)
+	weights := make([]float64, len(keys))
+	weights[0] = 1
+	entries, err := r.data.rdb.ZInterWithScores(ctx, &redis.ZStore{Keys: keys, Weights: weights}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to intersect rankings %v: %w", keys, err)
+	}
+
+	// ZINTER returns ascending scores; match ZREVRANGE ordering
+	entries = slices.DeleteFunc(entries, func(e redis.Z) bool { return e.Score <= 0 })
+	slices.Reverse(entries)
+	if int(limit) < len(entries) {
+		entries = entries[:limit]
+	}
+	return entries, nil
+}
+
+// windowed computes a leaderboard from the daily rollups, cached briefly in Redis.
+// The cached list always holds MaxRankingLimit entries and is sliced per request.
+func (r *rankingRepo) windowed(ctx context.Context, ordering string, query *biz.RankingQuery) ([]*biz.RankedMovie, error) {
+	segments, err := querySegments(ctx, r.data.db, query)
+	if err != nil {
+		return nil, err
+	}
+	cacheKey := strings.Join(append([]string{"rank:window", ordering, query.Window.String()}, segments...), ":")
+
+	if r.data.rdb != nil {
+		cached, err := r.data.rdb.Get(ctx, cacheKey).Result()
+		if err == nil {
+			var movies []*biz.RankedMovie
+			if err := json.Unmarshal([]byte(cached), &movies); err == nil {
+				return limitRanking(movies, query.Limit), nil
+			}
+		}
+	}
+
+	// All-time averages of movies under anomaly review stay frozen, as in
+	// GetRatingAggregate and the leaderboards RebuildRankings writes
+	average := "SUM(rating_sum) / SUM(rating_count)"
+	if query.Window == biz.WindowAll {
+		average = "COALESCE(rating_anomaly_reviews.frozen_average, " + average + ")"
+	}
+	order := average + " DESC, SUM(rating_count) DESC, rating_daily_stats.movie_title"
+	if ordering == rankingPopular {
+		order = "SUM(rating_count) DESC, " + average + " DESC, rating_daily_stats.movie_title"
+	}
+
+	db := r.data.db.WithContext(ctx).
+		Model(&RatingDailyStat{}).
+		Select("rating_daily_stats.movie_title as title, ROUND((" + average + ")::numeric, 1) as average, SUM(rating_count) as count")
+	group := "rating_daily_stats.movie_title"
+	if query.Window == biz.WindowAll {
+		db = db.Joins("LEFT JOIN rating_anomaly_reviews ON rating_anomaly_reviews.movie_title = rating_daily_stats.movie_title "+
+			"AND rating_anomaly_reviews.expires_at > ?", time.Now().UTC())
+		group = "rating_daily_stats.movie_title, rating_anomaly_reviews.frozen_average"
+	} else {
+		db = db.Where("day > ?", windowStartDay(query.Window, time.Now()))
+	}
+	if len(segments) > 0 {
+		db = db.Joins("JOIN movies ON movies.title_key = rating_daily_stats.movie_title AND movies.deleted_at IS NULL")
+	}
+	if query.Genre != nil {
+		db = db.Where("movies.id IN (?)", primaryGenreMovieIDs(r.data.db.WithContext(ctx), *query.Genre))
+	}
+	if query.Year != nil {
+		db = db.Where("EXTRACT(YEAR FROM movies.release_date) = ?", *query.Year)
+	}
+	if query.MPARating != nil {
+		db = db.Where("LOWER(movies.mpa_rating) = LOWER(?)", strings.TrimSpace(*query.MPARating))
+	}
+
+	var movies []*biz.RankedMovie
+	err = db.Group(group).
+		Having("SUM(rating_count) > 0").
+		Order(order).
+		Limit(biz.MaxRankingLimit).
+		Scan(&movies).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute %s ranking: %w", ordering, err)
+	}
+
+	if r.data.rdb != nil {
+		if data, err := json.Marshal(movies); err == nil {
+			r.data.rdb.Set(ctx, cacheKey, data, windowedRankingTTL)
+		}
+	}
+
+	return limitRanking(movies, query.Limit), nil
+}
+
+func (r *rankingRepo) ListTrending(ctx context.Context, query *biz.TrendingQuery) ([]*biz.RankedMovie, error) {
+	if r.data.rdb != nil {
+		movies, err := r.trendingFromZSet(ctx, query)
+		if err == nil {
+			return movies, nil
+		}
+		r.log.Warnf("failed to read trending ranking from redis: %v", err)
+	}
+	return r.trendingFromSQL(ctx, query)
+}
+
+func (r *rankingRepo) RenormalizeTrending(ctx context.Context) error {
+	if r.data.rdb == nil {
+		return nil
+	}
+	dropped, err := renormalizeTrendingScript.Run(ctx, r.data.rdb, []string{trendingKey, trendingEpochKey},
+		time.Now().Unix(), r.halfLife.Seconds(), trendingMinScore).Int64()
+	if err != nil {
+		return err
+	}
+	if dropped > 0 {
+		r.log.Infof("dropped %d movies with decayed trending scores", dropped)
+	}
+	return nil
+}
+
+// trendingFromZSet reads the trending ZSet in batches, keeping movies that match
+// the filters until the limit is reached
+func (r *rankingRepo) trendingFromZSet(ctx context.Context, query *biz.TrendingQuery) ([]*biz.RankedMovie, error) {
+	epoch, err := trendingEpoch(ctx, r.data.rdb)
+	if err != nil {
+		return nil, err
+	}
+	// Scores are relative to the epoch; rescale so a brand-new rating counts as 1
+	scale := trendingDecay(time.Now().Unix()-epoch, r.halfLife)
+
+	movies := make([]*biz.RankedMovie, 0, query.Limit)
+	for start := int64(0); len(movies) < int(query.Limit); start += trendingScanBatch {
+		entries, err := r.data.rdb.ZRevRangeWithScores(ctx, trendingKey, start, start+trendingScanBatch-1).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read trending ranking: %w", err)
+		}
+		if len(entries) == 0 {
+			break
+		}
+
+		titles := make([]string, 0, len(entries))
+		for _, e := range entries {
+			titles = append(titles, e.Member.(string))
+		}
+		matching, err := r.filterTitles(ctx, titles, query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range entries {
+			title := e.Member.(string)
+			if !matching[title] {
+				continue
+			}
+			movies = append(movies, &biz.RankedMovie{Title: title, Score: e.Score * scale})
+			if len(movies) == int(query.Limit) {
+				break
+			}
+		}
+	}
+	if len(movies) == 0 {
+		return movies, nil
+	}
+
+	// Fill in the all-time aggregate from the other rankings
+	titles := make([]string, 0, len(movies))
+	for _, m := range movies {
+		titles = append(titles, m.Title)
+	}
+	averages, err := r.data.rdb.ZMScore(ctx, topRankingKey, titles...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ranking %s: %w", topRankingKey, err)
+	}
+	counts, err := r.data.rdb.ZMScore(ctx, popularRankingKey, titles...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ranking %s: %w", popularRankingKey, err)
+	}
+	for i, m := range movies {
+		m.Average = math.Round(averages[i]*10) / 10
+		m.Count = int32(counts[i])
+	}
+
+	return movies, nil
+}
+
+// filterTitles returns which of the titles belong to existing movies matching the filters
+func (r *rankingRepo) filterTitles(ctx context.Context, titles []string, query *biz.TrendingQuery) (map[string]bool, error) {
+	db := r.data.db.WithContext(ctx).Model(&Movie{}).Where("title_key IN ?", titles)
+	if query.Genre != nil {
+		db = db.Where("id IN (?)", primaryGenreMovieIDs(r.data.db.WithContext(ctx), *query.Genre))
+	}
+	if query.Year != nil {
+		db = db.Where("EXTRACT(YEAR FROM release_date) = ?", *query.Year)
+	}
+
+	var matching []string
+	if err := db.Pluck("title_key", &matching).Error; err != nil {
+		return nil, fmt.Errorf("failed to filter trending movies: %w", err)
+	}
+
+	result := make(map[string]bool, len(matching))
+	for _, title := range matching {
+		result[title] = true
+	}
+	return result, nil
+}
+
+// trendingFromSQL computes trending scores directly from ratings, relative to now
+func (r *rankingRepo) trendingFromSQL(ctx context.Context, query *biz.TrendingQuery) ([]*biz.RankedMovie, error) {
+	now := time.Now().Unix()
+	halfLife := r.halfLife.Seconds()
+
+	db := r.data.db.WithContext(ctx).
+		Table("ratings").
+		Select("ratings.movie_title as title, ROUND(AVG(ratings.rating), 1) as average, COUNT(*) as count, "+trendingSumSQL+" as score",
+			now, halfLife, trendingMinExponent).
+		Joins("JOIN movies ON movies.title_key = ratings.movie_title AND movies.deleted_at IS NULL").
+		Where("ratings.moderation_status <> ?", biz.ModerationHidden)
+	if query.Genre != nil {
+		db = db.Where("movies.id IN (?)", primaryGenreMovieIDs(r.data.db.WithContext(ctx), *query.Genre))
+	}
+	if query.Year != nil {
+		db = db.Where("EXTRACT(YEAR FROM movies.release_date) = ?", *query.Year)
+	}
+
+	var movies []*biz.RankedMovie
+	err := db.Group("ratings.movie_title").
+		Having(trendingSumSQL+" >= ?", now, halfLife, trendingMinExponent, trendingMinScore).
+		Order("score DESC, ratings.movie_title").
+		Limit(int(query.Limit)).
+		Scan(&movies).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute trending ranking: %w", err)
+	}
+
+	return movies, nil
+}
+
+// RebuildRankings regenerates the global and segment leaderboards from SQL.
+// Each ZSet is written under a temporary key and renamed into place, then
+// segment ZSets that no longer have any movies are removed.
+func (r *rankingRepo) RebuildRankings(ctx context.Context) (int, error) {
+	if r.data.rdb == nil {
+		return 0, fmt.Errorf("rankings require redis")
+	}
+
+	epoch, err := trendingEpoch(ctx, r.data.rdb)
+	if err != nil {
+		return 0, err
+	}
+
+	var rows []struct {
+		Movie
+		Average  float64
+		Count    int32
+		Trending float64
+	}
+	err = r.data.db.WithContext(ctx).
+		Table("ratings").
+		Select("movies.*, COALESCE(rating_anomaly_reviews.frozen_average, AVG(ratings.rating)) as average, COUNT(*) as count, "+trendingSumSQL+" as trending",
+			epoch, r.halfLife.Seconds(), trendingMinExponent).
+		Joins("JOIN movies ON movies.title_key = ratings.movie_title AND movies.deleted_at IS NULL").
+		Joins("LEFT JOIN rating_anomaly_reviews ON rating_anomaly_reviews.movie_title = movies.title_key AND rating_anomaly_reviews.expires_at > ?", time.Now().UTC()).
+		Where("ratings.moderation_status <> ?", biz.ModerationHidden).
+		Group("movies.id, rating_anomaly_reviews.frozen_average").
+		Scan(&rows).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to aggregate ratings for rankings: %w", err)
+	}
+
+	zsets := map[string][]redis.Z{
+		topRankingKey:     {},
+		popularRankingKey: {},
+		trendingKey:       {},
+	}
+	for i := range rows {
+		row := &rows[i]
+		segments := rankingSegments(&row.Movie)
+		for _, key := range rankingKeys(topRankingKey, segments) {
+			zsets[key] = append(zsets[key], redis.Z{Score: row.Average, Member: row.TitleKey})
+		}
+		for _, key := range rankingKeys(popularRankingKey, segments) {
+			zsets[key] = append(zsets[key], redis.Z{Score: float64(row.Count), Member: row.TitleKey})
+		}
+		if row.Trending >= trendingMinScore {
+			zsets[trendingKey] = append(zsets[trendingKey], redis.Z{Score: row.Trending, Member: row.TitleKey})
+		}
+	}
+
+	for key, members := range zsets {
+		if len(members) == 0 {
+			if err := r.data.rdb.Del(ctx, key).Err(); err != nil {
+				return 0, fmt.Errorf("failed to clear ranking %s: %w", key, err)
+			}
+			continue
+		}
+		tmpKey := "rank:rebuild:" + key
+		_, err := r.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Del(ctx, tmpKey)
+			pipe.ZAdd(ctx, tmpKey, members...)
+			pipe.Rename(ctx, tmpKey, key)
+			return nil
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to write ranking %s: %w", key, err)
+		}
+	}
+
+	// Drop segments left over from genres, years or MPA ratings without ranked movies
+	for _, base := range []string{topRankingKey, popularRankingKey} {
+		iter := r.data.rdb.Scan(ctx, 0, base+":*", 100).Iterator()
+		for iter.Next(ctx) {
+			if _, ok := zsets[iter.Val()]; ok {
+				continue
+			}
+			if err := r.data.rdb.Del(ctx, iter.Val()).Err(); err != nil {
+				return 0, fmt.Errorf("failed to remove stale ranking %s: %w", iter.Val(), err)
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return 0, fmt.Errorf("failed to scan rankings: %w", err)
+		}
+	}
+
+	return len(rows), nil
+}
+
+func limitRanking(movies []*biz.RankedMovie, limit int32) []*biz.RankedMovie {
+	if int(limit) < len(movies) {
+		return movies[:limit]
+	}
+	return movies
+}
@@ -0,0 +1,158 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"src/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// tokenBucketScript atomically refills and takes one token from a bucket.
+// It uses the Redis server clock so all replicas share the same time source.
+// Returns {allowed, tokens left} with tokens as a string to keep the fraction.
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1]) or burst
+local ts = tonumber(state[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+local allowed = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate * 1000))
+return {allowed, tostring(tokens)}
+`)
+
+// localBucketsMax bounds the in-process bucket map before stale buckets are pruned
+const localBucketsMax = 10000
+
+type rateLimiter struct {
+	data *Data
+	log  *log.Helper
+
+	mu    sync.Mutex
+	local map[string]*localBucket
+}
+
+type localBucket struct {
+	tokens float64
+	ts     time.Time
+	limit  biz.RateLimit
+}
+
+// NewRateLimiter creates a token-bucket rate limiter.
+// Buckets live in Redis so limits hold across replicas, with an in-process
+// fallback when Redis is not configured or unavailable.
+func NewRateLimiter(data *Data, logger log.Logger) biz.RateLimiter {
+	return &rateLimiter{
+		data:  data,
+		log:   log.NewHelper(logger),
+		local: make(map[string]*localBucket),
+	}
+}
+
+func (l *rateLimiter) Allow(ctx context.Context, key string, limit biz.RateLimit) (*biz.RateLimitResult, error) {
+	if limit.Rate <= 0 || limit.Burst <= 0 {
+		return nil, fmt.Errorf("invalid rate limit for %s", key)
+	}
+
+	if l.data.rdb != nil {
+		res, err := l.allowRedis(ctx, key, limit)
+		if err == nil {
+			return res, nil
+		}
+		// Fail over to the local bucket rather than rejecting or skipping limits
+		l.log.Warnf("redis rate limiter failed, using in-process limiter: %v", err)
+	}
+
+	return l.allowLocal(key, limit), nil
+}
+
+func (l *rateLimiter) allowRedis(ctx context.Context, key string, limit biz.RateLimit) (*biz.RateLimitResult, error) {
+	redisKey := fmt.Sprintf("ratelimit:%s", key)
+	values, err := tokenBucketScript.Run(ctx, l.data.rdb, []string{redisKey}, limit.Rate, limit.Burst).Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to run token bucket script: %w", err)
+	}
+	if len(values) != 2 {
+		return nil, fmt.Errorf("unexpected token bucket result: %v", values)
+	}
+
+	allowed, _ := values[0].(int64)
+	tokensStr, _ := values[1].(string)
+	tokens, err := strconv.ParseFloat(tokensStr, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token count %q: %w", tokensStr, err)
+	}
+
+	return bucketResult(allowed == 1, tokens, limit), nil
+}
+
+func (l *rateLimiter) allowLocal(key string, limit biz.RateLimit) *biz.RateLimitResult {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	bucket, ok := l.local[key]
+	if !ok {
+		if len(l.local) >= localBucketsMax {
+			l.pruneLocked(now)
+		}
+		bucket = &localBucket{tokens: float64(limit.Burst), ts: now, limit: limit}
+		l.local[key] = bucket
+	}
+
+	// Refill since the last request
+	elapsed := now.Sub(bucket.ts).Seconds()
+	bucket.tokens = math.Min(float64(limit.Burst), bucket.tokens+elapsed*limit.Rate)
+	bucket.ts = now
+	bucket.limit = limit
+
+	allowed := bucket.tokens >= 1
+	if allowed {
+		bucket.tokens--
+	}
+
+	return bucketResult(allowed, bucket.tokens, limit)
+}
+
+// pruneLocked drops buckets that have refilled completely; caller must hold l.mu
+func (l *rateLimiter) pruneLocked(now time.Time) {
+	for key, bucket := range l.local {
+		full := bucket.tokens + now.Sub(bucket.ts).Seconds()*bucket.limit.Rate
+		if full >= float64(bucket.limit.Burst) {
+			delete(l.local, key)
+		}
+	}
+}
+
+// bucketResult builds the result from the tokens left after the request
+func bucketResult(allowed bool, tokens float64, limit biz.RateLimit) *biz.RateLimitResult {
+	res := &biz.RateLimitResult{
+		Allowed:   allowed,
+		Limit:     limit.Burst,
+		Remaining: int64(math.Floor(tokens)),
+		Reset:     secondsToDuration((float64(limit.Burst) - tokens) / limit.Rate),
+	}
+	if !allowed {
+		res.RetryAfter = secondsToDuration((1 - tokens) / limit.Rate)
+	}
+	return res
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Ceil(seconds * float64(time.Second)))
+}
@@ -0,0 +1,145 @@
+package data
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"src/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestBucketResult(t *testing.T) {
+	limit := biz.RateLimit{Rate: 2, Burst: 10}
+
+	tests := []struct {
+		name    string
+		allowed bool
+		tokens  float64
+		want    biz.RateLimitResult
+	}{
+		{
+			name:    "full bucket after one request",
+			allowed: true,
+			tokens:  9,
+			want:    biz.RateLimitResult{Allowed: true, Limit: 10, Remaining: 9, Reset: 500 * time.Millisecond},
+		},
+		{
+			name:    "fraction left is rounded down",
+			allowed: true,
+			tokens:  2.5,
+			want:    biz.RateLimitResult{Allowed: true, Limit: 10, Remaining: 2, Reset: 3750 * time.Millisecond},
+		},
+		{
+			name:    "last token taken",
+			allowed: true,
+			tokens:  0,
+			want:    biz.RateLimitResult{Allowed: true, Limit: 10, Remaining: 0, Reset: 5 * time.Second},
+		},
+		{
+			name:    "rejected waits for the next token",
+			allowed: false,
+			tokens:  0.5,
+			want: biz.RateLimitResult{
+				Limit:      10,
+				Remaining:  0,
+				RetryAfter: 250 * time.Millisecond,
+				Reset:      4750 * time.Millisecond,
+			},
+		},
+		{
+			name:    "rejected with an empty bucket",
+			allowed: false,
+			tokens:  0,
+			want:    biz.RateLimitResult{Limit: 10, Remaining: 0, RetryAfter: 500 * time.Millisecond, Reset: 5 * time.Second},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bucketResult(tt.allowed, tt.tokens, limit); *got != tt.want {
+				t.Errorf("bucketResult() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllowLocal(t *testing.T) {
+	limit := biz.RateLimit{Rate: 1, Burst: 3}
+
+	tests := []struct {
+		name    string
+		prepare func(l *rateLimiter)
+		key     string
+		want    []bool // Allowed for consecutive requests
+	}{
+		{
+			name: "burst then rejected",
+			key:  "a",
+			want: []bool{true, true, true, false},
+		},
+		{
+			name: "other keys have their own bucket",
+			prepare: func(l *rateLimiter) {
+				l.allowLocal("b", limit)
+				l.allowLocal("b", limit)
+				l.allowLocal("b", limit)
+			},
+			key:  "a",
+			want: []bool{true, true, true, false},
+		},
+		{
+			name: "refilled over time",
+			prepare: func(l *rateLimiter) {
+				l.local["a"] = &localBucket{tokens: 0, ts: time.Now().Add(-2 * time.Second), limit: limit}
+			},
+			key:  "a",
+			want: []bool{true, true, false},
+		},
+		{
+			name: "refill is capped at the burst",
+			prepare: func(l *rateLimiter) {
+				l.local["a"] = &localBucket{tokens: 0, ts: time.Now().Add(-time.Hour), limit: limit}
+			},
+			key:  "a",
+			want: []bool{true, true, true, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewRateLimiter(&Data{}, log.NewStdLogger(io.Discard)).(*rateLimiter)
+			if tt.prepare != nil {
+				tt.prepare(l)
+			}
+			for i, want := range tt.want {
+				res, err := l.Allow(context.Background(), tt.key, limit)
+				if err != nil {
+					t.Fatalf("Allow() error = %v", err)
+				}
+				if res.Allowed != want {
+					t.Fatalf("request %d: Allowed = %v, want %v", i+1, res.Allowed, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPruneLocked(t *testing.T) {
+	limit := biz.RateLimit{Rate: 1, Burst: 3}
+	now := time.Now()
+	l := NewRateLimiter(&Data{}, log.NewStdLogger(io.Discard)).(*rateLimiter)
+	l.local["refilled"] = &localBucket{tokens: 0, ts: now.Add(-3 * time.Second), limit: limit}
+	l.local["draining"] = &localBucket{tokens: 0, ts: now.Add(-time.Second), limit: limit}
+
+	l.pruneLocked(now)
+
+	if _, ok := l.local["refilled"]; ok {
+		t.Error("pruneLocked() kept a bucket that has refilled")
+	}
+	if _, ok := l.local["draining"]; !ok {
+		t.Error("pruneLocked() dropped a bucket that is still refilling")
+	}
+}
@@ -5,81 +5,189 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
+	"unicode/utf8"
 
 	"src/internal/biz"
+	"src/internal/conf"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
+// Rating event types
+const (
+	ratingEventCreated = "created"
+	ratingEventUpdated = "updated"
+)
+
+// maxUserAgentLength matches the rating_events.user_agent column size
+const maxUserAgentLength = 512
+
 type ratingRepo struct {
-	data *Data
-	log  *log.Helper
+	data     *Data
+	halfLife time.Duration
+	log      *log.Helper
 }
 
 // NewRatingRepo creates a new rating repository
-func NewRatingRepo(data *Data, logger log.Logger) biz.RatingRepo {
+func NewRatingRepo(data *Data, trending *conf.Trending, logger log.Logger) biz.RatingRepo {
+	return newRatingRepo(data, trending, logger)
+}
+
+func newRatingRepo(data *Data, trending *conf.Trending, logger log.Logger) *ratingRepo {
 	return &ratingRepo{
-		data: data,
-		log:  log.NewHelper(logger),
+		data:     data,
+		halfLife: trendingHalfLife(trending),
+		log:      log.NewHelper(logger),
+	}
+}
+
+// keepHidden returns the ON CONFLICT assignment of a moderation column that
+// keeps the stored value while the rating is hidden and the new submission
+// is not pending review
+func keepHidden(column string) clause.Assignment {
+	return clause.Assignment{
+		Column: clause.Column{Name: column},
+		Value: gorm.Expr(fmt.Sprintf("CASE WHEN ratings.moderation_status = '%s' AND excluded.moderation_status <> '%s' "+
+			"THEN ratings.%s ELSE excluded.%s END", biz.ModerationHidden, biz.ModerationPending, column, column)),
 	}
 }
 
 func (r *ratingRepo) UpsertRating(ctx context.Context, rating *biz.Rating) error {
 	dbRating := &Rating{
-		MovieTitle: rating.MovieTitle,
-		RaterID:    rating.RaterID,
-		Rating:     rating.Rating,
+		MovieTitle:       rating.MovieTitle,
+		RaterID:          rating.RaterID,
+		Rating:           rating.Rating,
+		Review:           rating.Review,
+		RatedAt:          time.Now().UTC(),
+		ModerationStatus: string(rating.ModerationStatus),
+		ModerationReason: rating.ModerationReason,
 	}
 
-	// Use GORM's ON CONFLICT clause for upsert
-	result := r.data.db.WithContext(ctx).Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "movie_title"}, {Name: "rater_id"}},
-		DoUpdates: clause.AssignmentColumns([]string{"rating", "updated_at"}),
-	}).Create(dbRating)
+	// Upsert and audit in one transaction so the history matches the stored value
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the current row (if any) to capture the old value
+		var existing []Rating
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("movie_title = ? AND rater_id = ?", rating.MovieTitle, rating.RaterID).
+			Limit(1).
+			Find(&existing).Error; err != nil {
+			return err
+		}
 
-	if result.Error != nil {
-		return fmt.Errorf("failed to upsert rating: %w", result.Error)
-	}
+		// The row is locked, so the version read is the one the upsert replaces
+		if rating.Version != 0 {
+			var current int64
+			if len(existing) > 0 {
+				current = existing[0].Version
+			}
+			if current != rating.Version {
+				return &biz.VersionConflictError{
+					Resource: "rating",
+					ID:       rating.MovieTitle + "/" + rating.RaterID,
+					Expected: rating.Version,
+					Current:  current,
+				}
+			}
+		}
 
-	// Invalidate rating aggregate cache
-	if r.data.rdb != nil {
-		cacheKey := fmt.Sprintf("rating:agg:%s", rating.MovieTitle)
-		r.data.rdb.Del(ctx, cacheKey)
+		// Use GORM's ON CONFLICT clause for upsert
+		// A new submission replaces the review, so its moderation state is reset
+		// too, except that a review a moderator hid stays hidden, with the
+		// moderator's reason, unless the screener flags the new one
+		if err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "movie_title"}, {Name: "rater_id"}},
+			DoUpdates: append(
+				clause.AssignmentColumns([]string{"rating", "review", "rated_at", "updated_at"}),
+				keepHidden("moderation_status"),
+				keepHidden("moderation_reason"),
+				clause.Assignment{Column: clause.Column{Name: "version"}, Value: gorm.Expr("ratings.version + 1")},
+			),
+		}, clause.Returning{Columns: []clause.Column{
+			{Name: "id"}, {Name: "version"}, {Name: "moderation_status"}, {Name: "moderation_reason"},
+		}}).Create(dbRating).Error; err != nil {
+			return err
+		}
 
-		// Update Redis ZSet for rankings
-		r.updateRankings(ctx, rating.MovieTitle)
+		event := &RatingEvent{
+			RatingID:   dbRating.ID,
+			MovieTitle: rating.MovieTitle,
+			RaterID:    rating.RaterID,
+			EventType:  ratingEventCreated,
+			NewRating:  rating.Rating,
+		}
+		if len(existing) > 0 {
+			event.EventType = ratingEventUpdated
+			event.OldRating = &existing[0].Rating
+		}
+		if client, ok := biz.ClientFromContext(ctx); ok {
+			event.ClientIP = nonEmpty(client.IP)
+			event.UserAgent = nonEmpty(truncate(client.UserAgent, maxUserAgentLength))
+		}
+		return tx.Create(event).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upsert rating: %w", err)
 	}
 
+	// RETURNING populates the ID, version and moderation state for both
+	// inserted and updated rows
+	rating.ID = int64(dbRating.ID)
+	rating.Version = dbRating.Version
+	rating.ModerationStatus = biz.ModerationStatus(dbRating.ModerationStatus)
+	rating.ModerationReason = dbRating.ModerationReason
+	rating.UpdatedAt = dbRating.UpdatedAt
+
+	// Invalidate rating aggregate cache and update Redis ZSet for rankings
+	r.invalidateAggregate(ctx, rating.MovieTitle)
+
 	return nil
 }
 
-func (r *ratingRepo) GetRatingAggregate(ctx context.Context, movieTitle string) (*biz.RatingAggregate, error) {
+func (r *ratingRepo) GetRatingAggregate(ctx context.Context, movieTitle string, window biz.RatingWindow) (*biz.RatingAggregate, error) {
+	cacheKey := aggregateCacheKey(movieTitle, window)
+
 	// Try cache first if Redis is available
 	if r.data.rdb != nil {
-		cacheKey := fmt.Sprintf("rating:agg:%s", movieTitle)
 		cached, err := r.data.rdb.Get(ctx, cacheKey).Result()
 		if err == nil {
 			var agg biz.RatingAggregate
 			if err := json.Unmarshal([]byte(cached), &agg); err == nil {
-				r.log.Debugf("cache hit for rating aggregate: %s", movieTitle)
+				r.log.Debugf("cache hit for rating aggregate: %s (%s)", movieTitle, window)
+				recordCacheLookups(cacheRatingAggregate, 1, 0)
 				return &agg, nil
 			}
 		}
+		recordCacheLookups(cacheRatingAggregate, 0, 1)
 	}
 
 	// Query from database
 	var result struct {
-		Average float64
-		Count   int32
+		Average      float64
+		Count        int32
+		LastModified *time.Time
 	}
 
-	err := r.data.db.WithContext(ctx).
-		Model(&Rating{}).
-		Select("ROUND(AVG(rating)::numeric, 1) as average, COUNT(*) as count").
-		Where("movie_title = ?", movieTitle).
-		Scan(&result).Error
+	var err error
+	if window == biz.WindowAll {
+		// Hidden ratings are not counted, but hiding one changes the aggregate
+		err = r.data.db.WithContext(ctx).
+			Model(&Rating{}).
+			Select("ROUND((AVG(rating) FILTER (WHERE moderation_status <> ?))::numeric, 1) as average, "+
+				"COUNT(*) FILTER (WHERE moderation_status <> ?) as count, MAX(updated_at) as last_modified",
+				biz.ModerationHidden, biz.ModerationHidden).
+			Where("movie_title = ?", movieTitle).
+			Scan(&result).Error
+	} else {
+		// Windowed aggregates read the daily rollups instead of scanning ratings
+		err = r.data.db.WithContext(ctx).
+			Model(&RatingDailyStat{}).
+			Select("COALESCE(ROUND((SUM(rating_sum) / NULLIF(SUM(rating_count), 0))::numeric, 1), 0) as average, COALESCE(SUM(rating_count), 0) as count").
+			Where("movie_title = ? AND day > ?", movieTitle, windowStartDay(window, time.Now())).
+			Scan(&result).Error
+	}
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get rating aggregate: %w", err)
@@ -90,9 +198,38 @@ func (r *ratingRepo) GetRatingAggregate(ctx context.Context, movieTitle string)
 		Count:   result.Count,
 	}
 
+	// Apply an active anomaly review; the frozen value is an all-time average
+	review, err := r.anomalyReview(ctx, movieTitle)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if review != nil && review.ExpiresAt.After(now) {
+		agg.UnderReview = true
+		if review.FrozenAverage != nil && window == biz.WindowAll {
+			agg.Average = *review.FrozenAverage
+		}
+	}
+
+	// An all-time aggregate last changed with the latest write to a rating, or
+	// when a review froze the average or released it
+	if window == biz.WindowAll {
+		if result.LastModified != nil {
+			agg.LastModified = result.LastModified.UTC()
+		}
+		if review != nil {
+			changed := review.StartedAt
+			if !review.ExpiresAt.After(now) {
+				changed = review.ExpiresAt
+			}
+			if changed.After(agg.LastModified) {
+				agg.LastModified = changed.UTC()
+			}
+		}
+	}
+
 	// Cache result if Redis is available
 	if r.data.rdb != nil {
-		cacheKey := fmt.Sprintf("rating:agg:%s", movieTitle)
 		if data, err := json.Marshal(agg); err == nil {
 			r.data.rdb.Set(ctx, cacheKey, data, 15*time.Minute)
 		}
@@ -101,22 +238,178 @@ func (r *ratingRepo) GetRatingAggregate(ctx context.Context, movieTitle string)
 	return agg, nil
 }
 
+func (r *ratingRepo) MarkUnderReview(ctx context.Context, review *biz.AggregateReview) (bool, error) {
+	dbReview := &RatingAnomalyReview{
+		MovieTitle:    review.MovieTitle,
+		Reason:        review.Reason,
+		FrozenAverage: review.FrozenAverage,
+		StartedAt:     review.StartedAt,
+		ExpiresAt:     review.ExpiresAt,
+	}
+
+	// Insert, or replace an expired review; an active review is kept as-is
+	// so the frozen value stays at the pre-burst average of the first trigger
+	result := r.data.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "movie_title"}},
+		DoUpdates: clause.AssignmentColumns([]string{"reason", "frozen_average", "started_at", "expires_at"}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: "rating_anomaly_reviews.expires_at < ?", Vars: []interface{}{review.StartedAt}},
+		}},
+	}).Create(dbReview)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to mark movie under review: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	if r.data.rdb != nil {
+		r.data.rdb.Del(ctx, aggregateCacheKeys(review.MovieTitle)...)
+
+		// Rankings use the frozen value as well
+		if review.FrozenAverage != nil {
+			segments, err := movieRankingSegments(ctx, r.data.db, review.MovieTitle)
+			if err != nil {
+				r.log.Warnf("failed to freeze ranking segments: %v", err)
+			}
+			for _, key := range rankingKeys(topRankingKey, segments) {
+				r.data.rdb.ZAdd(ctx, key, redis.Z{
+					Score:  *review.FrozenAverage,
+					Member: review.MovieTitle,
+				})
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// activeReview returns the unexpired anomaly review for a movie, if any
+func (r *ratingRepo) activeReview(ctx context.Context, movieTitle string) (*RatingAnomalyReview, error) {
+	review, err := r.anomalyReview(ctx, movieTitle)
+	if err != nil || review == nil || !review.ExpiresAt.After(time.Now()) {
+		return nil, err
+	}
+	return review, nil
+}
+
+// anomalyReview returns the latest anomaly review of a movie, active or not
+func (r *ratingRepo) anomalyReview(ctx context.Context, movieTitle string) (*RatingAnomalyReview, error) {
+	var reviews []RatingAnomalyReview
+	err := r.data.db.WithContext(ctx).
+		Where("movie_title = ?", movieTitle).
+		Limit(1).
+		Find(&reviews).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get anomaly review: %w", err)
+	}
+	if len(reviews) == 0 {
+		return nil, nil
+	}
+	return &reviews[0], nil
+}
+
+func (r *ratingRepo) ListRatingEvents(ctx context.Context, query *biz.RatingHistoryQuery) (*biz.RatingHistoryPage, error) {
+	db := r.data.db.WithContext(ctx).Model(&RatingEvent{}).Where("movie_title = ?", query.MovieTitle)
+
+	// Keyset pagination: the cursor holds the last event ID seen (newest first)
+	if query.Cursor != nil && *query.Cursor != "" {
+		lastID, err := decodeCursor(*query.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor: %w", err)
+		}
+		db = db.Where("id < ?", lastID)
+	}
+
+	// Apply filters
+	if query.RaterID != nil {
+		db = db.Where("rater_id = ?", *query.RaterID)
+	}
+	if query.Since != nil {
+		db = db.Where("created_at >= ?", *query.Since)
+	}
+	if query.Until != nil {
+		db = db.Where("created_at < ?", *query.Until)
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	var dbEvents []RatingEvent
+	if err := db.Order("id DESC").Limit(int(limit + 1)).Find(&dbEvents).Error; err != nil {
+		return nil, fmt.Errorf("failed to list rating events: %w", err)
+	}
+
+	hasMore := len(dbEvents) > int(limit)
+	if hasMore {
+		dbEvents = dbEvents[:limit]
+	}
+
+	items := make([]*biz.RatingEvent, 0, len(dbEvents))
+	for i := range dbEvents {
+		e := &dbEvents[i]
+		items = append(items, &biz.RatingEvent{
+			ID:         e.ID,
+			RatingID:   int64(e.RatingID),
+			MovieTitle: e.MovieTitle,
+			RaterID:    e.RaterID,
+			EventType:  e.EventType,
+			OldRating:  e.OldRating,
+			NewRating:  e.NewRating,
+			ClientIP:   e.ClientIP,
+			UserAgent:  e.UserAgent,
+			CreatedAt:  e.CreatedAt,
+		})
+	}
+
+	result := &biz.RatingHistoryPage{
+		Items: items,
+	}
+
+	if hasMore {
+		nextCursor := encodeCursor(int(dbEvents[len(dbEvents)-1].ID))
+		result.NextCursor = &nextCursor
+	}
+
+	return result, nil
+}
+
+// invalidateAggregate drops the cached aggregate and refreshes rankings
+// after the set of visible ratings for a movie changed
+func (r *ratingRepo) invalidateAggregate(ctx context.Context, movieTitle string) {
+	if r.data.rdb == nil {
+		return
+	}
+	r.data.rdb.Del(ctx, aggregateCacheKeys(movieTitle)...)
+	r.updateRankings(ctx, movieTitle)
+}
+
 // updateRankings updates Redis ZSet rankings
 func (r *ratingRepo) updateRankings(ctx context.Context, movieTitle string) {
 	if r.data.rdb == nil {
 		return
 	}
 
+	epoch, err := trendingEpoch(ctx, r.data.rdb)
+	if err != nil {
+		r.log.Warnf("failed to get trending epoch for ranking update: %v", err)
+		return
+	}
+
 	// Get movie aggregate
 	var result struct {
-		Average float64
-		Count   int32
+		Average  float64
+		Count    int32
+		Trending float64
 	}
 
-	err := r.data.db.WithContext(ctx).
+	err = r.data.db.WithContext(ctx).
 		Model(&Rating{}).
-		Select("AVG(rating) as average, COUNT(*) as count").
-		Where("movie_title = ?", movieTitle).
+		Select("AVG(rating) as average, COUNT(*) as count, "+trendingSumSQL+" as trending",
+			epoch, r.halfLife.Seconds(), trendingMinExponent).
+		Where("movie_title = ? AND moderation_status <> ?", movieTitle, biz.ModerationHidden).
 		Scan(&result).Error
 
 	if err != nil {
@@ -124,17 +417,103 @@ func (r *ratingRepo) updateRankings(ctx context.Context, movieTitle string) {
 		return
 	}
 
-	// Update popular movies ranking (by rating count)
-	r.data.rdb.ZAdd(ctx, "rank:movies:popular", redis.Z{
-		Score:  float64(result.Count),
-		Member: movieTitle,
-	})
+	segments, err := movieRankingSegments(ctx, r.data.db, movieTitle)
+	if err != nil {
+		r.log.Warnf("failed to get segments for ranking update: %v", err)
+		return
+	}
 
-	// Update top-rated movies ranking (by average rating)
-	if result.Count > 0 {
-		r.data.rdb.ZAdd(ctx, "rank:movies:top", redis.Z{
-			Score:  result.Average,
+	// Update popular movies rankings (by rating count)
+	for _, key := range rankingKeys(popularRankingKey, segments) {
+		r.data.rdb.ZAdd(ctx, key, redis.Z{
+			Score:  float64(result.Count),
 			Member: movieTitle,
 		})
 	}
+
+	// Keep the frozen scores while the movie is under review
+	review, err := r.activeReview(ctx, movieTitle)
+	if err != nil {
+		r.log.Warnf("failed to get anomaly review for ranking update: %v", err)
+		return
+	}
+	if review != nil && review.FrozenAverage != nil {
+		return
+	}
+
+	// Update top-rated movies rankings (by average rating)
+	for _, key := range rankingKeys(topRankingKey, segments) {
+		if result.Count > 0 {
+			r.data.rdb.ZAdd(ctx, key, redis.Z{
+				Score:  result.Average,
+				Member: movieTitle,
+			})
+		} else {
+			// All visible ratings are gone (e.g. hidden by moderation)
+			r.data.rdb.ZRem(ctx, key, movieTitle)
+		}
+	}
+
+	// Update trending movies ranking (by decayed rating activity)
+	if err := setTrendingScore(ctx, r.data.rdb, movieTitle, result.Trending, epoch, r.halfLife); err != nil {
+		r.log.Warnf("failed to update trending score: %v", err)
+	}
+}
+
+// ratingToBiz converts data.Rating to biz.Rating
+func ratingToBiz(m *Rating) *biz.Rating {
+	return &biz.Rating{
+		ID:               int64(m.ID),
+		MovieTitle:       m.MovieTitle,
+		RaterID:          m.RaterID,
+		Rating:           m.Rating,
+		Review:           m.Review,
+		ModerationStatus: biz.ModerationStatus(m.ModerationStatus),
+		ModerationReason: m.ModerationReason,
+		UpdatedAt:        m.UpdatedAt,
+		Version:          m.Version,
+	}
+}
+
+// nonEmpty returns nil for an empty string
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// truncate cuts s to at most n bytes without splitting a UTF-8 sequence
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// aggregateCacheKey returns the cache key of a movie's aggregate in a window.
+// The window is part of the prefix so titles containing ':' cannot collide.
+func aggregateCacheKey(movieTitle string, window biz.RatingWindow) string {
+	if window == biz.WindowAll {
+		return fmt.Sprintf("rating:agg:%s", movieTitle)
+	}
+	return fmt.Sprintf("rating:agg_%s:%s", window, movieTitle)
+}
+
+// aggregateCacheKeys returns the cache keys of a movie's aggregate in every window
+func aggregateCacheKeys(movieTitle string) []string {
+	keys := make([]string, 0, len(biz.RatingWindows))
+	for _, window := range biz.RatingWindows {
+		keys = append(keys, aggregateCacheKey(movieTitle, window))
+	}
+	return keys
+}
+
+// windowStartDay returns the last UTC day before the window ending at now
+// (exclusive bound), so the window covers today and the days before it
+func windowStartDay(window biz.RatingWindow, now time.Time) string {
+	return now.UTC().AddDate(0, 0, -int(window)).Format("2006-01-02")
 }
@@ -0,0 +1,62 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"src/internal/biz"
+)
+
+func TestWindowStartDay(t *testing.T) {
+	tests := []struct {
+		name   string
+		window biz.RatingWindow
+		now    time.Time
+		want   string
+	}{
+		{
+			name:   "7d covers today and the six days before",
+			window: biz.Window7d,
+			now:    time.Date(2026, 10, 19, 15, 0, 0, 0, time.UTC),
+			want:   "2026-10-12",
+		},
+		{
+			name:   "just after midnight",
+			window: biz.Window7d,
+			now:    time.Date(2026, 10, 19, 0, 0, 1, 0, time.UTC),
+			want:   "2026-10-12",
+		},
+		{
+			name:   "just before midnight",
+			window: biz.Window7d,
+			now:    time.Date(2026, 10, 19, 23, 59, 59, 0, time.UTC),
+			want:   "2026-10-12",
+		},
+		{
+			name:   "30d across a month boundary",
+			window: biz.Window30d,
+			now:    time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC),
+			want:   "2026-02-08",
+		},
+		{
+			name:   "365d across a leap day",
+			window: biz.Window365d,
+			now:    time.Date(2028, 3, 1, 12, 0, 0, 0, time.UTC),
+			want:   "2027-03-02",
+		},
+		{
+			name:   "days are UTC days",
+			window: biz.Window7d,
+			now:    time.Date(2026, 10, 20, 2, 0, 0, 0, time.FixedZone("CST", 8*3600)),
+			want:   "2026-10-12",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := windowStartDay(tt.window, tt.now); got != tt.want {
+				t.Errorf("windowStartDay(%s) = %s, want %s", tt.window, got, tt.want)
+			}
+		})
+	}
+}
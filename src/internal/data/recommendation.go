@@ -0,0 +1,135 @@
+package data
+
+import (
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"src/internal/biz"
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultModelPath is used when no model path is configured
+const defaultModelPath = "recommender.gob"
+
+type recommendationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewRecommendationRepo creates a new recommendation repository
+func NewRecommendationRepo(data *Data, logger log.Logger) biz.RecommendationRepo {
+	return &recommendationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *recommendationRepo) ListTrainingRatings(ctx context.Context) ([]*biz.Rating, error) {
+	var rows []Rating
+	err := r.data.db.WithContext(ctx).
+		Select("ratings.movie_title, ratings.rater_id, ratings.rating").
+		Joins("JOIN movies ON movies.title_key = ratings.movie_title AND movies.deleted_at IS NULL").
+		Where("ratings.moderation_status <> ?", biz.ModerationHidden).
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list training ratings: %w", err)
+	}
+
+	ratings := make([]*biz.Rating, 0, len(rows))
+	for i := range rows {
+		ratings = append(ratings, &biz.Rating{
+			MovieTitle: rows[i].MovieTitle,
+			RaterID:    rows[i].RaterID,
+			Rating:     rows[i].Rating,
+		})
+	}
+	return ratings, nil
+}
+
+func (r *recommendationRepo) ListRatedTitles(ctx context.Context, raterID string) ([]string, error) {
+	var titles []string
+	err := r.data.db.WithContext(ctx).
+		Model(&Rating{}).
+		Where("rater_id = ?", raterID).
+		Pluck("movie_title", &titles).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rated movies: %w", err)
+	}
+	return titles, nil
+}
+
+type fileModelStore struct {
+	path string
+	log  *log.Helper
+}
+
+// NewRecommenderModelStore creates a model store backed by a local file.
+// Models are gob-encoded and replaced atomically so readers never see a partial file.
+func NewRecommenderModelStore(c *conf.Recommender, logger log.Logger) biz.RecommenderModelStore {
+	path := c.GetModelPath()
+	if path == "" {
+		path = defaultModelPath
+	}
+	return &fileModelStore{
+		path: path,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (s *fileModelStore) Save(ctx context.Context, model *biz.RecommenderModel) error {
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create model file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := gob.NewEncoder(tmp).Encode(model); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to encode model: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write model file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to replace model file: %w", err)
+	}
+
+	s.log.Infof("saved recommendation model to %s", s.path)
+	return nil
+}
+
+func (s *fileModelStore) Load(ctx context.Context) (*biz.RecommenderModel, error) {
+	f, err := os.Open(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, biz.ErrModelNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open model file: %w", err)
+	}
+	defer f.Close()
+
+	var model biz.RecommenderModel
+	if err := gob.NewDecoder(f).Decode(&model); err != nil {
+		return nil, fmt.Errorf("failed to decode model: %w", err)
+	}
+	return &model, nil
+}
+
+func (s *fileModelStore) Version(ctx context.Context) (time.Time, error) {
+	info, err := os.Stat(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return time.Time{}, biz.ErrModelNotFound
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to stat model file: %w", err)
+	}
+	return info.ModTime(), nil
+}
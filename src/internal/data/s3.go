@@ -0,0 +1,188 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const defaultS3Timeout = 10 * time.Second
+
+// s3BlobStore keeps files in a bucket of an S3-compatible service (AWS S3,
+// MinIO...). Requests are signed with AWS Signature Version 4.
+type s3BlobStore struct {
+	endpoint  *url.URL
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	pathStyle bool
+	publicURL string
+	client    *http.Client
+	log       *log.Helper
+}
+
+func newS3BlobStore(c *conf.Media_S3, logger log.Logger) (*s3BlobStore, error) {
+	if c.GetEndpoint() == "" || c.GetBucket() == "" {
+		return nil, fmt.Errorf("s3 media storage requires an endpoint and a bucket")
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(c.GetEndpoint(), "/"))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", c.GetEndpoint())
+	}
+
+	timeout := defaultS3Timeout
+	if c.GetTimeout() != nil {
+		timeout = c.GetTimeout().AsDuration()
+	}
+	s := &s3BlobStore{
+		endpoint:  endpoint,
+		region:    c.GetRegion(),
+		bucket:    c.GetBucket(),
+		accessKey: c.GetAccessKey(),
+		secretKey: c.GetSecretKey(),
+		pathStyle: c.GetPathStyle(),
+		publicURL: strings.TrimSuffix(c.GetPublicUrl(), "/"),
+		client:    &http.Client{Timeout: timeout},
+		log:       log.NewHelper(logger),
+	}
+	if s.region == "" {
+		s.region = "us-east-1"
+	}
+	if s.publicURL == "" {
+		s.publicURL = s.objectURL("")
+	}
+	return s, nil
+}
+
+func (s *s3BlobStore) Put(ctx context.Context, key, contentType string, data []byte) (string, error) {
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+	header.Set("Cache-Control", "public, max-age=31536000, immutable")
+	if err := s.do(ctx, http.MethodPut, key, header, data); err != nil {
+		return "", fmt.Errorf("failed to upload %s: %w", key, err)
+	}
+	return s.publicURL + "/" + escapePath(key), nil
+}
+
+func (s *s3BlobStore) Delete(ctx context.Context, keys ...string) error {
+	var errs []error
+	for _, key := range keys {
+		// Deleting a missing object succeeds in S3
+		if err := s.do(ctx, http.MethodDelete, key, http.Header{}, nil); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete %s: %w", key, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// objectURL returns the URL of an object in path or virtual-hosted style
+func (s *s3BlobStore) objectURL(key string) string {
+	host, path := s.endpoint.Host, s.endpoint.EscapedPath()
+	if s.pathStyle {
+		path += "/" + escapePath(s.bucket)
+	} else {
+		host = s.bucket + "." + host
+	}
+	if key != "" {
+		path += "/" + escapePath(key)
+	}
+	return s.endpoint.Scheme + "://" + host + path
+}
+
+// do sends a signed request for an object and fails on a non-2xx response
+func (s *s3BlobStore) do(ctx context.Context, method, key string, header http.Header, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	s.sign(req, body, time.Now().UTC())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("s3 returned %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to req
+func (s *s3BlobStore) sign(req *http.Request, body []byte, now time.Time) {
+	payloadHash := sha256Hex(body)
+	amzDate := now.Format("20060102T150405Z")
+	day := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		"", // no query string
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := day + "/" + s.region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), day)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKey, scope, signedHeaders, signature))
+}
+
+// escapePath URI-encodes an object key as SigV4 requires: everything but
+// unreserved characters and '/'
+func escapePath(key string) string {
+	var b strings.Builder
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || c == '/' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,175 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"src/internal/biz"
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultChangeWindow        = time.Hour
+	defaultMaxChangesPerWindow = 20
+)
+
+// NewContentScreener creates the automatic screening chain for ratings
+func NewContentScreener(c *conf.Moderation, data *Data, logger log.Logger) biz.ContentScreener {
+	l := log.NewHelper(logger)
+
+	var screeners []biz.ContentScreener
+	if words := parseWordList(c.GetBlockedWords()); len(words) > 0 {
+		screeners = append(screeners, &wordListScreener{words: words})
+	}
+
+	window := defaultChangeWindow
+	if c.GetChangeWindow() != nil && c.GetChangeWindow().AsDuration() > 0 {
+		window = c.GetChangeWindow().AsDuration()
+	}
+	maxChanges := int64(defaultMaxChangesPerWindow)
+	if c.GetMaxChangesPerWindow() > 0 {
+		maxChanges = int64(c.GetMaxChangesPerWindow())
+	}
+	screeners = append(screeners, &rateOfChangeScreener{
+		data:       data,
+		window:     window,
+		maxChanges: maxChanges,
+		local:      make(map[string]*windowCounter),
+	})
+
+	return &screenerChain{screeners: screeners, log: l}
+}
+
+// screenerChain runs screeners in order and returns the first flag
+type screenerChain struct {
+	screeners []biz.ContentScreener
+	log       *log.Helper
+}
+
+func (s *screenerChain) Screen(ctx context.Context, rating *biz.Rating) (*biz.ScreenVerdict, error) {
+	for _, screener := range s.screeners {
+		verdict, err := screener.Screen(ctx, rating)
+		if err != nil {
+			// A failing screener must not block the others
+			s.log.Warnf("content screener failed: %v", err)
+			continue
+		}
+		if verdict.Flagged {
+			return verdict, nil
+		}
+	}
+	return &biz.ScreenVerdict{}, nil
+}
+
+// wordListScreener flags reviews containing blocked words or phrases
+type wordListScreener struct {
+	words []string
+}
+
+func (s *wordListScreener) Screen(ctx context.Context, rating *biz.Rating) (*biz.ScreenVerdict, error) {
+	if rating.Review == nil || *rating.Review == "" {
+		return &biz.ScreenVerdict{}, nil
+	}
+
+	text := strings.ToLower(*rating.Review)
+	tokens := make(map[string]bool)
+	for _, token := range strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}) {
+		tokens[token] = true
+	}
+
+	for _, word := range s.words {
+		// Phrases are matched as substrings, single words as whole tokens
+		if tokens[word] || (strings.Contains(word, " ") && strings.Contains(text, word)) {
+			return &biz.ScreenVerdict{Flagged: true, Reason: fmt.Sprintf("blocked word: %s", word)}, nil
+		}
+	}
+	return &biz.ScreenVerdict{}, nil
+}
+
+// rateOfChangeScreener flags raters who submit or change ratings unusually often.
+// Counters live in Redis so they hold across replicas, with an in-process fallback.
+type rateOfChangeScreener struct {
+	data       *Data
+	window     time.Duration
+	maxChanges int64
+
+	mu    sync.Mutex
+	local map[string]*windowCounter
+}
+
+type windowCounter struct {
+	count   int64
+	resetAt time.Time
+}
+
+func (s *rateOfChangeScreener) Screen(ctx context.Context, rating *biz.Rating) (*biz.ScreenVerdict, error) {
+	count, err := s.increment(ctx, rating.RaterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if count > s.maxChanges {
+		return &biz.ScreenVerdict{
+			Flagged: true,
+			Reason:  fmt.Sprintf("rater submitted %d ratings within %s", count, s.window),
+		}, nil
+	}
+	return &biz.ScreenVerdict{}, nil
+}
+
+// increment bumps the fixed-window counter for a rater and returns the new count
+func (s *rateOfChangeScreener) increment(ctx context.Context, raterID string) (int64, error) {
+	if s.data.rdb != nil {
+		key := fmt.Sprintf("moderation:changes:%s", raterID)
+		count, err := s.data.rdb.Incr(ctx, key).Result()
+		if err != nil {
+			return 0, fmt.Errorf("failed to increment change counter: %w", err)
+		}
+		if count == 1 {
+			s.data.rdb.Expire(ctx, key, s.window)
+		}
+		return count, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	counter, ok := s.local[raterID]
+	if !ok || now.After(counter.resetAt) {
+		counter = &windowCounter{resetAt: now.Add(s.window)}
+		s.local[raterID] = counter
+		s.pruneLocked(now)
+	}
+	counter.count++
+	return counter.count, nil
+}
+
+// pruneLocked drops expired counters; caller must hold s.mu
+func (s *rateOfChangeScreener) pruneLocked(now time.Time) {
+	for raterID, counter := range s.local {
+		if now.After(counter.resetAt) {
+			delete(s.local, raterID)
+		}
+	}
+}
+
+// parseWordList parses a comma-separated word list into lowercase entries
+func parseWordList(list string) []string {
+	var words []string
+	for _, word := range strings.Split(list, ",") {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+	return words
+}
@@ -0,0 +1,123 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"src/internal/biz"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// Global leaderboard ZSets. Each also has per-segment copies holding only the
+// movies of that primary genre, release year or MPA rating, e.g.
+// rank:movies:top:genre:science-fiction. Genre segments are named by genre ID.
+const (
+	topRankingKey     = "rank:movies:top"
+	popularRankingKey = "rank:movies:popular"
+)
+
+// rankingSegments returns the leaderboard segments a movie belongs to. The
+// genre column holds the canonical name of the primary genre, whose key's slug
+// is the genre's ID.
+func rankingSegments(m *Movie) []string {
+	segments := []string{
+		"genre:" + biz.GenreSlug(biz.GenreKey(m.Genre)),
+		"year:" + strconv.Itoa(m.ReleaseDate.Year()),
+	}
+	if m.MPARating != nil && *m.MPARating != "" {
+		segments = append(segments, "mpa:"+normalizeSegment(*m.MPARating))
+	}
+	return segments
+}
+
+// querySegments returns the segments selected by a ranking query, resolving
+// its genre through the aliases
+func querySegments(ctx context.Context, db *gorm.DB, query *biz.RankingQuery) ([]string, error) {
+	var segments []string
+	if query.Genre != nil {
+		id, err := genreID(ctx, db, *query.Genre)
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, "genre:"+id)
+	}
+	if query.Year != nil {
+		segments = append(segments, "year:"+strconv.Itoa(int(*query.Year)))
+	}
+	if query.MPARating != nil {
+		segments = append(segments, "mpa:"+normalizeSegment(*query.MPARating))
+	}
+	return segments, nil
+}
+
+// normalizeSegment makes segment values case-insensitive
+func normalizeSegment(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// segmentKeys returns the per-segment keys of a leaderboard
+func segmentKeys(base string, segments []string) []string {
+	keys := make([]string, 0, len(segments))
+	for _, s := range segments {
+		keys = append(keys, base+":"+s)
+	}
+	return keys
+}
+
+// rankingKeys returns the global key of a leaderboard followed by its segment keys
+func rankingKeys(base string, segments []string) []string {
+	return append([]string{base}, segmentKeys(base, segments)...)
+}
+
+// movieRankingSegments looks up the segments of a movie by key.
+// Returns no segments if the movie no longer exists.
+func movieRankingSegments(ctx context.Context, db *gorm.DB, movieTitle string) ([]string, error) {
+	var movie Movie
+	err := db.WithContext(ctx).Where("title_key = ?", movieTitle).First(&movie).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get movie for ranking segments: %w", err)
+	}
+	return rankingSegments(&movie), nil
+}
+
+// moveRankingSegments moves a movie's leaderboard entries when an edit changes
+// its segments, keeping the scores from the global leaderboards
+func moveRankingSegments(ctx context.Context, rdb *redis.Client, oldMovie, newMovie *Movie) error {
+	oldSegments := rankingSegments(oldMovie)
+	newSegments := rankingSegments(newMovie)
+	if oldMovie.TitleKey == newMovie.TitleKey && slices.Equal(oldSegments, newSegments) {
+		return nil
+	}
+
+	for _, base := range []string{topRankingKey, popularRankingKey} {
+		score, err := rdb.ZScore(ctx, base, oldMovie.TitleKey).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return fmt.Errorf("failed to read ranking %s: %w", base, err)
+		}
+		ranked := err == nil
+
+		for _, key := range segmentKeys(base, oldSegments) {
+			if err := rdb.ZRem(ctx, key, oldMovie.TitleKey).Err(); err != nil {
+				return fmt.Errorf("failed to update ranking %s: %w", key, err)
+			}
+		}
+		if !ranked {
+			continue
+		}
+		for _, key := range segmentKeys(base, newSegments) {
+			if err := rdb.ZAdd(ctx, key, redis.Z{Score: score, Member: newMovie.TitleKey}).Err(); err != nil {
+				return fmt.Errorf("failed to update ranking %s: %w", key, err)
+			}
+		}
+	}
+	return nil
+}
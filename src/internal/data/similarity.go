@@ -0,0 +1,181 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"src/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// computeSimilaritiesLockID is the Postgres advisory lock held while
+// similarities are computed, so that replicas take turns ("similar" in ASCII)
+const computeSimilaritiesLockID int64 = 0x73696d696c6172
+
+// computeSimilaritiesSQL computes item-to-item adjusted cosine similarity:
+// ratings are centered on each rater's mean so generous and harsh raters
+// compare fairly, then each movie pair is scored over the raters who rated both.
+// Only positive similarities are kept, top_k per movie.
+const computeSimilaritiesSQL = `
+INSERT INTO movie_similarities (movie_title, similar_title, similarity, co_raters, computed_at)
+WITH centered AS (
+    SELECT movie_title, rater_id,
+           rating - AVG(rating) OVER (PARTITION BY rater_id) AS r
+    FROM ratings
+    WHERE moderation_status <> @hidden
+),
+pairs AS (
+    SELECT a.movie_title, b.movie_title AS similar_title,
+           (SUM(a.r * b.r) / NULLIF(SQRT(SUM(a.r * a.r)) * SQRT(SUM(b.r * b.r)), 0))::float8 AS similarity,
+           COUNT(*) AS co_raters
+    FROM centered a
+    JOIN centered b ON b.rater_id = a.rater_id AND b.movie_title <> a.movie_title
+    GROUP BY a.movie_title, b.movie_title
+    HAVING COUNT(*) >= @min_co_raters
+),
+ranked AS (
+    SELECT *, ROW_NUMBER() OVER (
+               PARTITION BY movie_title
+               ORDER BY similarity DESC, co_raters DESC, similar_title
+           ) AS rank
+    FROM pairs
+    WHERE similarity > 0
+)
+SELECT movie_title, similar_title, similarity, co_raters, NOW()
+FROM ranked
+WHERE rank <= @top_k`
+
+// Content-based similarity weights; a movie sharing all three attributes scores 1
+const (
+	contentGenreWeight       = 0.5
+	contentDistributorWeight = 0.3
+	contentMPARatingWeight   = 0.2
+)
+
+type similarityRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSimilarityRepo creates a new similarity repository
+func NewSimilarityRepo(data *Data, logger log.Logger) biz.SimilarityRepo {
+	return &similarityRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *similarityRepo) ComputeSimilarities(ctx context.Context, topK, minCoRaters int32, minAge time.Duration) (int64, error) {
+	var pairs int64
+
+	// Replace in one transaction so readers never see a partial result
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Every replica runs the job; one computes at a time and the others
+		// skip rather than wait to redo the same work
+		var locked bool
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", computeSimilaritiesLockID).Row().Scan(&locked); err != nil {
+			return err
+		}
+		if !locked {
+			return biz.ErrSimilaritiesSkipped
+		}
+		var computedAt sql.NullTime
+		if err := tx.Raw("SELECT MAX(computed_at) FROM movie_similarities").Row().Scan(&computedAt); err != nil {
+			return err
+		}
+		if computedAt.Valid && time.Since(computedAt.Time) < minAge {
+			return biz.ErrSimilaritiesSkipped
+		}
+
+		if err := tx.Exec("DELETE FROM movie_similarities").Error; err != nil {
+			return err
+		}
+		result := tx.Exec(computeSimilaritiesSQL, map[string]interface{}{
+			"hidden":        biz.ModerationHidden,
+			"min_co_raters": minCoRaters,
+			"top_k":         topK,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		pairs = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to compute similarities: %w", err)
+	}
+
+	return pairs, nil
+}
+
+func (r *similarityRepo) ListSimilar(ctx context.Context, movieTitle string, limit int32) ([]*biz.SimilarMovie, error) {
+	var rows []MovieSimilarity
+	err := r.data.db.WithContext(ctx).
+		Joins("JOIN movies ON movies.title_key = movie_similarities.similar_title AND movies.deleted_at IS NULL").
+		Where("movie_similarities.movie_title = ?", movieTitle).
+		Order("movie_similarities.similarity DESC, movie_similarities.co_raters DESC").
+		Limit(int(limit)).
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list similar movies: %w", err)
+	}
+
+	similar := make([]*biz.SimilarMovie, 0, len(rows))
+	for _, row := range rows {
+		similar = append(similar, &biz.SimilarMovie{
+			Title:      row.SimilarTitle,
+			Similarity: row.Similarity,
+			CoRaters:   row.CoRaters,
+			Source:     biz.SimilarityCollaborative,
+		})
+	}
+	return similar, nil
+}
+
+func (r *similarityRepo) ListContentSimilar(ctx context.Context, movie *biz.Movie, exclude []string, limit int32) ([]*biz.SimilarMovie, error) {
+	// Score one weighted term per attribute the movie has
+	terms := []string{"CASE WHEN id IN (?) THEN ? ELSE 0 END"}
+	args := []interface{}{primaryGenreMovieIDs(r.data.db.WithContext(ctx), movie.Genre), contentGenreWeight}
+	if movie.Distributor != nil && *movie.Distributor != "" {
+		terms = append(terms, "CASE WHEN LOWER(distributor) = LOWER(?) THEN ? ELSE 0 END")
+		args = append(args, *movie.Distributor, contentDistributorWeight)
+	}
+	if movie.MPARating != nil && *movie.MPARating != "" {
+		terms = append(terms, "CASE WHEN mpa_rating = ? THEN ? ELSE 0 END")
+		args = append(args, *movie.MPARating, contentMPARatingWeight)
+	}
+	score := "(" + strings.Join(terms, " + ") + ")"
+
+	var rows []struct {
+		Title      string
+		Similarity float64
+	}
+	err := r.data.db.WithContext(ctx).
+		Model(&Movie{}).
+		Select("title_key AS title, "+score+"::float8 as similarity", args...).
+		Where(score+" > 0", args...).
+		Where("title_key NOT IN ?", exclude).
+		// Among equal scores prefer movies released close to this one
+		Order(clause.Expr{SQL: "similarity DESC, ABS(release_date - ?::date), title_key", Vars: []interface{}{movie.ReleaseDate}}).
+		Limit(int(limit)).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list content-similar movies: %w", err)
+	}
+
+	similar := make([]*biz.SimilarMovie, 0, len(rows))
+	for _, row := range rows {
+		similar = append(similar, &biz.SimilarMovie{
+			Title:      row.Title,
+			Similarity: row.Similarity,
+			Source:     biz.SimilarityContent,
+		})
+	}
+	return similar, nil
+}
@@ -0,0 +1,346 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"src/internal/biz"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// resolveTitle finds the movie a title refers to, following the rule on
+// biz.MovieRepo.GetMovieByTitle. exact reports whether the movie holds the
+// title itself; only those lookups are cached under the title.
+func (r *movieRepo) resolveTitle(ctx context.Context, title string) (movie *Movie, exact bool, err error) {
+	// 1. Exact title
+	var matches []Movie
+	err = r.data.db.WithContext(ctx).
+		Where("title = ?", title).
+		Order("release_date, id").
+		Find(&matches).Error
+	if err != nil {
+		return nil, false, err
+	}
+	if len(matches) == 1 {
+		return &matches[0], true, nil
+	}
+	if len(matches) > 1 {
+		return nil, false, r.ambiguousTitle(title, matches)
+	}
+
+	// 2. "Title (YYYY)"
+	if base, year, ok := biz.ParseDisambiguatedTitle(title); ok {
+		var m Movie
+		err = r.data.db.WithContext(ctx).
+			Where("title = ? AND EXTRACT(YEAR FROM release_date) = ?", base, year).
+			First(&m).Error
+		if err == nil {
+			return &m, false, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, err
+		}
+	}
+
+	// 3. Alternate titles
+	err = r.data.db.WithContext(ctx).
+		Where("id IN (?)", r.data.db.WithContext(ctx).Model(&AlternateTitle{}).Select("movie_id").Where("title = ?", title)).
+		Order("release_date, id").
+		Find(&matches).Error
+	if err != nil {
+		return nil, false, err
+	}
+	if len(matches) == 1 {
+		return &matches[0], false, nil
+	}
+	if len(matches) > 1 {
+		return nil, false, r.ambiguousTitle(title, matches)
+	}
+
+	// 4. Movie ID, which the Location of a created movie refers to
+	if _, err := uuid.Parse(title); err == nil {
+		var m Movie
+		err = r.data.db.WithContext(ctx).Where("id = ?", title).First(&m).Error
+		if err != nil {
+			return nil, false, err
+		}
+		return &m, false, nil
+	}
+	return nil, false, gorm.ErrRecordNotFound
+}
+
+// titleResolution is the outcome of resolveTitle for one title
+type titleResolution struct {
+	movie *Movie
+	exact bool
+	err   error // Ambiguity
+}
+
+// resolveTitles resolves several titles like resolveTitle, with one query per
+// step for all titles still unresolved. Titles that match nothing are left
+// out of the result.
+func (r *movieRepo) resolveTitles(ctx context.Context, titles []string) (map[string]*titleResolution, error) {
+	resolved := make(map[string]*titleResolution, len(titles))
+	if len(titles) == 0 {
+		return resolved, nil
+	}
+	// settle records the matches of a step for a title, if it has any
+	settle := func(title string, matches []Movie, exact bool) {
+		switch {
+		case len(matches) == 1:
+			resolved[title] = &titleResolution{movie: &matches[0], exact: exact}
+		case len(matches) > 1:
+			resolved[title] = &titleResolution{err: r.ambiguousTitle(title, matches)}
+		}
+	}
+
+	// 1. Exact titles
+	var movies []Movie
+	err := r.data.db.WithContext(ctx).
+		Where("title IN ?", titles).
+		Order("release_date, id").
+		Find(&movies).Error
+	if err != nil {
+		return nil, err
+	}
+	byTitle := make(map[string][]Movie)
+	for _, m := range movies {
+		byTitle[m.Title] = append(byTitle[m.Title], m)
+	}
+	for _, title := range titles {
+		settle(title, byTitle[title], true)
+	}
+
+	// 2. "Title (YYYY)"
+	var conds []string
+	var args []interface{}
+	for _, title := range titles {
+		if _, ok := resolved[title]; ok {
+			continue
+		}
+		if base, year, ok := biz.ParseDisambiguatedTitle(title); ok {
+			conds = append(conds, "(title = ? AND EXTRACT(YEAR FROM release_date) = ?)")
+			args = append(args, base, year)
+		}
+	}
+	if len(conds) > 0 {
+		movies = nil
+		if err := r.data.db.WithContext(ctx).Where(strings.Join(conds, " OR "), args...).Order("id").Find(&movies).Error; err != nil {
+			return nil, err
+		}
+		for i := range movies {
+			// The first movie per title and year, as First picks it
+			title := biz.DisambiguatedTitle(movies[i].Title, movies[i].ReleaseDate.Year())
+			if _, ok := resolved[title]; !ok && slices.Contains(titles, title) {
+				resolved[title] = &titleResolution{movie: &movies[i]}
+			}
+		}
+	}
+
+	// 3. Alternate titles
+	var rest []string
+	for _, title := range titles {
+		if _, ok := resolved[title]; !ok {
+			rest = append(rest, title)
+		}
+	}
+	if len(rest) == 0 {
+		return resolved, nil
+	}
+	if err := r.resolveAlternateTitles(ctx, rest, settle); err != nil {
+		return nil, err
+	}
+
+	// 4. Movie IDs
+	var ids []string
+	for _, title := range rest {
+		if _, err := uuid.Parse(title); err == nil {
+			if _, ok := resolved[title]; !ok {
+				ids = append(ids, title)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return resolved, nil
+	}
+	movies = nil
+	if err := r.data.db.WithContext(ctx).Where("id IN ?", ids).Find(&movies).Error; err != nil {
+		return nil, err
+	}
+	for i := range movies {
+		resolved[movies[i].ID] = &titleResolution{movie: &movies[i]}
+	}
+	return resolved, nil
+}
+
+// resolveAlternateTitles settles the titles matching alternate titles
+func (r *movieRepo) resolveAlternateTitles(ctx context.Context, titles []string, settle func(string, []Movie, bool)) error {
+	var alternates []AlternateTitle
+	if err := r.data.db.WithContext(ctx).Where("title IN ?", titles).Find(&alternates).Error; err != nil {
+		return err
+	}
+	if len(alternates) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(alternates))
+	for _, alt := range alternates {
+		ids = append(ids, alt.MovieID)
+	}
+	var movies []Movie
+	if err := r.data.db.WithContext(ctx).Where("id IN ?", ids).Order("release_date, id").Find(&movies).Error; err != nil {
+		return err
+	}
+	movieTitles := make(map[string][]string) // Alternate titles per movie ID
+	for _, alt := range alternates {
+		if !slices.Contains(movieTitles[alt.MovieID], alt.Title) {
+			movieTitles[alt.MovieID] = append(movieTitles[alt.MovieID], alt.Title)
+		}
+	}
+	byTitle := make(map[string][]Movie)
+	for _, m := range movies {
+		for _, title := range movieTitles[m.ID] {
+			byTitle[title] = append(byTitle[title], m)
+		}
+	}
+	for _, title := range titles {
+		settle(title, byTitle[title], false)
+	}
+	return nil
+}
+
+// ambiguousTitle builds the error listing the movies a title matches
+func (r *movieRepo) ambiguousTitle(title string, matches []Movie) error {
+	candidates := make([]*biz.Movie, 0, len(matches))
+	for i := range matches {
+		candidates = append(candidates, r.modelToBiz(&matches[i]))
+	}
+	return &biz.AmbiguousTitleError{Title: title, Candidates: candidates}
+}
+
+// assignTitleKey checks a new movie's title against existing movies and picks
+// its key: the title, or "Title (YYYY)" once the plain title is taken. Keys of
+// soft-deleted movies stay taken since their ratings may still refer to them.
+func assignTitleKey(tx *gorm.DB, m *Movie) error {
+	year := m.ReleaseDate.Year()
+
+	var count int64
+	err := tx.Model(&Movie{}).
+		Where("title = ? AND EXTRACT(YEAR FROM release_date) = ?", m.Title, year).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %s", biz.ErrDuplicateTitle, biz.DisambiguatedTitle(m.Title, year))
+	}
+
+	// Fall back to the movie ID if a deleted movie held both keys
+	for _, key := range []string{m.Title, biz.DisambiguatedTitle(truncate(m.Title, 248), year), m.ID} {
+		if err := tx.Unscoped().Model(&Movie{}).Where("title_key = ?", key).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			m.TitleKey = key
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", biz.ErrDuplicateTitle, m.Title)
+}
+
+// alternateTitleMovieIDs is a subquery of movies with an alternate title matching the pattern
+func alternateTitleMovieIDs(db *gorm.DB, pattern string) *gorm.DB {
+	return db.Model(&AlternateTitle{}).Select("movie_id").Where("title ILIKE ?", pattern)
+}
+
+// loadAlternateTitles fills in the alternate titles of the given movies with one query
+func loadAlternateTitles(ctx context.Context, db *gorm.DB, movies []*biz.Movie) error {
+	if len(movies) == 0 {
+		return nil
+	}
+
+	byID := make(map[string][]*biz.Movie, len(movies))
+	ids := make([]string, 0, len(movies))
+	for _, m := range movies {
+		if _, ok := byID[m.ID]; !ok {
+			ids = append(ids, m.ID)
+		}
+		byID[m.ID] = append(byID[m.ID], m)
+	}
+
+	var rows []AlternateTitle
+	err := db.WithContext(ctx).
+		Where("movie_id IN ?", ids).
+		Order("movie_id, kind, language NULLS FIRST, region NULLS FIRST, id").
+		Find(&rows).Error
+	if err != nil {
+		return fmt.Errorf("failed to load alternate titles: %w", err)
+	}
+
+	for i := range rows {
+		row := &rows[i]
+		for _, movie := range byID[row.MovieID] {
+			movie.AlternateTitles = append(movie.AlternateTitles, &biz.AlternateTitle{
+				ID:       row.ID,
+				Title:    row.Title,
+				Language: row.Language,
+				Region:   row.Region,
+				Kind:     biz.AlternateTitleKind(row.Kind),
+			})
+		}
+	}
+	return nil
+}
+
+func (r *movieRepo) AddAlternateTitle(ctx context.Context, movie *biz.Movie, title *biz.AlternateTitle) error {
+	row := &AlternateTitle{
+		MovieID:  movie.ID,
+		Title:    title.Title,
+		Language: title.Language,
+		Region:   title.Region,
+		Kind:     string(title.Kind),
+	}
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpVersion(tx, movie); err != nil {
+			return err
+		}
+		// Adding a title the movie already lists is a no-op
+		return tx.Omit("Movie").
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(row).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add alternate title: %w", err)
+	}
+	title.ID = row.ID
+
+	invalidateMovieCache(ctx, r.data, movie.Title)
+	return nil
+}
+
+func (r *movieRepo) DeleteAlternateTitle(ctx context.Context, movie *biz.Movie, id int64) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpVersion(tx, movie); err != nil {
+			return err
+		}
+		result := tx.Where("id = ? AND movie_id = ?", id, movie.ID).Delete(&AlternateTitle{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: %d", biz.ErrAlternateTitleNotFound, id)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete alternate title: %w", err)
+	}
+
+	invalidateMovieCache(ctx, r.data, movie.Title)
+	return nil
+}
@@ -0,0 +1,197 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"src/internal/biz"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// loadTranslations fills in the translations of the given movies and the
+// localized names of their genres. Expects the genres to be loaded.
+func loadTranslations(ctx context.Context, db *gorm.DB, movies []*biz.Movie) error {
+	if len(movies) == 0 {
+		return nil
+	}
+
+	byID := make(map[string][]*biz.Movie, len(movies))
+	ids := make([]string, 0, len(movies))
+	for _, m := range movies {
+		if _, ok := byID[m.ID]; !ok {
+			ids = append(ids, m.ID)
+		}
+		byID[m.ID] = append(byID[m.ID], m)
+	}
+
+	var rows []MovieTranslation
+	if err := db.WithContext(ctx).Where("movie_id IN ?", ids).Order("locale").Find(&rows).Error; err != nil {
+		return fmt.Errorf("failed to load translations: %w", err)
+	}
+	for i := range rows {
+		row := &rows[i]
+		for _, m := range byID[row.MovieID] {
+			m.Translations = append(m.Translations, &biz.MovieTranslation{
+				Locale:   row.Locale,
+				Synopsis: row.Synopsis,
+				Tagline:  row.Tagline,
+			})
+		}
+	}
+
+	var names []struct {
+		MovieID string
+		Genre   string
+		Locale  string
+		Name    string
+	}
+	err := db.WithContext(ctx).
+		Table("movie_genres").
+		Select("movie_genres.movie_id, genres.name as genre, genre_translations.locale, genre_translations.name").
+		Joins("JOIN genres ON genres.id = movie_genres.genre_id").
+		Joins("JOIN genre_translations ON genre_translations.genre_id = movie_genres.genre_id").
+		Where("movie_genres.movie_id IN ?", ids).
+		Scan(&names).Error
+	if err != nil {
+		return fmt.Errorf("failed to load genre translations: %w", err)
+	}
+	for _, n := range names {
+		for _, m := range byID[n.MovieID] {
+			if m.GenreTranslations == nil {
+				m.GenreTranslations = make(map[string]map[string]string)
+			}
+			if m.GenreTranslations[n.Genre] == nil {
+				m.GenreTranslations[n.Genre] = make(map[string]string)
+			}
+			m.GenreTranslations[n.Genre][n.Locale] = n.Name
+		}
+	}
+	return nil
+}
+
+func (r *movieRepo) SetTranslation(ctx context.Context, movie *biz.Movie, translation *biz.MovieTranslation) error {
+	row := &MovieTranslation{
+		MovieID:  movie.ID,
+		Locale:   translation.Locale,
+		Synopsis: translation.Synopsis,
+		Tagline:  translation.Tagline,
+	}
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpVersion(tx, movie); err != nil {
+			return err
+		}
+		return tx.Omit("Movie").
+			Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "movie_id"}, {Name: "locale"}},
+				DoUpdates: clause.AssignmentColumns([]string{"synopsis", "tagline", "updated_at"}),
+			}).
+			Create(row).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set translation: %w", err)
+	}
+
+	invalidateMovieCache(ctx, r.data, movie.Title)
+	return nil
+}
+
+func (r *movieRepo) DeleteTranslation(ctx context.Context, movie *biz.Movie, locale string) error {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := bumpVersion(tx, movie); err != nil {
+			return err
+		}
+		result := tx.Where("movie_id = ? AND locale = ?", movie.ID, locale).Delete(&MovieTranslation{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: %s", biz.ErrTranslationNotFound, locale)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete translation: %w", err)
+	}
+
+	invalidateMovieCache(ctx, r.data, movie.Title)
+	return nil
+}
+
+func (r *genreRepo) SetTranslation(ctx context.Context, genreID, locale, name string) error {
+	row := &GenreTranslation{GenreID: genreID, Locale: locale, Name: name}
+	err := r.data.db.WithContext(ctx).
+		Omit("Genre").
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "genre_id"}, {Name: "locale"}},
+			DoUpdates: clause.AssignmentColumns([]string{"name"}),
+		}).
+		Create(row).Error
+	if err != nil {
+		return fmt.Errorf("failed to set genre translation: %w", err)
+	}
+
+	r.invalidateGenreMovies(ctx, genreID)
+	return nil
+}
+
+func (r *genreRepo) DeleteTranslation(ctx context.Context, genreID, locale string) error {
+	result := r.data.db.WithContext(ctx).
+		Where("genre_id = ? AND locale = ?", genreID, locale).
+		Delete(&GenreTranslation{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete genre translation: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: %s", biz.ErrTranslationNotFound, locale)
+	}
+
+	r.invalidateGenreMovies(ctx, genreID)
+	return nil
+}
+
+// invalidateGenreMovies drops the cached movies of a genre, whose localized
+// genre names are cached with them
+func (r *genreRepo) invalidateGenreMovies(ctx context.Context, genreID string) {
+	if r.data.rdb == nil {
+		return
+	}
+	var titles []string
+	err := r.data.db.WithContext(ctx).
+		Model(&Movie{}).
+		Where("id IN (?)", r.data.db.WithContext(ctx).Model(&MovieGenre{}).Select("movie_id").Where("genre_id = ?", genreID)).
+		Pluck("title", &titles).Error
+	if err != nil {
+		r.log.Warnf("failed to list movies of genre %s: %v", genreID, err)
+		return
+	}
+	invalidateMovieCache(ctx, r.data, titles...)
+}
+
+// loadGenreTranslations fills in the localized names of the given genres
+func loadGenreTranslations(ctx context.Context, db *gorm.DB, genres []*biz.Genre) error {
+	if len(genres) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*biz.Genre, len(genres))
+	ids := make([]string, 0, len(genres))
+	for _, g := range genres {
+		byID[g.ID] = g
+		ids = append(ids, g.ID)
+	}
+
+	var rows []GenreTranslation
+	if err := db.WithContext(ctx).Where("genre_id IN ?", ids).Find(&rows).Error; err != nil {
+		return fmt.Errorf("failed to load genre translations: %w", err)
+	}
+	for _, row := range rows {
+		genre := byID[row.GenreID]
+		if genre.Translations == nil {
+			genre.Translations = make(map[string]string)
+		}
+		genre.Translations[row.Locale] = row.Name
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"src/internal/conf"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Trending scores use the usual exponential-decay trick: instead of decaying
+// every score over time, a rating at time t contributes 2^((t - epoch) / half-life),
+// so newer ratings weigh more. Scores grow as time passes, so the epoch is moved
+// forward periodically and all scores are rescaled to match (renormalization).
+const (
+	trendingKey      = "rank:movies:trending"
+	trendingEpochKey = "rank:movies:trending:epoch"
+
+	// defaultTrendingHalfLife applies when no half-life is configured
+	defaultTrendingHalfLife = 72 * time.Hour
+	// trendingMinScore drops movies whose activity decayed below ~10 half-lives of one rating
+	trendingMinScore = 1e-3
+	// trendingMinExponent keeps POWER() above the float8 underflow limit in Postgres
+	trendingMinExponent = -1000
+)
+
+// trendingSumSQL sums the decayed contributions of visible ratings relative to
+// an epoch; its parameters are the epoch (unix seconds) and the half-life (seconds)
+const trendingSumSQL = "COALESCE(SUM(POWER(2, GREATEST((EXTRACT(EPOCH FROM rated_at)::float8 - ?) / ?, ?))), 0)"
+
+// setTrendingScoreScript stores a score computed against epoch ARGV[3],
+// rescaling it if the epoch moved (renormalization) in the meantime.
+var setTrendingScoreScript = redis.NewScript(`
+local epoch = tonumber(ARGV[3])
+local current = tonumber(redis.call('GET', KEYS[2]) or ARGV[3])
+local score = tonumber(ARGV[2]) * 2 ^ ((epoch - current) / tonumber(ARGV[4]))
+if score < tonumber(ARGV[5]) then
+  redis.call('ZREM', KEYS[1], ARGV[1])
+else
+  redis.call('ZADD', KEYS[1], score, ARGV[1])
+end
+return 1
+`)
+
+// renormalizeTrendingScript moves the epoch to ARGV[1] and rescales every score,
+// dropping movies that decayed below ARGV[3]. Returns the number of dropped movies.
+var renormalizeTrendingScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local old = tonumber(redis.call('GET', KEYS[2]))
+if old == nil then
+  redis.call('SET', KEYS[2], ARGV[1])
+  return 0
+end
+if now <= old then
+  return 0
+end
+local factor = 2 ^ ((old - now) / tonumber(ARGV[2]))
+local min = tonumber(ARGV[3])
+local entries = redis.call('ZRANGE', KEYS[1], 0, -1, 'WITHSCORES')
+local dropped = 0
+for i = 1, #entries, 2 do
+  local score = tonumber(entries[i + 1]) * factor
+  if score < min then
+    redis.call('ZREM', KEYS[1], entries[i])
+    dropped = dropped + 1
+  else
+    redis.call('ZADD', KEYS[1], score, entries[i])
+  end
+end
+redis.call('SET', KEYS[2], ARGV[1])
+return dropped
+`)
+
+// trendingHalfLife returns the configured half-life or the default
+func trendingHalfLife(c *conf.Trending) time.Duration {
+	if c != nil && c.HalfLife != nil && c.HalfLife.AsDuration() > 0 {
+		return c.HalfLife.AsDuration()
+	}
+	return defaultTrendingHalfLife
+}
+
+// trendingDecay is the factor a score decays by over elapsed seconds, the
+// 2^(-elapsed / half-life) the scripts rescale scores by when the epoch moves
+func trendingDecay(elapsed int64, halfLife time.Duration) float64 {
+	return math.Exp2(-float64(elapsed) / halfLife.Seconds())
+}
+
+// trendingEpoch returns the current trending epoch (unix seconds), starting it now if unset
+func trendingEpoch(ctx context.Context, rdb *redis.Client) (int64, error) {
+	val, err := rdb.Get(ctx, trendingEpochKey).Result()
+	if errors.Is(err, redis.Nil) {
+		if err := rdb.SetNX(ctx, trendingEpochKey, time.Now().Unix(), 0).Err(); err != nil {
+			return 0, fmt.Errorf("failed to start trending epoch: %w", err)
+		}
+		val, err = rdb.Get(ctx, trendingEpochKey).Result()
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get trending epoch: %w", err)
+	}
+	epoch, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid trending epoch %q: %w", val, err)
+	}
+	return epoch, nil
+}
+
+// setTrendingScore stores a movie's trending score computed against epoch
+func setTrendingScore(ctx context.Context, rdb *redis.Client, movieTitle string, score float64, epoch int64, halfLife time.Duration) error {
+	return setTrendingScoreScript.Run(ctx, rdb, []string{trendingKey, trendingEpochKey},
+		movieTitle, score, epoch, halfLife.Seconds(), trendingMinScore).Err()
+}
@@ -0,0 +1,132 @@
+package data
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"src/internal/conf"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestTrendingHalfLife(t *testing.T) {
+	tests := []struct {
+		name string
+		c    *conf.Trending
+		want time.Duration
+	}{
+		{name: "no config", want: defaultTrendingHalfLife},
+		{name: "unset", c: &conf.Trending{}, want: defaultTrendingHalfLife},
+		{name: "zero", c: &conf.Trending{HalfLife: durationpb.New(0)}, want: defaultTrendingHalfLife},
+		{name: "negative", c: &conf.Trending{HalfLife: durationpb.New(-time.Hour)}, want: defaultTrendingHalfLife},
+		{name: "configured", c: &conf.Trending{HalfLife: durationpb.New(24 * time.Hour)}, want: 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := trendingHalfLife(tt.c); got != tt.want {
+				t.Errorf("trendingHalfLife() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrendingDecay(t *testing.T) {
+	halfLife := time.Hour
+
+	tests := []struct {
+		name    string
+		elapsed int64
+		want    float64
+	}{
+		{name: "no time passed", elapsed: 0, want: 1},
+		{name: "one half-life", elapsed: 3600, want: 0.5},
+		{name: "two half-lives", elapsed: 7200, want: 0.25},
+		{name: "half a half-life", elapsed: 1800, want: math.Sqrt2 / 2},
+		{name: "epoch ahead of the clock", elapsed: -3600, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := trendingDecay(tt.elapsed, halfLife); math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("trendingDecay(%d) = %v, want %v", tt.elapsed, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestTrendingEpochRescale checks that moving the epoch, as renormalization
+// does, leaves the scores read from the ZSet as they were: a score summed
+// against one epoch and rescaled to a later one reads the same as the score
+// summed relative to now, as trendingFromSQL computes it
+func TestTrendingEpochRescale(t *testing.T) {
+	halfLife := 72 * time.Hour
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC).Unix()
+	day := int64(24 * 3600)
+
+	tests := []struct {
+		name     string
+		ratedAt  []int64
+		epochs   []int64 // Epoch the score is summed against, then each it moves to
+		now      int64
+		wantRead float64 // Score as read, where a brand-new rating counts 1
+	}{
+		{
+			name:     "new rating counts one",
+			ratedAt:  []int64{start},
+			epochs:   []int64{start},
+			now:      start,
+			wantRead: 1,
+		},
+		{
+			name:     "one half-life old",
+			ratedAt:  []int64{start},
+			epochs:   []int64{start},
+			now:      start + 3*day,
+			wantRead: 0.5,
+		},
+		{
+			name:     "epoch moved once",
+			ratedAt:  []int64{start, start + day},
+			epochs:   []int64{start, start + 3*day},
+			now:      start + 3*day,
+			wantRead: 0.5 + math.Exp2(-2.0/3),
+		},
+		{
+			name:     "epoch moved several times",
+			ratedAt:  []int64{start, start + day, start + 5*day},
+			epochs:   []int64{start - 10*day, start, start + 2*day, start + 6*day},
+			now:      start + 6*day,
+			wantRead: 0.25 + math.Exp2(-5.0/3) + math.Exp2(-1.0/3),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Each rating contributes 2^((rated_at - epoch) / half-life), as in trendingSumSQL
+			var score float64
+			for _, ratedAt := range tt.ratedAt {
+				score += trendingDecay(tt.epochs[0]-ratedAt, halfLife)
+			}
+			// Renormalization rescales every score to the new epoch
+			for i := 1; i < len(tt.epochs); i++ {
+				score *= trendingDecay(tt.epochs[i]-tt.epochs[i-1], halfLife)
+			}
+			epoch := tt.epochs[len(tt.epochs)-1]
+
+			got := score * trendingDecay(tt.now-epoch, halfLife)
+			if math.Abs(got-tt.wantRead) > 1e-9 {
+				t.Errorf("read score = %v, want %v", got, tt.wantRead)
+			}
+
+			var direct float64
+			for _, ratedAt := range tt.ratedAt {
+				direct += trendingDecay(tt.now-ratedAt, halfLife)
+			}
+			if math.Abs(got-direct) > 1e-9 {
+				t.Errorf("read score = %v, computed relative to now = %v", got, direct)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"src/internal/conf"
+	"src/internal/service"
+
+	"github.com/go-kratos/kratos/v2/encoding"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// cacheRule is a configured Cache-Control policy with its route split into
+// segments
+type cacheRule struct {
+	segments     []string
+	cacheControl string
+}
+
+func newCacheRules(rules []*conf.Server_HTTP_CacheRule) []cacheRule {
+	result := make([]cacheRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Path == "" || rule.CacheControl == "" {
+			continue
+		}
+		result = append(result, cacheRule{
+			segments:     strings.Split(strings.Trim(rule.Path, "/"), "/"),
+			cacheControl: rule.CacheControl,
+		})
+	}
+	return result
+}
+
+// matches reports whether the path is the rule's route, a {name} segment
+// matching any one segment
+func (r cacheRule) matches(path string) bool {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) != len(r.segments) {
+		return false
+	}
+	for i, segment := range r.segments {
+		if !strings.HasPrefix(segment, "{") && segment != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ConditionalFilter makes GET and HEAD API replies cacheable: each gets a
+// strong ETag (the one the response encoder set, or a hash of the body), the
+// Cache-Control of the first matching rule, and a 304 with no body when
+// If-None-Match, or If-Modified-Since against the Last-Modified a handler set
+// (movie and all-time rating replies do), shows the client's copy is current.
+//
+// Replies are buffered to that end; responses in other formats, such as
+// exports and media files, stream through untouched.
+func ConditionalFilter(rules []*conf.Server_HTTP_CacheRule) khttp.FilterFunc {
+	cacheRules := newCacheRules(rules)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+			cw := &conditionalResponse{ResponseWriter: w}
+			next.ServeHTTP(cw, r)
+			if !cw.buffered {
+				return
+			}
+
+			h := w.Header()
+			if h.Get("ETag") == "" {
+				sum := sha256.Sum256(cw.body.Bytes())
+				h.Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+			}
+			if h.Get("Cache-Control") == "" {
+				for _, rule := range cacheRules {
+					if rule.matches(r.URL.Path) {
+						h.Set("Cache-Control", rule.cacheControl)
+						break
+					}
+				}
+			}
+			// Replies are localized and may show a rater their own content
+			h.Add("Vary", "Accept, Accept-Language, X-Rater-Id")
+
+			if notModified(r, h) {
+				h.Del("Content-Type")
+				h.Del("Content-Length")
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(cw.body.Bytes())
+		})
+	}
+}
+
+// notModified evaluates the conditional headers of a GET or HEAD request
+// against the validators of its reply. If-Modified-Since only counts without
+// If-None-Match.
+func notModified(r *http.Request, h http.Header) bool {
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		return service.MatchETag(ifNoneMatch, h.Get("ETag"), true)
+	}
+	ifModifiedSince, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	lastModified, err := http.ParseTime(h.Get("Last-Modified"))
+	if err != nil {
+		return false
+	}
+	return !lastModified.Truncate(time.Second).After(ifModifiedSince)
+}
+
+// conditionalResponse holds back a successful API reply until its validators
+// are checked. Whether to buffer is decided when the status is written, from
+// the status and the Content-Type.
+type conditionalResponse struct {
+	http.ResponseWriter
+	decided  bool
+	buffered bool
+	body     bytes.Buffer
+}
+
+func (w *conditionalResponse) WriteHeader(code int) {
+	if w.decided {
+		return
+	}
+	w.decided = true
+	w.buffered = code == http.StatusOK && isAPIReply(w.Header().Get("Content-Type"))
+	if !w.buffered {
+		w.ResponseWriter.WriteHeader(code)
+	}
+}
+
+func (w *conditionalResponse) Write(p []byte) (int, error) {
+	if !w.decided {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.buffered {
+		return w.body.Write(p)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// Unwrap lets http.ResponseController flush a streamed response
+func (w *conditionalResponse) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// isAPIReply reports whether a response is a reply encoded by one of the
+// codecs the API serves, like application/json
+func isAPIReply(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	subtype, ok := strings.CutPrefix(mediaType, "application/")
+	return ok && encoding.GetCodec(subtype) != nil
+}
@@ -0,0 +1,208 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// newConditionalServer serves GET /movies/{title}/rating through
+// ConditionalFilter, with a handler that sets Last-Modified like the rating
+// and movie replies do
+func newConditionalServer(lastModified time.Time) *khttp.Server {
+	srv := khttp.NewServer(khttp.Filter(ConditionalFilter(nil)))
+	srv.Route("/").GET("/movies/{title}/rating", func(ctx khttp.Context) error {
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				tr.ReplyHeader().Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+			}
+			return map[string]interface{}{"average": 4.5, "count": 2}, nil
+		})
+		out, err := h(ctx, nil)
+		if err != nil {
+			return err
+		}
+		return ctx.Result(http.StatusOK, out)
+	})
+	return srv
+}
+
+func TestConditionalFilterIfModifiedSince(t *testing.T) {
+	lastModified := time.Date(2026, 10, 1, 12, 30, 15, 500, time.UTC)
+	srv := newConditionalServer(lastModified)
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    int
+	}{
+		{
+			name: "no conditional headers",
+			want: http.StatusOK,
+		},
+		{
+			name:    "not modified since the last modification",
+			headers: map[string]string{"If-Modified-Since": lastModified.Format(http.TimeFormat)},
+			want:    http.StatusNotModified,
+		},
+		{
+			name:    "not modified since a later time",
+			headers: map[string]string{"If-Modified-Since": lastModified.Add(time.Hour).Format(http.TimeFormat)},
+			want:    http.StatusNotModified,
+		},
+		{
+			name:    "modified since an earlier time",
+			headers: map[string]string{"If-Modified-Since": lastModified.Add(-time.Second).Format(http.TimeFormat)},
+			want:    http.StatusOK,
+		},
+		{
+			name:    "unparsable date",
+			headers: map[string]string{"If-Modified-Since": "yesterday"},
+			want:    http.StatusOK,
+		},
+		{
+			name: "If-None-Match takes precedence",
+			headers: map[string]string{
+				"If-Modified-Since": lastModified.Format(http.TimeFormat),
+				"If-None-Match":     `"other"`,
+			},
+			want: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/movies/Inception/rating", nil)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.want)
+			}
+			if got := rec.Header().Get("Last-Modified"); got != lastModified.Format(http.TimeFormat) {
+				t.Errorf("Last-Modified = %q, want %q", got, lastModified.Format(http.TimeFormat))
+			}
+			if tt.want == http.StatusNotModified && rec.Body.Len() != 0 {
+				t.Errorf("304 has a body of %d bytes", rec.Body.Len())
+			}
+		})
+	}
+}
+
+func TestNotModified(t *testing.T) {
+	lastModified := time.Date(2026, 10, 1, 12, 30, 15, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		request map[string]string
+		reply   map[string]string
+		want    bool
+	}{
+		{
+			name:  "no conditional headers",
+			reply: map[string]string{"ETag": `"abc"`, "Last-Modified": lastModified.Format(http.TimeFormat)},
+		},
+		{
+			name:    "matching ETag",
+			request: map[string]string{"If-None-Match": `"abc"`},
+			reply:   map[string]string{"ETag": `"abc"`},
+			want:    true,
+		},
+		{
+			name:    "weakly matching ETag",
+			request: map[string]string{"If-None-Match": `W/"abc"`},
+			reply:   map[string]string{"ETag": `"abc"`},
+			want:    true,
+		},
+		{
+			name:    "other ETag",
+			request: map[string]string{"If-None-Match": `"def"`},
+			reply:   map[string]string{"ETag": `"abc"`},
+		},
+		{
+			name: "If-None-Match wins over If-Modified-Since",
+			request: map[string]string{
+				"If-None-Match":     `"def"`,
+				"If-Modified-Since": lastModified.Format(http.TimeFormat),
+			},
+			reply: map[string]string{"ETag": `"abc"`, "Last-Modified": lastModified.Format(http.TimeFormat)},
+		},
+		{
+			name:    "not modified since",
+			request: map[string]string{"If-Modified-Since": lastModified.Format(http.TimeFormat)},
+			reply:   map[string]string{"Last-Modified": lastModified.Format(http.TimeFormat)},
+			want:    true,
+		},
+		{
+			name:    "modified since",
+			request: map[string]string{"If-Modified-Since": lastModified.Add(-time.Second).Format(http.TimeFormat)},
+			reply:   map[string]string{"Last-Modified": lastModified.Format(http.TimeFormat)},
+		},
+		{
+			name:    "reply without Last-Modified",
+			request: map[string]string{"If-Modified-Since": lastModified.Format(http.TimeFormat)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/movies/Inception", nil)
+			for k, v := range tt.request {
+				r.Header.Set(k, v)
+			}
+			h := http.Header{}
+			for k, v := range tt.reply {
+				h.Set(k, v)
+			}
+			if got := notModified(r, h); got != tt.want {
+				t.Errorf("notModified() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCacheRuleMatches(t *testing.T) {
+	rules := newCacheRules([]*conf.Server_HTTP_CacheRule{
+		{Path: "/movies/{title}/rating", CacheControl: "public, max-age=60"},
+		{Path: "/rankings/top/", CacheControl: "public, max-age=300"},
+		{Path: "/genres", CacheControl: ""},
+		{Path: "", CacheControl: "no-store"},
+	})
+	if len(rules) != 2 {
+		t.Fatalf("newCacheRules() kept %d rules, want the 2 with a path and Cache-Control", len(rules))
+	}
+	rating, top := rules[0], rules[1]
+
+	tests := []struct {
+		name string
+		rule cacheRule
+		path string
+		want bool
+	}{
+		{name: "path parameter", rule: rating, path: "/movies/Inception/rating", want: true},
+		{name: "trailing slash", rule: rating, path: "/movies/Inception/rating/", want: true},
+		{name: "other literal segment", rule: rating, path: "/movies/Inception/similar"},
+		{name: "fewer segments", rule: rating, path: "/movies/Inception"},
+		{name: "more segments", rule: rating, path: "/movies/Inception/rating/history"},
+		{name: "literal path", rule: top, path: "/rankings/top", want: true},
+		{name: "other literal path", rule: top, path: "/rankings/popular"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.matches(tt.path); got != tt.want {
+				t.Errorf("matches(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
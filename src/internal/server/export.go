@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	v1 "src/api/movie/v1"
+	"src/internal/biz"
+	"src/internal/service"
+
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// registerExport routes GET /movies/export, the HTTP side of the streaming
+// ExportMovies RPC. The file is written straight to the response and flushed
+// after every page of movies, so it arrives as a chunked download.
+func registerExport(srv *khttp.Server, movieSvc *service.MovieService) {
+	route := srv.Route("/")
+	route.GET("/movies/export", func(ctx khttp.Context) error {
+		in := &v1.ExportMoviesRequest{}
+		if err := ctx.BindQuery(in); err != nil {
+			return err
+		}
+
+		w := &exportResponse{
+			ResponseWriter: ctx.Response(),
+			format:         biz.ExportFormat(in.Format),
+		}
+		khttp.SetOperation(ctx, v1.MovieService_ExportMovies_FullMethodName)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, movieSvc.WriteMovieExport(ctx, req.(*v1.ExportMoviesRequest), w, w.flush)
+		})
+
+		// An export may outlast the server timeout; a client that goes away
+		// still stops it, as writing the next page fails
+		_, err := h(context.WithoutCancel(ctx), in)
+		if err != nil && w.started {
+			// Too late for an error response: cut the download short so the
+			// client does not take a truncated file for a complete one
+			panic(http.ErrAbortHandler)
+		}
+		return err
+	})
+}
+
+// exportResponse sets the download headers when the export starts writing,
+// so a request that fails before then gets a regular error response
+type exportResponse struct {
+	http.ResponseWriter
+	format  biz.ExportFormat
+	started bool
+}
+
+func (w *exportResponse) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+		w.Header().Set("Content-Type", w.format.ContentType())
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="movies.%s"`, w.format))
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *exportResponse) flush() error {
+	if !w.started {
+		return nil
+	}
+	return http.NewResponseController(w.ResponseWriter).Flush()
+}
@@ -1,21 +1,65 @@
 package server
 
 import (
+	"context"
+	"net/http"
+
 	v1 "src/api/movie/v1"
+	"src/internal/biz"
 	"src/internal/conf"
 	"src/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	httpstatus "github.com/go-kratos/kratos/v2/transport/http/status"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
+func init() {
+	httpstatus.DefaultConverter = statusConverter{httpstatus.DefaultConverter}
+}
+
+// statusConverter gives the HTTP codes of API errors that kratos reports as
+// codes.Unknown their gRPC code
+type statusConverter struct {
+	httpstatus.Converter
+}
+
+func (c statusConverter) ToGRPCCode(code int) codes.Code {
+	switch code {
+	case http.StatusUnprocessableEntity, http.StatusUnsupportedMediaType:
+		return codes.InvalidArgument
+	case http.StatusRequestEntityTooLarge:
+		return codes.ResourceExhausted
+	case http.StatusMultipleChoices, // Ambiguous title
+		http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	}
+	return c.Converter.ToGRPCCode(code)
+}
+
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, movieSvc *service.MovieService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, auth *conf.Auth, rl *conf.RateLimit, limiter biz.RateLimiter, movieSvc *service.MovieService, moderationSvc *service.ModerationService, rankingSvc *service.RankingService, recommendationSvc *service.RecommendationService, personSvc *service.PersonService, collectionSvc *service.CollectionService, genreSvc *service.GenreService, idempotencyUC *biz.IdempotencyUseCase, media *conf.Media, logger log.Logger) *grpc.Server {
+	middlewares := []middleware.Middleware{
+		MetricsMiddleware(),
+		recovery.Recovery(),
+		ErrorMiddleware(),
+		RateLimitMiddleware(rl, limiter, logger),
+		AuthMiddleware(auth.Token),
+		RaterIdMiddleware(),
+		ClientInfoMiddleware(rl.GetTrustProxyHeaders()),
+		LocaleMiddleware(),
+		ValidateMiddleware(),
+		IdempotencyMiddleware(idempotencyUC, rl.GetTrustProxyHeaders(), logger),
+	}
 	var opts = []grpc.ServerOption{
-		grpc.Middleware(
-			recovery.Recovery(),
-		),
+		grpc.Middleware(middlewares...),
+		grpc.StreamInterceptor(streamMiddleware(middlewares...)),
+		// Leave room for an image upload in a single message
+		grpc.Options(ggrpc.MaxRecvMsgSize(int(maxUploadBytes(media)) + multipartOverhead)),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -28,5 +72,24 @@ func NewGRPCServer(c *conf.Server, movieSvc *service.MovieService, logger log.Lo
 	}
 	srv := grpc.NewServer(opts...)
 	v1.RegisterMovieServiceServer(srv, movieSvc)
+	v1.RegisterModerationServiceServer(srv, moderationSvc)
+	v1.RegisterRankingServiceServer(srv, rankingSvc)
+	v1.RegisterRecommendationServiceServer(srv, recommendationSvc)
+	v1.RegisterPersonServiceServer(srv, personSvc)
+	v1.RegisterCollectionServiceServer(srv, collectionSvc)
+	v1.RegisterGenreServiceServer(srv, genreSvc)
 	return srv
 }
+
+// streamMiddleware runs the server middleware on streaming RPCs, which kratos
+// only applies to unary ones. The middleware sees the request as nil.
+func streamMiddleware(m ...middleware.Middleware) ggrpc.StreamServerInterceptor {
+	chain := middleware.Chain(m...)
+	return func(srv interface{}, ss ggrpc.ServerStream, _ *ggrpc.StreamServerInfo, handler ggrpc.StreamHandler) error {
+		h := chain(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return nil, handler(srv, grpc.NewWrappedStream(ctx, ss))
+		})
+		_, err := h(ss.Context(), nil)
+		return err
+	}
+}
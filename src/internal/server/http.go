@@ -2,8 +2,10 @@ package server
 
 import (
 	"net/http"
+	"net/url"
 
 	v1 "src/api/movie/v1"
+	"src/internal/biz"
 	"src/internal/conf"
 	"src/internal/service"
 
@@ -11,12 +13,24 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/proto"
 )
 
 // Custom response encoder to handle 201 status for resource creation
 func customResponseEncoder(w http.ResponseWriter, r *http.Request, v interface{}) error {
+	// Tag the reply with its content, for conditional GETs and If-Match writes
+	if reply, ok := v.(proto.Message); ok {
+		codec, _ := khttp.CodecForRequest(r, "Accept")
+		if etag := service.ETag(reply, codec.Name()); etag != "" {
+			w.Header().Set("ETag", etag)
+		}
+	}
+
 	// Check if this is a CreateMovie response (POST /movies, not /movies/{title}/ratings)
 	if r.Method == "POST" && r.URL.Path == "/movies" {
+		if reply, ok := v.(*v1.CreateMovieReply); ok {
+			w.Header().Set("Location", "/movies/"+url.PathEscape(reply.Id))
+		}
 		w.WriteHeader(http.StatusCreated)
 	}
 
@@ -39,13 +53,22 @@ func customErrorEncoder(w http.ResponseWriter, r *http.Request, err error) {
 }
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, auth *conf.Auth, movieSvc *service.MovieService, logger log.Logger) *khttp.Server {
+func NewHTTPServer(c *conf.Server, auth *conf.Auth, rl *conf.RateLimit, limiter biz.RateLimiter, movieSvc *service.MovieService, moderationSvc *service.ModerationService, rankingSvc *service.RankingService, recommendationSvc *service.RecommendationService, personSvc *service.PersonService, collectionSvc *service.CollectionService, genreSvc *service.GenreService, idempotencyUC *biz.IdempotencyUseCase, media *conf.Media, store biz.BlobStore, logger log.Logger) *khttp.Server {
 	var opts = []khttp.ServerOption{
 		khttp.Middleware(
+			MetricsMiddleware(),
 			recovery.Recovery(),
+			ErrorMiddleware(),
+			RateLimitMiddleware(rl, limiter, logger),
 			AuthMiddleware(auth.Token),
 			RaterIdMiddleware(),
+			ClientInfoMiddleware(rl.GetTrustProxyHeaders()),
+			LocaleMiddleware(),
+			ImageUploadMiddleware(maxUploadBytes(media)),
+			ValidateMiddleware(),
+			IdempotencyMiddleware(idempotencyUC, rl.GetTrustProxyHeaders(), logger),
 		),
+		khttp.Filter(MetricsFilter(), ConditionalFilter(c.Http.GetCacheRules())),
 		khttp.ResponseEncoder(customResponseEncoder),
 		khttp.ErrorEncoder(customErrorEncoder),
 	}
@@ -59,6 +82,21 @@ func NewHTTPServer(c *conf.Server, auth *conf.Auth, movieSvc *service.MovieServi
 		opts = append(opts, khttp.Timeout(c.Http.Timeout.AsDuration()))
 	}
 	srv := khttp.NewServer(opts...)
+	// Before the generated routes, where GET /movies/{title} would match it
+	registerExport(srv, movieSvc)
 	v1.RegisterMovieServiceHTTPServer(srv, movieSvc)
+	v1.RegisterModerationServiceHTTPServer(srv, moderationSvc)
+	v1.RegisterRankingServiceHTTPServer(srv, rankingSvc)
+	v1.RegisterRecommendationServiceHTTPServer(srv, recommendationSvc)
+	v1.RegisterPersonServiceHTTPServer(srv, personSvc)
+	v1.RegisterCollectionServiceHTTPServer(srv, collectionSvc)
+	v1.RegisterGenreServiceHTTPServer(srv, genreSvc)
+	registerImageUpload(srv, movieSvc, maxUploadBytes(media))
+	registerMetrics(srv)
+
+	// Serve stored images when the blob store is not publicly reachable itself
+	if served, ok := store.(biz.ServedBlobStore); ok {
+		srv.HandlePrefix(served.URLPrefix(), served)
+	}
 	return srv
 }
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	stderrors "errors"
+	"net/http"
+
+	"src/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// IdempotencyMiddleware replays the first response to requests retried with
+// the same Idempotency-Key header. It covers every POST over HTTP and every
+// unary call over gRPC that sends the key (idempotency-key metadata). Keys
+// are scoped to the caller: the API key, else the rater ID, else the IP.
+// Replays carry an Idempotent-Replayed header; a key reused with another
+// payload gets 422, and one whose first request is still running 409.
+// If the store fails the request runs without idempotency.
+func IdempotencyMiddleware(uc *biz.IdempotencyUseCase, trustProxy bool, logger log.Logger) middleware.Middleware {
+	l := log.NewHelper(logger)
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			key := tr.RequestHeader().Get("Idempotency-Key")
+			msg, isProto := req.(proto.Message)
+			if key == "" || !isProto {
+				return handler(ctx, req)
+			}
+			if ht, ok := tr.(khttp.Transporter); ok && ht.Request().Method != http.MethodPost {
+				return handler(ctx, req)
+			}
+
+			hash, err := requestHash(tr.Operation(), msg)
+			if err != nil {
+				l.Warnf("failed to hash request for idempotency: %v", err)
+				return handler(ctx, req)
+			}
+			principal := idempotencyPrincipal(ctx, tr, trustProxy)
+
+			stored, err := uc.Begin(ctx, principal, key, hash)
+			switch {
+			case stderrors.Is(err, biz.ErrInvalidIdempotencyKey), stderrors.Is(err, biz.ErrIdempotencyKeyReused),
+				stderrors.Is(err, biz.ErrIdempotencyInProgress):
+				return nil, err
+			case err != nil:
+				l.Warnf("idempotency store failed, running request without it: %v", err)
+				return handler(ctx, req)
+			case stored != nil:
+				reply, err := decodeReply(stored)
+				if err != nil {
+					return nil, errors.InternalServer("INTERNAL", "failed to decode stored response")
+				}
+				tr.ReplyHeader().Set("Idempotent-Replayed", "true")
+				return reply, nil
+			}
+
+			reply, err := handler(ctx, req)
+			var response []byte
+			if err == nil {
+				if response, err = encodeReply(reply); err != nil {
+					l.Warnf("failed to encode response for idempotency: %v", err)
+					response, err = nil, nil
+				}
+			}
+			// Store even if the caller went away, that is when retries come
+			uc.Finish(context.WithoutCancel(ctx), principal, key, hash, response)
+			return reply, err
+		}
+	}
+}
+
+// requestHash identifies the operation and payload of a request
+func requestHash(operation string, req proto.Message) (string, error) {
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.New()
+	sum.Write([]byte(operation))
+	sum.Write([]byte{0})
+	sum.Write(payload)
+	return hex.EncodeToString(sum.Sum(nil)), nil
+}
+
+// idempotencyPrincipal returns who a key belongs to, named like rate limit
+// subjects so raw credentials are never stored
+func idempotencyPrincipal(ctx context.Context, tr transport.Transporter, trustProxy bool) string {
+	ip := clientIP(ctx, tr, trustProxy)
+	if tr.RequestHeader().Get("Authorization") != "" {
+		return rateLimitSubject(tr, rateLimitKeyAPIKey, ip)
+	}
+	return rateLimitSubject(tr, rateLimitKeyRater, ip)
+}
+
+// encodeReply stores a reply with its type so it can be replayed as is
+func encodeReply(reply interface{}) ([]byte, error) {
+	msg, ok := reply.(proto.Message)
+	if !ok {
+		return nil, stderrors.New("reply is not a proto message")
+	}
+	wrapped, err := anypb.New(msg)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(wrapped)
+}
+
+func decodeReply(data []byte) (proto.Message, error) {
+	var wrapped anypb.Any
+	if err := proto.Unmarshal(data, &wrapped); err != nil {
+		return nil, err
+	}
+	return wrapped.UnmarshalNew()
+}
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"testing"
+
+	v1 "src/api/movie/v1"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRequestHash(t *testing.T) {
+	budget := int64(1000)
+	base := func() *v1.CreateMovieRequest {
+		return &v1.CreateMovieRequest{
+			Title:       "Inception",
+			Genre:       "Sci-Fi",
+			ReleaseDate: "2010-07-16",
+			Budget:      &budget,
+			ExternalIds: map[string]string{"imdb": "tt1375666", "tmdb": "27205", "eidr": "10.5240/1"},
+		}
+	}
+	const operation = v1.MovieService_CreateMovie_FullMethodName
+	want, err := requestHash(operation, base())
+	if err != nil {
+		t.Fatalf("requestHash() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		operation string
+		req       proto.Message
+		same      bool
+	}{
+		{
+			name:      "same request",
+			operation: operation,
+			req:       base(),
+			same:      true,
+		},
+		{
+			name:      "same map built in another order",
+			operation: operation,
+			req: func() proto.Message {
+				req := base()
+				req.ExternalIds = map[string]string{"eidr": "10.5240/1", "tmdb": "27205", "imdb": "tt1375666"}
+				return req
+			}(),
+			same: true,
+		},
+		{
+			name:      "other payload",
+			operation: operation,
+			req: func() proto.Message {
+				req := base()
+				req.Title = "Interstellar"
+				return req
+			}(),
+		},
+		{
+			name:      "unset optional field",
+			operation: operation,
+			req: func() proto.Message {
+				req := base()
+				req.Budget = nil
+				return req
+			}(),
+		},
+		{
+			name:      "other operation",
+			operation: v1.MovieService_BulkImportMovies_FullMethodName,
+			req:       base(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := requestHash(tt.operation, tt.req)
+			if err != nil {
+				t.Fatalf("requestHash() error = %v", err)
+			}
+			if (got == want) != tt.same {
+				t.Errorf("requestHash() = %s, base %s, want same = %v", got, want, tt.same)
+			}
+		})
+	}
+}
+
+func TestEncodeDecodeReply(t *testing.T) {
+	distributor := "Warner Bros."
+	next := "cursor"
+
+	tests := []struct {
+		name  string
+		reply proto.Message
+	}{
+		{
+			name: "created movie",
+			reply: &v1.CreateMovieReply{
+				Id:          "0190a1b2-0000-7000-8000-000000000001",
+				Title:       "Inception",
+				ReleaseDate: "2010-07-16",
+				Genre:       "Science Fiction",
+				Distributor: &distributor,
+				BoxOffice: &v1.BoxOffice{
+					Revenue:     &v1.Revenue{Worldwide: 836800000},
+					Currency:    "USD",
+					LastUpdated: timestamppb.Now(),
+				},
+				Genres:      []string{"Science Fiction", "Action"},
+				ExternalIds: map[string]string{"imdb": "tt1375666"},
+				Version:     1,
+			},
+		},
+		{
+			name: "list with nested items",
+			reply: &v1.ListMoviesReply{
+				Items:      []*v1.MovieItem{{Id: "1", Title: "Inception", BoxOffice: &v1.BoxOffice{}}},
+				NextCursor: &next,
+			},
+		},
+		{
+			name:  "empty reply",
+			reply: &v1.HealthCheckReply{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := encodeReply(tt.reply)
+			if err != nil {
+				t.Fatalf("encodeReply() error = %v", err)
+			}
+			got, err := decodeReply(data)
+			if err != nil {
+				t.Fatalf("decodeReply() error = %v", err)
+			}
+			if got.ProtoReflect().Descriptor().FullName() != tt.reply.ProtoReflect().Descriptor().FullName() {
+				t.Fatalf("decodeReply() type = %s, want %s",
+					got.ProtoReflect().Descriptor().FullName(), tt.reply.ProtoReflect().Descriptor().FullName())
+			}
+			if !proto.Equal(got, tt.reply) {
+				t.Errorf("decodeReply() = %v, want %v", got, tt.reply)
+			}
+		})
+	}
+}
+
+func TestEncodeReplyRejectsNonProto(t *testing.T) {
+	if _, err := encodeReply(map[string]string{"status": "ok"}); err == nil {
+		t.Error("encodeReply() error = nil, want an error for a non-proto reply")
+	}
+}
+
+func TestDecodeReplyRejectsGarbage(t *testing.T) {
+	if _, err := decodeReply([]byte("not a stored reply")); err == nil {
+		t.Error("decodeReply() error = nil, want an error")
+	}
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	v1 "src/api/movie/v1"
+	"src/internal/biz"
+	"src/internal/conf"
+	"src/internal/service"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// multipartOverhead is allowed on top of the image size for the form fields
+// and part headers of an upload
+const multipartOverhead = 64 << 10
+
+// maxUploadBytes returns the configured size limit of an uploaded image
+func maxUploadBytes(media *conf.Media) int64 {
+	if media.GetMaxUploadBytes() > 0 {
+		return media.GetMaxUploadBytes()
+	}
+	return biz.DefaultMaxImageBytes
+}
+
+// registerImageUpload routes POST /movies/{id}/images, a multipart/form-data
+// upload with the image in the "file" field and an optional "kind" field.
+// Multipart has no generated binding, so the request goes through the server
+// middleware like a generated handler, with ImageUploadMiddleware decoding the
+// form once authentication and rate limiting have let it through.
+func registerImageUpload(srv *khttp.Server, movieSvc *service.MovieService, maxBytes int64) {
+	route := srv.Route("/")
+	route.POST("/movies/{id}/images", func(ctx khttp.Context) error {
+		r := ctx.Request()
+		r.Body = http.MaxBytesReader(ctx.Response(), r.Body, maxBytes+multipartOverhead)
+
+		in := &v1.UploadMovieImageRequest{Id: ctx.Vars().Get("id")}
+		khttp.SetOperation(ctx, v1.MovieService_UploadMovieImage_FullMethodName)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return movieSvc.UploadMovieImage(ctx, req.(*v1.UploadMovieImageRequest))
+		})
+		out, err := h(ctx, in)
+		if err != nil {
+			return err
+		}
+		return ctx.Result(http.StatusCreated, out)
+	})
+}
+
+// ImageUploadMiddleware fills in an image upload made over HTTP from its
+// multipart form. It goes after authentication and rate limiting, so that the
+// body of a rejected upload is never read, and before validation and
+// idempotency, which need the image.
+func ImageUploadMiddleware(maxBytes int64) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			in, ok := req.(*v1.UploadMovieImageRequest)
+			if !ok {
+				return handler(ctx, req)
+			}
+			r, ok := khttp.RequestFromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			file, _, err := r.FormFile("file")
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if stderrors.As(err, &tooLarge) {
+					return nil, errors.New(413, "PAYLOAD_TOO_LARGE", fmt.Sprintf("upload larger than %d bytes", maxBytes))
+				}
+				return nil, errors.New(422, "UNPROCESSABLE_ENTITY", "multipart form with a file field is required")
+			}
+			defer file.Close()
+			if in.Data, err = io.ReadAll(file); err != nil {
+				return nil, errors.New(422, "UNPROCESSABLE_ENTITY", fmt.Sprintf("failed to read file: %v", err))
+			}
+			in.Kind = r.FormValue("kind")
+			return handler(ctx, in)
+		}
+	}
+}
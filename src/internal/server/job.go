@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"src/internal/biz"
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultRenormalizeInterval applies when no trending renormalize interval is configured
+const defaultRenormalizeInterval = time.Hour
+
+// idempotencyPurgeInterval is how often expired idempotency records are deleted
+const idempotencyPurgeInterval = time.Hour
+
+// job is a task run on a fixed interval
+type job struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+	// runOnStart also runs the job once when the server starts
+	runOnStart bool
+}
+
+// JobServer runs periodic background jobs for the lifetime of the app.
+// It implements transport.Server so it starts and stops with the API servers.
+type JobServer struct {
+	jobs []job
+	log  *log.Helper
+
+	done chan struct{}
+	once sync.Once
+}
+
+// NewJobServer creates the background job runner
+func NewJobServer(trending *conf.Trending, similarity *conf.Similarity, recommender *conf.Recommender, rankingUC *biz.RankingUseCase, similarityUC *biz.SimilarityUseCase, recommendationUC *biz.RecommendationUseCase, idempotencyUC *biz.IdempotencyUseCase, logger log.Logger) *JobServer {
+	renormalizeInterval := defaultRenormalizeInterval
+	if trending.GetRenormalizeInterval().AsDuration() > 0 {
+		renormalizeInterval = trending.GetRenormalizeInterval().AsDuration()
+	}
+	similarityInterval := biz.DefaultSimilarityInterval
+	if similarity.GetInterval().AsDuration() > 0 {
+		similarityInterval = similarity.GetInterval().AsDuration()
+	}
+	reloadInterval := biz.DefaultModelReloadInterval
+	if recommender.GetReloadInterval().AsDuration() > 0 {
+		reloadInterval = recommender.GetReloadInterval().AsDuration()
+	}
+
+	return &JobServer{
+		jobs: []job{
+			{name: "renormalize-trending", interval: renormalizeInterval, run: rankingUC.RenormalizeTrending},
+			{name: "compute-similarities", interval: similarityInterval, run: similarityUC.ComputeSimilarities},
+			{name: "reload-recommender", interval: reloadInterval, run: recommendationUC.ReloadModel, runOnStart: true},
+			{name: "purge-idempotency-keys", interval: idempotencyPurgeInterval, run: idempotencyUC.PurgeExpired},
+		},
+		log:  log.NewHelper(logger),
+		done: make(chan struct{}),
+	}
+}
+
+// Start runs every job on its interval until Stop is called, then waits for
+// running jobs to finish
+func (s *JobServer) Start(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, j := range s.jobs {
+		wg.Add(1)
+		go func(j job) {
+			defer wg.Done()
+			s.loop(ctx, j)
+		}(j)
+	}
+	s.log.Infof("[Job] server started with %d jobs", len(s.jobs))
+
+	select {
+	case <-ctx.Done():
+	case <-s.done:
+	}
+	cancel()
+	wg.Wait()
+	return nil
+}
+
+// Stop signals all jobs to stop
+func (s *JobServer) Stop(ctx context.Context) error {
+	s.once.Do(func() { close(s.done) })
+	s.log.Info("[Job] server stopping")
+	return nil
+}
+
+func (s *JobServer) loop(ctx context.Context, j job) {
+	if j.runOnStart {
+		s.runJob(ctx, j)
+	}
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.runJob(ctx, j)
+		}
+	}
+}
+
+func (s *JobServer) runJob(ctx context.Context, j job) {
+	if err := j.run(ctx); err != nil {
+		s.log.Errorf("job %s failed: %v", j.name, err)
+	}
+}
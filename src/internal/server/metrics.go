@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	httpstatus "github.com/go-kratos/kratos/v2/transport/http/status"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Request metrics, labelled with the transport (http or grpc), the operation
+// (the full gRPC method name, which HTTP routes share) and the status: the
+// HTTP status code written, or the name of the gRPC code
+var (
+	serverRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "server_requests_total",
+		Help: "Requests handled, by transport, operation and status.",
+	}, []string{"kind", "operation", "code"})
+
+	serverRequestSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "server_request_duration_seconds",
+		Help:    "Time taken to handle requests, by transport, operation and status.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"kind", "operation", "code"})
+)
+
+// observeRequest counts and times a handled request
+func observeRequest(kind transport.Kind, operation, code string, start time.Time) {
+	labels := prometheus.Labels{"kind": kind.String(), "operation": operation, "code": code}
+	serverRequests.With(labels).Inc()
+	serverRequestSeconds.With(labels).Observe(time.Since(start).Seconds())
+}
+
+// metricsOperationKey is the context key of the operation MetricsMiddleware
+// reports to MetricsFilter
+type metricsOperationKey struct{}
+
+// MetricsFilter counts and times HTTP requests by the status actually written,
+// such as 201 for a creation or 304 for a conditional GET. It goes first among
+// the filters. The operation is only known once the route is matched, so
+// MetricsMiddleware reports it back; requests that never reach the API
+// middleware, like those for /metrics itself, are not counted.
+func MetricsFilter() khttp.FilterFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			operation := new(string)
+			sw := &statusResponse{ResponseWriter: w}
+			// Deferred to also count streams aborted with a panic
+			defer func() {
+				if *operation == "" {
+					return
+				}
+				status := sw.status
+				if status == 0 {
+					status = http.StatusOK
+				}
+				observeRequest(transport.KindHTTP, *operation, strconv.Itoa(status), start)
+			}()
+			next.ServeHTTP(sw, r.WithContext(context.WithValue(r.Context(), metricsOperationKey{}, operation)))
+		})
+	}
+}
+
+// statusResponse remembers the status written
+type statusResponse struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusResponse) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statusResponse) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// Unwrap lets http.ResponseController flush a streamed response
+func (w *statusResponse) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// MetricsMiddleware counts and times gRPC calls. It goes first in the chain,
+// so it sees the status the client gets, including for panics. Over HTTP it
+// only reports the operation to MetricsFilter, which sees the status written.
+func MetricsMiddleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			if tr.Kind() == transport.KindHTTP {
+				if operation, ok := ctx.Value(metricsOperationKey{}).(*string); ok {
+					*operation = tr.Operation()
+				}
+				return handler(ctx, req)
+			}
+
+			start := time.Now()
+			reply, err := handler(ctx, req)
+			code := 200
+			if err != nil {
+				code = int(errors.FromError(err).Code)
+			}
+			observeRequest(tr.Kind(), tr.Operation(), httpstatus.ToGRPCCode(code).String(), start)
+			return reply, err
+		}
+	}
+}
+
+// registerMetrics serves the metrics of the default Prometheus registry at
+// /metrics, outside the API middleware
+func registerMetrics(srv *khttp.Server) {
+	srv.Handle("/metrics", promhttp.Handler())
+}
@@ -4,11 +4,75 @@ import (
 	"context"
 	"strings"
 
+	v1 "src/api/movie/v1"
+	"src/internal/biz"
+	"src/internal/service"
+
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/transport"
 )
 
+// authOperations require a valid Bearer token (catalog writes and admin RPCs)
+var authOperations = map[string]bool{
+	v1.OperationMovieServiceCreateMovie:              true,
+	v1.OperationMovieServiceBulkImportMovies:         true,
+	v1.MovieService_ExportMovies_FullMethodName:      true,
+	v1.OperationMovieServiceSetMovieCredits:          true,
+	v1.OperationMovieServiceSetMovieExternalId:       true,
+	v1.OperationMovieServiceDeleteMovieExternalId:    true,
+	v1.OperationMovieServiceSetMovieGenres:           true,
+	v1.OperationMovieServiceSetMovieTags:             true,
+	v1.OperationMovieServiceAddAlternateTitle:        true,
+	v1.OperationMovieServiceRemoveAlternateTitle:     true,
+	v1.OperationMovieServiceSetMovieTranslation:      true,
+	v1.OperationMovieServiceDeleteMovieTranslation:   true,
+	v1.MovieService_UploadMovieImage_FullMethodName:  true,
+	v1.OperationMovieServiceDeleteMovieImage:         true,
+	v1.OperationGenreServiceCreateGenre:              true,
+	v1.OperationGenreServiceAddGenreAlias:            true,
+	v1.OperationGenreServiceSetGenreTranslation:      true,
+	v1.OperationGenreServiceDeleteGenreTranslation:   true,
+	v1.OperationPersonServiceCreatePerson:            true,
+	v1.OperationPersonServiceUpdatePerson:            true,
+	v1.OperationPersonServiceDeletePerson:            true,
+	v1.OperationModerationServiceListModerationQueue: true,
+	v1.OperationModerationServiceModerateReview:      true,
+	v1.OperationMovieServiceGetRatingHistory:         true,
+}
+
+// raterOperations require an X-Rater-Id header identifying the caller
+var raterOperations = map[string]bool{
+	v1.OperationMovieServiceSubmitRating:                true,
+	v1.OperationModerationServiceReportReview:           true,
+	v1.OperationRecommendationServiceGetRecommendations: true,
+	v1.OperationCollectionServiceCreateCollection:       true,
+	v1.OperationCollectionServiceUpdateCollection:       true,
+	v1.OperationCollectionServiceDeleteCollection:       true,
+	v1.OperationCollectionServiceAddCollectionItem:      true,
+	v1.OperationCollectionServiceRemoveCollectionItem:   true,
+	v1.OperationCollectionServiceReorderCollectionItems: true,
+}
+
+// viewerOperations accept an optional X-Rater-Id header; when present it
+// lets the caller see their own private content
+var viewerOperations = map[string]bool{
+	v1.OperationCollectionServiceGetCollection:   true,
+	v1.OperationCollectionServiceListCollections: true,
+}
+
+// ErrorMiddleware reports the errors of handlers and the middleware after it
+// as the API errors service.APIError maps them to, so HTTP and gRPC clients
+// get the same status and error body
+func ErrorMiddleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			reply, err := handler(ctx, req)
+			return reply, service.APIError(err)
+		}
+	}
+}
+
 // AuthMiddleware validates Bearer token for write operations
 func AuthMiddleware(token string) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
@@ -19,8 +83,8 @@ func AuthMiddleware(token string) middleware.Middleware {
 				return nil, errors.Unauthorized("UNAUTHORIZED", "missing transport info")
 			}
 
-			// Only apply auth to CreateMovie (NOT SubmitRating, it uses X-Rater-Id only)
-			if tr.Operation() == "/api.movie.v1.MovieService/CreateMovie" {
+			// Only apply auth to protected operations (NOT SubmitRating, it uses X-Rater-Id only)
+			if authOperations[tr.Operation()] {
 
 				// Extract Authorization header
 				authHeader := tr.RequestHeader().Get("Authorization")
@@ -45,7 +109,7 @@ func AuthMiddleware(token string) middleware.Middleware {
 	}
 }
 
-// RaterIdMiddleware extracts X-Rater-Id header for rating operations
+// RaterIdMiddleware extracts X-Rater-Id header for rating and reporting operations
 func RaterIdMiddleware() middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
@@ -55,8 +119,8 @@ func RaterIdMiddleware() middleware.Middleware {
 				return nil, errors.Unauthorized("UNAUTHORIZED", "missing transport info")
 			}
 
-			// Only apply to SubmitRating operation
-			if tr.Operation() == "/api.movie.v1.MovieService/SubmitRating" {
+			// Only apply to rater operations
+			if raterOperations[tr.Operation()] {
 				// Extract X-Rater-Id header
 				raterID := tr.RequestHeader().Get("X-Rater-Id")
 				if raterID == "" {
@@ -65,9 +129,45 @@ func RaterIdMiddleware() middleware.Middleware {
 
 				// Inject rater ID into context
 				ctx = context.WithValue(ctx, "rater_id", raterID)
+			} else if viewerOperations[tr.Operation()] {
+				if raterID := tr.RequestHeader().Get("X-Rater-Id"); raterID != "" {
+					ctx = context.WithValue(ctx, "rater_id", raterID)
+				}
 			}
 
 			return handler(ctx, req)
 		}
 	}
 }
+
+// LocaleMiddleware derives the caller's locale chain from the Accept-Language
+// header (accept-language metadata over gRPC)
+func LocaleMiddleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				ctx = biz.NewLocaleContext(ctx, biz.LocaleChain(tr.RequestHeader().Get("Accept-Language")))
+				// Responses differ by language, so HTTP caches must key on it
+				if tr.Kind() == transport.KindHTTP {
+					tr.ReplyHeader().Set("Vary", "Accept-Language")
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// ClientInfoMiddleware records the caller's IP and user agent for auditing
+func ClientInfoMiddleware(trustProxy bool) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				ctx = biz.NewClientContext(ctx, biz.ClientInfo{
+					IP:        clientIP(ctx, tr, trustProxy),
+					UserAgent: tr.RequestHeader().Get("User-Agent"),
+				})
+			}
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"src/internal/biz"
+	"src/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+// Rate limit bucket keys
+const (
+	rateLimitKeyRater  = "rater"
+	rateLimitKeyAPIKey = "api_key"
+	rateLimitKeyIP     = "ip"
+)
+
+// rateLimitRule is a per-operation limit with its bucket key
+type rateLimitRule struct {
+	key   string
+	limit biz.RateLimit
+}
+
+// rateLimitCheck is a single bucket to take a token from
+type rateLimitCheck struct {
+	bucket string
+	limit  biz.RateLimit
+}
+
+// RateLimitMiddleware throttles requests with token buckets: a global per-IP
+// limit plus per-operation limits keyed by rater ID, API key or client IP.
+// Rejected requests get 429 with Retry-After; every limited response carries
+// RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers.
+func RateLimitMiddleware(c *conf.RateLimit, limiter biz.RateLimiter, logger log.Logger) middleware.Middleware {
+	l := log.NewHelper(logger)
+
+	perIP, hasPerIP := toRateLimit(c.GetPerIp())
+	rules := make(map[string]rateLimitRule)
+	for _, rule := range c.GetRules() {
+		limit, ok := toRateLimit(rule.GetLimit())
+		if !ok || rule.GetOperation() == "" {
+			continue
+		}
+		key := rule.GetKey()
+		if key == "" {
+			key = rateLimitKeyIP
+		}
+		rules[rule.GetOperation()] = rateLimitRule{key: key, limit: limit}
+	}
+	trustProxy := c.GetTrustProxyHeaders()
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if !c.GetEnabled() {
+				return handler(ctx, req)
+			}
+
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			ip := clientIP(ctx, tr, trustProxy)
+			var checks []rateLimitCheck
+			if hasPerIP {
+				checks = append(checks, rateLimitCheck{bucket: "ip:" + ip, limit: perIP})
+			}
+			if rule, ok := rules[tr.Operation()]; ok {
+				subject := rateLimitSubject(tr, rule.key, ip)
+				checks = append(checks, rateLimitCheck{
+					bucket: "op:" + tr.Operation() + ":" + subject,
+					limit:  rule.limit,
+				})
+			}
+
+			// Report the most restrictive bucket; stop at the first rejection
+			var tightest *biz.RateLimitResult
+			for _, check := range checks {
+				res, err := limiter.Allow(ctx, check.bucket, check.limit)
+				if err != nil {
+					l.Warnf("rate limiter failed for %s: %v", check.bucket, err)
+					continue
+				}
+				if tightest == nil || !res.Allowed || res.Remaining < tightest.Remaining {
+					tightest = res
+				}
+				if !res.Allowed {
+					break
+				}
+			}
+
+			if tightest != nil {
+				setRateLimitHeaders(tr.ReplyHeader(), tightest)
+				if !tightest.Allowed {
+					return nil, errors.New(429, "TOO_MANY_REQUESTS", "rate limit exceeded")
+				}
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// toRateLimit converts a configured limit into a token bucket
+func toRateLimit(c *conf.RateLimit_Limit) (biz.RateLimit, bool) {
+	if c.GetRequests() <= 0 || c.GetPeriod() == nil || c.GetPeriod().AsDuration() <= 0 {
+		return biz.RateLimit{}, false
+	}
+	burst := int64(c.GetBurst())
+	if burst <= 0 {
+		burst = int64(c.GetRequests())
+	}
+	return biz.RateLimit{
+		Rate:  float64(c.GetRequests()) / c.GetPeriod().AsDuration().Seconds(),
+		Burst: burst,
+	}, true
+}
+
+// rateLimitSubject returns the bucket subject for a rule key, falling back to the IP
+func rateLimitSubject(tr transport.Transporter, key, ip string) string {
+	switch key {
+	case rateLimitKeyRater:
+		if raterID := tr.RequestHeader().Get("X-Rater-Id"); raterID != "" {
+			return "rater:" + raterID
+		}
+	case rateLimitKeyAPIKey:
+		authHeader := tr.RequestHeader().Get("Authorization")
+		if token, ok := strings.CutPrefix(authHeader, "Bearer "); ok && token != "" {
+			// Never store raw credentials in bucket keys
+			sum := sha256.Sum256([]byte(token))
+			return "key:" + hex.EncodeToString(sum[:8])
+		}
+	}
+	return "ip:" + ip
+}
+
+// clientIP returns the caller's IP for HTTP and gRPC transports
+func clientIP(ctx context.Context, tr transport.Transporter, trustProxy bool) string {
+	if trustProxy {
+		if forwarded := tr.RequestHeader().Get("X-Forwarded-For"); forwarded != "" {
+			first, _, _ := strings.Cut(forwarded, ",")
+			return strings.TrimSpace(first)
+		}
+		if realIP := tr.RequestHeader().Get("X-Real-IP"); realIP != "" {
+			return realIP
+		}
+	}
+
+	var addr string
+	if ht, ok := tr.(khttp.Transporter); ok {
+		addr = ht.Request().RemoteAddr
+	} else if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
+
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// setRateLimitHeaders writes RateLimit-* and Retry-After headers
+func setRateLimitHeaders(h transport.Header, res *biz.RateLimitResult) {
+	h.Set("RateLimit-Limit", strconv.FormatInt(res.Limit, 10))
+	h.Set("RateLimit-Remaining", strconv.FormatInt(res.Remaining, 10))
+	h.Set("RateLimit-Reset", strconv.FormatInt(ceilSeconds(res.Reset), 10))
+	if !res.Allowed {
+		h.Set("Retry-After", strconv.FormatInt(max(ceilSeconds(res.RetryAfter), 1), 10))
+	}
+}
+
+func ceilSeconds(d time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,32 @@
+package server
+
+import (
+	"context"
+
+	"src/internal/service"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+// validator is implemented by the requests protoc-gen-validate generates
+// validators for from the rules in the API protos
+type validator interface {
+	ValidateAll() error
+}
+
+// ValidateMiddleware checks requests against the rules of their messages
+// before they reach a handler or claim an idempotency key. Every violated
+// rule is reported as a field of a *biz.ValidationError, which the error
+// middleware turns into a 422 listing the fields.
+func ValidateMiddleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if v, ok := req.(validator); ok {
+				if err := v.ValidateAll(); err != nil {
+					return nil, service.ValidationError(err)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+
+	kErrors "github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "src/api/movie/v1"
+	"src/internal/biz"
+)
+
+// CollectionService implements the CollectionService API
+type CollectionService struct {
+	v1.UnimplementedCollectionServiceServer
+
+	collectionUC *biz.CollectionUseCase
+	movieSvc     *MovieService
+}
+
+// NewCollectionService creates a new CollectionService
+func NewCollectionService(collectionUC *biz.CollectionUseCase, movieSvc *MovieService) *CollectionService {
+	return &CollectionService{
+		collectionUC: collectionUC,
+		movieSvc:     movieSvc,
+	}
+}
+
+// CreateCollection implements collection creation
+func (s *CollectionService) CreateCollection(ctx context.Context, req *v1.CreateCollectionRequest) (*v1.Collection, error) {
+	// Extract rater ID from context (set by middleware)
+	ownerID, ok := ctx.Value("rater_id").(string)
+	if !ok || ownerID == "" {
+		return nil, kErrors.Unauthorized("UNAUTHORIZED", "missing X-Rater-Id header")
+	}
+
+	collection := &biz.Collection{
+		Name:        req.Name,
+		Description: req.Description,
+		OwnerID:     ownerID,
+	}
+	if req.Visibility != nil {
+		collection.Visibility = biz.CollectionVisibility(*req.Visibility)
+	}
+
+	collection, err := s.collectionUC.CreateCollection(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.collectionToProto(ctx, collection), nil
+}
+
+// GetCollection implements collection lookup
+func (s *CollectionService) GetCollection(ctx context.Context, req *v1.GetCollectionRequest) (*v1.Collection, error) {
+	// The viewer is optional; without it only public collections are found
+	viewerID, _ := ctx.Value("rater_id").(string)
+
+	collection, err := s.collectionUC.GetCollection(ctx, req.Id, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.collectionToProto(ctx, collection), nil
+}
+
+// UpdateCollection implements partial collection updates
+func (s *CollectionService) UpdateCollection(ctx context.Context, req *v1.UpdateCollectionRequest) (*v1.Collection, error) {
+	ownerID, ok := ctx.Value("rater_id").(string)
+	if !ok || ownerID == "" {
+		return nil, kErrors.Unauthorized("UNAUTHORIZED", "missing X-Rater-Id header")
+	}
+
+	update := &biz.CollectionUpdate{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+	if req.Visibility != nil {
+		visibility := biz.CollectionVisibility(*req.Visibility)
+		update.Visibility = &visibility
+	}
+
+	collection, err := s.collectionUC.UpdateCollection(ctx, req.Id, ownerID, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.collectionToProto(ctx, collection), nil
+}
+
+// DeleteCollection implements collection deletion
+func (s *CollectionService) DeleteCollection(ctx context.Context, req *v1.DeleteCollectionRequest) (*v1.DeleteCollectionReply, error) {
+	ownerID, ok := ctx.Value("rater_id").(string)
+	if !ok || ownerID == "" {
+		return nil, kErrors.Unauthorized("UNAUTHORIZED", "missing X-Rater-Id header")
+	}
+
+	if err := s.collectionUC.DeleteCollection(ctx, req.Id, ownerID); err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteCollectionReply{}, nil
+}
+
+// ListCollections implements collection listing
+func (s *CollectionService) ListCollections(ctx context.Context, req *v1.ListCollectionsRequest) (*v1.ListCollectionsReply, error) {
+	viewerID, _ := ctx.Value("rater_id").(string)
+
+	query := &biz.CollectionListQuery{
+		Q:        req.Q,
+		OwnerID:  req.OwnerId,
+		ViewerID: viewerID,
+		Limit:    10, // Default limit
+		Cursor:   req.Cursor,
+	}
+	if req.Limit != nil {
+		query.Limit = *req.Limit
+	}
+
+	page, err := s.collectionUC.ListCollections(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &v1.ListCollectionsReply{
+		Items:      make([]*v1.Collection, 0, len(page.Items)),
+		NextCursor: page.NextCursor,
+	}
+	for _, collection := range page.Items {
+		reply.Items = append(reply.Items, s.collectionToProto(ctx, collection))
+	}
+
+	return reply, nil
+}
+
+// AddCollectionItem implements adding a movie to a collection
+func (s *CollectionService) AddCollectionItem(ctx context.Context, req *v1.AddCollectionItemRequest) (*v1.Collection, error) {
+	ownerID, ok := ctx.Value("rater_id").(string)
+	if !ok || ownerID == "" {
+		return nil, kErrors.Unauthorized("UNAUTHORIZED", "missing X-Rater-Id header")
+	}
+
+	collection, err := s.collectionUC.AddItem(ctx, req.Id, ownerID, req.Title, req.Position)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.collectionToProto(ctx, collection), nil
+}
+
+// RemoveCollectionItem implements removing a movie from a collection
+func (s *CollectionService) RemoveCollectionItem(ctx context.Context, req *v1.RemoveCollectionItemRequest) (*v1.Collection, error) {
+	ownerID, ok := ctx.Value("rater_id").(string)
+	if !ok || ownerID == "" {
+		return nil, kErrors.Unauthorized("UNAUTHORIZED", "missing X-Rater-Id header")
+	}
+
+	collection, err := s.collectionUC.RemoveItem(ctx, req.Id, ownerID, req.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.collectionToProto(ctx, collection), nil
+}
+
+// ReorderCollectionItems implements reordering the movies of a collection
+func (s *CollectionService) ReorderCollectionItems(ctx context.Context, req *v1.ReorderCollectionItemsRequest) (*v1.Collection, error) {
+	ownerID, ok := ctx.Value("rater_id").(string)
+	if !ok || ownerID == "" {
+		return nil, kErrors.Unauthorized("UNAUTHORIZED", "missing X-Rater-Id header")
+	}
+
+	collection, err := s.collectionUC.ReorderItems(ctx, req.Id, ownerID, req.Titles)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.collectionToProto(ctx, collection), nil
+}
+
+// collectionToProto converts biz.Collection to proto
+func (s *CollectionService) collectionToProto(ctx context.Context, collection *biz.Collection) *v1.Collection {
+	reply := &v1.Collection{
+		Id:          collection.ID,
+		Name:        collection.Name,
+		Description: collection.Description,
+		OwnerId:     collection.OwnerID,
+		Visibility:  string(collection.Visibility),
+		ItemCount:   collection.ItemCount,
+		CreatedAt:   timestamppb.New(convertToLocalTime(collection.CreatedAt)),
+		UpdatedAt:   timestamppb.New(convertToLocalTime(collection.UpdatedAt)),
+	}
+	for _, item := range collection.Items {
+		reply.Items = append(reply.Items, &v1.CollectionItem{
+			Movie:    s.movieSvc.movieItemToProto(ctx, item.Movie),
+			Position: item.Position,
+			AddedAt:  timestamppb.New(convertToLocalTime(item.AddedAt)),
+		})
+	}
+	if collection.Rating != nil {
+		reply.Rating = &v1.CollectionRating{
+			Average:     collection.Rating.Average,
+			Count:       collection.Rating.Count,
+			RatedMovies: collection.Rating.RatedMovies,
+		}
+	}
+	return reply
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	kErrors "github.com/go-kratos/kratos/v2/errors"
+
+	v1 "src/api/movie/v1"
+	"src/internal/biz"
+)
+
+// apiErrors maps the sentinel errors of the biz layer to API errors. An empty
+// message is taken from the error itself, which names what was wrong; not
+// found errors keep a fixed message as theirs may wrap internal details.
+var apiErrors = []struct {
+	target  error
+	code    int
+	reason  string
+	message string
+}{
+	{biz.ErrMovieNotFound, 404, "NOT_FOUND", "movie not found"},
+	{biz.ErrPersonNotFound, 404, "NOT_FOUND", "person not found"},
+	{biz.ErrGenreNotFound, 404, "NOT_FOUND", "genre not found"},
+	{biz.ErrCollectionNotFound, 404, "NOT_FOUND", "collection not found"},
+	{biz.ErrCollectionItemNotFound, 404, "NOT_FOUND", ""},
+	{biz.ErrRatingNotFound, 404, "NOT_FOUND", "review not found"},
+	{biz.ErrTranslationNotFound, 404, "NOT_FOUND", "translation not found"},
+	{biz.ErrAlternateTitleNotFound, 404, "NOT_FOUND", "alternate title not found"},
+	{biz.ErrExternalIDNotFound, 404, "NOT_FOUND", "external ID not found"},
+	{biz.ErrImageNotFound, 404, "NOT_FOUND", "image not found"},
+
+	{biz.ErrDuplicateTitle, 409, "CONFLICT", ""},
+	{biz.ErrDuplicateExternalID, 409, "CONFLICT", ""},
+	{biz.ErrGenreExists, 409, "CONFLICT", ""},
+	{biz.ErrCollectionItemExists, 409, "CONFLICT", ""},
+	{biz.ErrIdempotencyInProgress, 409, "IDEMPOTENCY_IN_PROGRESS", ""},
+
+	{biz.ErrCollectionForbidden, 403, "FORBIDDEN", ""},
+	{biz.ErrPreconditionFailed, 412, "PRECONDITION_FAILED", ""},
+
+	{biz.ErrInvalidCredit, 422, "UNPROCESSABLE_ENTITY", ""},
+	{biz.ErrInvalidGenre, 422, "UNPROCESSABLE_ENTITY", ""},
+	{biz.ErrInvalidTag, 422, "UNPROCESSABLE_ENTITY", ""},
+	{biz.ErrInvalidExternalID, 422, "UNPROCESSABLE_ENTITY", ""},
+	{biz.ErrInvalidAlternateTitle, 422, "UNPROCESSABLE_ENTITY", ""},
+	{biz.ErrInvalidTranslation, 422, "UNPROCESSABLE_ENTITY", ""},
+	{biz.ErrInvalidCollection, 422, "UNPROCESSABLE_ENTITY", ""},
+	{biz.ErrInvalidImage, 422, "UNPROCESSABLE_ENTITY", ""},
+	{biz.ErrInvalidBatch, 422, "UNPROCESSABLE_ENTITY", ""},
+	{biz.ErrInvalidImport, 422, "UNPROCESSABLE_ENTITY", ""},
+	{biz.ErrInvalidExport, 422, "UNPROCESSABLE_ENTITY", ""},
+	{biz.ErrInvalidWindow, 422, "UNPROCESSABLE_ENTITY", ""},
+	{biz.ErrInvalidIdempotencyKey, 422, "UNPROCESSABLE_ENTITY", ""},
+	{biz.ErrIdempotencyKeyReused, 422, "UNPROCESSABLE_ENTITY", ""},
+	{biz.ErrInvalidModerationStatus, 422, "UNPROCESSABLE_ENTITY", "status must be one of pending, approved, hidden"},
+	{biz.ErrInvalidModerationAction, 422, "UNPROCESSABLE_ENTITY", "action must be one of approve, hide"},
+
+	{biz.ErrUnsupportedImage, 415, "UNSUPPORTED_MEDIA_TYPE", ""},
+	{biz.ErrImageTooLarge, 413, "PAYLOAD_TOO_LARGE", ""},
+	{biz.ErrModelNotLoaded, 503, "SERVICE_UNAVAILABLE", "recommendation model not trained yet"},
+}
+
+// APIError maps an error of the biz layer to the API error reported for it,
+// with the same code, reason, message and metadata over HTTP and gRPC. API
+// errors and unknown errors are returned unchanged.
+//
+// A validation error lists its invalid fields in the metadata, mapping each
+// field to what is wrong with it. An ambiguous title is reported as 300 with
+// metadata mapping each candidate's "Title (YYYY)" to its ID. A version
+// conflict is reported as 409 VERSION_CONFLICT with the current version in
+// the metadata.
+func APIError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var se *kErrors.Error
+	if errors.As(err, &se) {
+		return err
+	}
+
+	var invalid *biz.ValidationError
+	if errors.As(err, &invalid) {
+		fields := make(map[string]string, len(invalid.Violations))
+		for _, v := range invalid.Violations {
+			if desc, ok := fields[v.Field]; ok {
+				fields[v.Field] = desc + "; " + v.Description
+			} else {
+				fields[v.Field] = v.Description
+			}
+		}
+		return kErrors.New(422, "UNPROCESSABLE_ENTITY", invalid.Error()).WithMetadata(fields)
+	}
+	var ambiguous *biz.AmbiguousTitleError
+	if errors.As(err, &ambiguous) {
+		candidates := make(map[string]string, len(ambiguous.Candidates))
+		for _, m := range ambiguous.Candidates {
+			candidates[biz.DisambiguatedTitle(m.Title, m.ReleaseDate.Year())] = m.ID
+		}
+		msg := fmt.Sprintf("title %q matches %d movies, add the release year, e.g. %q", ambiguous.Title, len(ambiguous.Candidates),
+			biz.DisambiguatedTitle(ambiguous.Candidates[0].Title, ambiguous.Candidates[0].ReleaseDate.Year()))
+		return kErrors.New(300, "AMBIGUOUS_TITLE", msg).WithMetadata(candidates)
+	}
+	var conflict *biz.VersionConflictError
+	if errors.As(err, &conflict) {
+		return kErrors.New(409, "VERSION_CONFLICT", conflict.Error()).
+			WithMetadata(map[string]string{"current_version": strconv.FormatInt(conflict.Current, 10)})
+	}
+
+	for _, e := range apiErrors {
+		if errors.Is(err, e.target) {
+			msg := e.message
+			if msg == "" {
+				msg = err.Error()
+			}
+			return kErrors.New(e.code, e.reason, msg)
+		}
+	}
+	return err
+}
+
+// lookupErrorToProto reports a failed item of a batch lookup with the error
+// the single-item call would return
+func lookupErrorToProto(err error) *v1.LookupError {
+	se := kErrors.FromError(APIError(err))
+	return &v1.LookupError{
+		Code:     se.Code,
+		Reason:   se.Reason,
+		Message:  se.Message,
+		Metadata: se.Metadata,
+	}
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/proto"
+
+	v1 "src/api/movie/v1"
+	"src/internal/biz"
+)
+
+// ETag returns the strong entity tag of a reply encoded with the named codec,
+// so that each representation the Accept header selects has its own tag.
+// Movies are tagged by MovieETag; other replies by a hash of their content.
+func ETag(reply proto.Message, codec string) string {
+	switch m := reply.(type) {
+	case *v1.MovieItem:
+		return MovieETag(m.Id, m.Version, m.Locale, codec)
+	case *v1.CreateMovieReply:
+		return MovieETag(m.Id, m.Version, m.Locale, codec)
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(reply)
+	if err != nil {
+		return ""
+	}
+	return hashETag([]byte(codec), data)
+}
+
+// MovieETag returns the tag of a movie's representation: its version, which
+// every write to the movie or its related rows advances, in the locale and
+// codec of the reply. The reply that created a movie and later reads of it
+// share the tag without comparing their content.
+func MovieETag(id string, version int64, locale, codec string) string {
+	return hashETag([]byte(id), []byte(strconv.FormatInt(version, 10)), []byte(locale), []byte(codec))
+}
+
+// hashETag returns a strong tag hashing the parts
+func hashETag(parts ...[]byte) string {
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write(part)
+		h.Write([]byte{0})
+	}
+	return `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
+
+// setLastModified sets the Last-Modified header of a reply, against which
+// ConditionalFilter answers If-Modified-Since. A zero time sets none.
+func setLastModified(ctx context.Context, modified time.Time) {
+	if modified.IsZero() {
+		return
+	}
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		tr.ReplyHeader().Set("Last-Modified", modified.UTC().Format(http.TimeFormat))
+	}
+}
+
+// movieLastModified returns when a movie last changed: its latest write, or
+// the box-office refresh if it came later
+func movieLastModified(movie *biz.Movie) time.Time {
+	modified := movie.UpdatedAt
+	if movie.BoxOffice != nil && movie.BoxOffice.LastUpdated.After(modified) {
+		modified = movie.BoxOffice.LastUpdated
+	}
+	return modified
+}
+
+// replyCodec returns the name of the codec a reply to the request is encoded
+// with: the one its Accept header selects over HTTP, as the response encoder
+// picks it, and proto over gRPC
+func replyCodec(ctx context.Context) string {
+	if r, ok := khttp.RequestFromServerContext(ctx); ok {
+		codec, _ := khttp.CodecForRequest(r, "Accept")
+		return codec.Name()
+	}
+	return "proto"
+}
+
+// MatchETag reports whether an If-Match or If-None-Match header value lists
+// etag, or is "*". Weak comparison, as If-None-Match uses, ignores the W/
+// prefix; strong comparison, as If-Match uses, never matches a weak tag.
+func MatchETag(header, etag string, weak bool) bool {
+	if etag == "" {
+		return false
+	}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return true
+		}
+		if weak {
+			tag = strings.TrimPrefix(tag, "W/")
+			etag = strings.TrimPrefix(etag, "W/")
+		} else if strings.HasPrefix(tag, "W/") {
+			continue
+		}
+		if tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// expectedVersion returns the version of a movie a write to it is made
+// against: the one the request names, or else the one of the movie the
+// If-Match header was checked against, so that no write can slip in between
+// the check and this one. The check fails while the movie, as this caller
+// would get it, has none of the ETags listed, or cannot be read. Zero makes
+// the write unconditional.
+func (s *MovieService) expectedVersion(ctx context.Context, title string, version *int64) (int64, error) {
+	expected := int64(0)
+	if version != nil {
+		expected = *version
+	}
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return expected, nil
+	}
+	ifMatch := tr.RequestHeader().Get("If-Match")
+	if ifMatch == "" {
+		return expected, nil
+	}
+	movie, err := s.movieUC.GetMovieByTitle(ctx, title)
+	if errors.Is(err, biz.ErrAmbiguousTitle) {
+		return 0, err
+	}
+	if err != nil {
+		return 0, biz.ErrPreconditionFailed
+	}
+	locale := movie.Localize(biz.LocalesFromContext(ctx)).Locale
+	if !MatchETag(ifMatch, MovieETag(movie.ID, movie.Version, locale, replyCodec(ctx)), false) {
+		return 0, biz.ErrPreconditionFailed
+	}
+	if expected == 0 {
+		expected = movie.Version
+	}
+	return expected, nil
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport"
+
+	v1 "src/api/movie/v1"
+	"src/internal/biz"
+)
+
+// fakeTransport is a server transport with plain headers
+type fakeTransport struct {
+	kind        transport.Kind
+	reqHeader   headerCarrier
+	replyHeader headerCarrier
+}
+
+type headerCarrier http.Header
+
+func (hc headerCarrier) Get(key string) string      { return http.Header(hc).Get(key) }
+func (hc headerCarrier) Set(key, value string)      { http.Header(hc).Set(key, value) }
+func (hc headerCarrier) Add(key, value string)      { http.Header(hc).Add(key, value) }
+func (hc headerCarrier) Keys() []string             { return nil }
+func (hc headerCarrier) Values(key string) []string { return http.Header(hc).Values(key) }
+
+func (tr *fakeTransport) Kind() transport.Kind            { return tr.kind }
+func (tr *fakeTransport) Endpoint() string                { return "" }
+func (tr *fakeTransport) Operation() string               { return "" }
+func (tr *fakeTransport) RequestHeader() transport.Header { return tr.reqHeader }
+func (tr *fakeTransport) ReplyHeader() transport.Header   { return tr.replyHeader }
+
+func newFakeTransport() *fakeTransport {
+	return &fakeTransport{kind: transport.KindGRPC, reqHeader: headerCarrier{}, replyHeader: headerCarrier{}}
+}
+
+func TestSetLastModified(t *testing.T) {
+	updated := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		movie *biz.Movie
+		want  string
+	}{
+		{
+			name:  "latest write",
+			movie: &biz.Movie{UpdatedAt: updated},
+			want:  "Thu, 01 Oct 2026 12:00:00 GMT",
+		},
+		{
+			name:  "later box-office refresh",
+			movie: &biz.Movie{UpdatedAt: updated, BoxOffice: &biz.BoxOffice{LastUpdated: updated.Add(time.Hour)}},
+			want:  "Thu, 01 Oct 2026 13:00:00 GMT",
+		},
+		{
+			name:  "earlier box-office refresh",
+			movie: &biz.Movie{UpdatedAt: updated, BoxOffice: &biz.BoxOffice{LastUpdated: updated.Add(-time.Hour)}},
+			want:  "Thu, 01 Oct 2026 12:00:00 GMT",
+		},
+		{
+			name:  "unknown",
+			movie: &biz.Movie{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := newFakeTransport()
+			setLastModified(transport.NewServerContext(context.Background(), tr), movieLastModified(tt.movie))
+			if got := tr.replyHeader.Get("Last-Modified"); got != tt.want {
+				t.Errorf("Last-Modified = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMovieETag(t *testing.T) {
+	base := MovieETag("m1", 3, "en", "json")
+
+	tests := []struct {
+		name string
+		etag string
+		same bool
+	}{
+		{name: "same representation", etag: MovieETag("m1", 3, "en", "json"), same: true},
+		{name: "other version", etag: MovieETag("m1", 4, "en", "json")},
+		{name: "other movie", etag: MovieETag("m2", 3, "en", "json")},
+		{name: "other locale", etag: MovieETag("m1", 3, "fr", "json")},
+		{name: "other codec", etag: MovieETag("m1", 3, "en", "proto")},
+		{name: "parts do not run together", etag: MovieETag("m13", 0, "en", "json")},
+		{
+			name: "created movie",
+			etag: ETag(&v1.CreateMovieReply{Id: "m1", Version: 3, Locale: "en"}, "json"),
+			same: true,
+		},
+		{
+			name: "movie item",
+			etag: ETag(&v1.MovieItem{Id: "m1", Version: 3, Locale: "en", BoxOffice: &v1.BoxOffice{}}, "json"),
+			same: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if (tt.etag == base) != tt.same {
+				t.Errorf("tag %s, base %s, want same = %v", tt.etag, base, tt.same)
+			}
+		})
+	}
+}
+
+func TestMatchETag(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		etag   string
+		weak   bool
+		want   bool
+	}{
+		{name: "same tag", header: `"abc"`, etag: `"abc"`, want: true},
+		{name: "other tag", header: `"abc"`, etag: `"def"`},
+		{name: "one of a list", header: `"abc", "def" ,"ghi"`, etag: `"def"`, want: true},
+		{name: "none of a list", header: `"abc", "ghi"`, etag: `"def"`},
+		{name: "any tag", header: "*", etag: `"def"`, want: true},
+		{name: "no tag to match", header: "*", etag: ""},
+		{name: "weak tag fails a strong comparison", header: `W/"abc"`, etag: `"abc"`},
+		{name: "weak tag passes a weak comparison", header: `W/"abc"`, etag: `"abc"`, weak: true, want: true},
+		{name: "weak etag passes a weak comparison", header: `"abc"`, etag: `W/"abc"`, weak: true, want: true},
+		{name: "unquoted tag", header: "abc", etag: `"abc"`},
+		{name: "empty header", header: "", etag: `"abc"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchETag(tt.header, tt.etag, tt.weak); got != tt.want {
+				t.Errorf("MatchETag(%q, %q, %v) = %v, want %v", tt.header, tt.etag, tt.weak, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"io"
+
+	"google.golang.org/grpc"
+
+	v1 "src/api/movie/v1"
+	"src/internal/biz"
+)
+
+// exportChunkSize bounds the data of one ExportMoviesChunk, well below the
+// default 4 MiB message limit of gRPC clients
+const exportChunkSize = 64 << 10
+
+// ExportMovies streams the movies matching the filters as an export file.
+// The server middleware does not see the request of a stream, so it is
+// validated here.
+func (s *MovieService) ExportMovies(req *v1.ExportMoviesRequest, stream grpc.ServerStreamingServer[v1.ExportMoviesChunk]) error {
+	if err := req.ValidateAll(); err != nil {
+		return ValidationError(err)
+	}
+	w := bufio.NewWriterSize(&chunkSender{stream: stream}, exportChunkSize)
+	return s.WriteMovieExport(stream.Context(), req, w, w.Flush)
+}
+
+// WriteMovieExport writes the export file of an ExportMovies request to w,
+// calling flush after every page of movies. An invalid request fails before
+// anything is written.
+func (s *MovieService) WriteMovieExport(ctx context.Context, req *v1.ExportMoviesRequest, w io.Writer, flush func() error) error {
+	query := &biz.MovieListQuery{
+		Q:           req.Q,
+		Year:        req.Year,
+		Genre:       req.Genre,
+		Distributor: req.Distributor,
+		Budget:      req.Budget,
+		MPARating:   req.MpaRating,
+		Director:    req.Director,
+		Actor:       req.Actor,
+		Tag:         req.Tag,
+	}
+
+	return s.movieUC.ExportMovies(ctx, query, biz.ExportFormat(req.Format), w, flush)
+}
+
+// chunkSender sends what is written to it as ExportMoviesChunk messages
+type chunkSender struct {
+	stream grpc.ServerStreamingServer[v1.ExportMoviesChunk]
+}
+
+func (c *chunkSender) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := min(len(p), exportChunkSize)
+		if err := c.stream.Send(&v1.ExportMoviesChunk{Data: p[:n]}); err != nil {
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+	return written, nil
+}
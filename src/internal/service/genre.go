@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+
+	v1 "src/api/movie/v1"
+	"src/internal/biz"
+)
+
+// GenreService implements the GenreService API
+type GenreService struct {
+	v1.UnimplementedGenreServiceServer
+
+	genreUC *biz.GenreUseCase
+}
+
+// NewGenreService creates a new GenreService
+func NewGenreService(genreUC *biz.GenreUseCase) *GenreService {
+	return &GenreService{
+		genreUC: genreUC,
+	}
+}
+
+// ListGenres implements genre listing
+func (s *GenreService) ListGenres(ctx context.Context, req *v1.ListGenresRequest) (*v1.ListGenresReply, error) {
+	genres, err := s.genreUC.ListGenres(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &v1.ListGenresReply{
+		Items: make([]*v1.Genre, 0, len(genres)),
+	}
+	for _, genre := range genres {
+		reply.Items = append(reply.Items, genreToProto(genre))
+	}
+
+	return reply, nil
+}
+
+// CreateGenre implements genre creation
+func (s *GenreService) CreateGenre(ctx context.Context, req *v1.CreateGenreRequest) (*v1.Genre, error) {
+	genre, err := s.genreUC.CreateGenre(ctx, req.Name, req.Aliases)
+	if err != nil {
+		return nil, err
+	}
+
+	return genreToProto(genre), nil
+}
+
+// AddGenreAlias implements adding an alias to a genre
+func (s *GenreService) AddGenreAlias(ctx context.Context, req *v1.AddGenreAliasRequest) (*v1.Genre, error) {
+	genre, err := s.genreUC.AddGenreAlias(ctx, req.Id, req.Alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return genreToProto(genre), nil
+}
+
+// SetGenreTranslation implements setting the name of a genre in a locale
+func (s *GenreService) SetGenreTranslation(ctx context.Context, req *v1.SetGenreTranslationRequest) (*v1.Genre, error) {
+	genre, err := s.genreUC.SetGenreTranslation(ctx, req.Id, req.Locale, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return genreToProto(genre), nil
+}
+
+// DeleteGenreTranslation implements removing the name of a genre in a locale
+func (s *GenreService) DeleteGenreTranslation(ctx context.Context, req *v1.DeleteGenreTranslationRequest) (*v1.Genre, error) {
+	genre, err := s.genreUC.DeleteGenreTranslation(ctx, req.Id, req.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	return genreToProto(genre), nil
+}
+
+// genreToProto converts biz.Genre to proto
+func genreToProto(genre *biz.Genre) *v1.Genre {
+	return &v1.Genre{
+		Id:           genre.ID,
+		Name:         genre.Name,
+		Aliases:      genre.Aliases,
+		Translations: genre.Translations,
+	}
+}
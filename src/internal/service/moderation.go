@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+
+	kErrors "github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "src/api/movie/v1"
+	"src/internal/biz"
+)
+
+// ModerationService implements the ModerationService API
+type ModerationService struct {
+	v1.UnimplementedModerationServiceServer
+
+	moderationUC *biz.ModerationUseCase
+}
+
+// NewModerationService creates a new ModerationService
+func NewModerationService(moderationUC *biz.ModerationUseCase) *ModerationService {
+	return &ModerationService{
+		moderationUC: moderationUC,
+	}
+}
+
+// ReportReview implements reporting of abusive reviews and ratings
+func (s *ModerationService) ReportReview(ctx context.Context, req *v1.ReportReviewRequest) (*v1.ReportReviewReply, error) {
+	// Extract reporter ID from context (set by middleware)
+	reporterID, ok := ctx.Value("rater_id").(string)
+	if !ok || reporterID == "" {
+		return nil, kErrors.Unauthorized("UNAUTHORIZED", "missing X-Rater-Id header")
+	}
+
+	if req.Id <= 0 {
+		return nil, kErrors.New(422, "UNPROCESSABLE_ENTITY", "invalid review id")
+	}
+
+	var reason *string
+	if req.Reason != "" {
+		reason = &req.Reason
+	}
+
+	rating, err := s.moderationUC.ReportRating(ctx, req.Id, reporterID, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ReportReviewReply{
+		Id:               rating.ID,
+		ModerationStatus: string(rating.ModerationStatus),
+		ReportCount:      rating.ReportCount,
+	}, nil
+}
+
+// ListModerationQueue implements listing of the moderation queue
+func (s *ModerationService) ListModerationQueue(ctx context.Context, req *v1.ListModerationQueueRequest) (*v1.ListModerationQueueReply, error) {
+	query := &biz.ModerationQueueQuery{
+		Limit: 10, // Default limit
+	}
+
+	if req.Status != nil {
+		query.Status = biz.ModerationStatus(*req.Status)
+	}
+	if req.Limit != nil {
+		query.Limit = *req.Limit
+	}
+	if req.Cursor != nil {
+		query.Cursor = req.Cursor
+	}
+
+	page, err := s.moderationUC.ListQueue(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &v1.ListModerationQueueReply{
+		Items: make([]*v1.ReviewItem, 0, len(page.Items)),
+	}
+	for _, rating := range page.Items {
+		reply.Items = append(reply.Items, reviewItemToProto(rating))
+	}
+	if page.NextCursor != nil {
+		reply.NextCursor = page.NextCursor
+	}
+
+	return reply, nil
+}
+
+// ModerateReview implements moderator actions on a review
+func (s *ModerationService) ModerateReview(ctx context.Context, req *v1.ModerateReviewRequest) (*v1.ModerateReviewReply, error) {
+	rating, err := s.moderationUC.Moderate(ctx, req.Id, req.GetVersion(), req.Action, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ModerateReviewReply{
+		Item: reviewItemToProto(rating),
+	}, nil
+}
+
+// Helper functions
+
+func reviewItemToProto(rating *biz.Rating) *v1.ReviewItem {
+	return &v1.ReviewItem{
+		Id:               rating.ID,
+		MovieTitle:       rating.MovieTitle,
+		RaterId:          rating.RaterID,
+		Rating:           rating.Rating,
+		Review:           rating.Review,
+		ModerationStatus: string(rating.ModerationStatus),
+		ModerationReason: rating.ModerationReason,
+		ReportCount:      rating.ReportCount,
+		UpdatedAt:        timestamppb.New(convertToLocalTime(rating.UpdatedAt)),
+		Version:          rating.Version,
+	}
+}
@@ -2,8 +2,7 @@ package service
 
 import (
 	"context"
-	"errors"
-	"fmt"
+	"strings"
 	"time"
 
 	kErrors "github.com/go-kratos/kratos/v2/errors"
@@ -28,30 +27,24 @@ type MovieService struct {
 
 	movieUC  *biz.MovieUseCase
 	ratingUC *biz.RatingUseCase
+	imageUC  *biz.ImageUseCase
 }
 
 // NewMovieService creates a new MovieService
-func NewMovieService(movieUC *biz.MovieUseCase, ratingUC *biz.RatingUseCase) *MovieService {
+func NewMovieService(movieUC *biz.MovieUseCase, ratingUC *biz.RatingUseCase, imageUC *biz.ImageUseCase) *MovieService {
 	return &MovieService{
 		movieUC:  movieUC,
 		ratingUC: ratingUC,
+		imageUC:  imageUC,
 	}
 }
 
 // CreateMovie implements movie creation
 func (s *MovieService) CreateMovie(ctx context.Context, req *v1.CreateMovieRequest) (*v1.CreateMovieReply, error) {
-	// Validate required fields
-	if req.Title == "" {
-		return nil, kErrors.New(422, "UNPROCESSABLE_ENTITY", "title is required")
-	}
-	if req.Genre == "" {
-		return nil, kErrors.New(422, "UNPROCESSABLE_ENTITY", "genre is required")
-	}
-
-	// Parse release date
+	// The request rules check the format of the date, not that it exists
 	releaseDate, err := time.Parse("2006-01-02", req.ReleaseDate)
 	if err != nil {
-		return nil, kErrors.New(422, "UNPROCESSABLE_ENTITY", fmt.Sprintf("invalid release_date format, expected YYYY-MM-DD: %v", err))
+		return nil, biz.NewValidationError("release_date", "is not a valid date")
 	}
 
 	// Convert proto request to biz model
@@ -70,6 +63,10 @@ func (s *MovieService) CreateMovie(ctx context.Context, req *v1.CreateMovieReque
 	if req.MpaRating != nil {
 		bizReq.MPARating = req.MpaRating
 	}
+	bizReq.Genres = req.Genres
+	bizReq.Tags = req.Tags
+	bizReq.Credits = creditsFromProto(req.Credits)
+	bizReq.ExternalIDs = req.ExternalIds
 
 	// Call business logic
 	movie, err := s.movieUC.CreateMovie(ctx, bizReq)
@@ -78,7 +75,7 @@ func (s *MovieService) CreateMovie(ctx context.Context, req *v1.CreateMovieReque
 	}
 
 	// Convert biz model to proto response
-	return s.movieToProto(movie), nil
+	return s.movieToProto(ctx, movie), nil
 }
 
 // ListMovies implements movie listing
@@ -106,6 +103,15 @@ func (s *MovieService) ListMovies(ctx context.Context, req *v1.ListMoviesRequest
 	if req.MpaRating != nil {
 		query.MPARating = req.MpaRating
 	}
+	if req.Director != nil {
+		query.Director = req.Director
+	}
+	if req.Actor != nil {
+		query.Actor = req.Actor
+	}
+	if req.Tag != nil {
+		query.Tag = req.Tag
+	}
 	if req.Limit != nil {
 		query.Limit = *req.Limit
 	}
@@ -125,7 +131,7 @@ func (s *MovieService) ListMovies(ctx context.Context, req *v1.ListMoviesRequest
 	}
 
 	for _, movie := range page.Items {
-		reply.Items = append(reply.Items, s.movieItemToProto(movie))
+		reply.Items = append(reply.Items, s.movieItemToProto(ctx, movie))
 	}
 
 	if page.NextCursor != nil {
@@ -135,6 +141,227 @@ func (s *MovieService) ListMovies(ctx context.Context, req *v1.ListMoviesRequest
 	return reply, nil
 }
 
+// BatchGetMovies looks up several movies by title or ID
+func (s *MovieService) BatchGetMovies(ctx context.Context, req *v1.BatchGetMoviesRequest) (*v1.BatchGetMoviesReply, error) {
+	lookups, err := s.movieUC.BatchGetMovies(ctx, req.Titles, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &v1.BatchGetMoviesReply{Results: make([]*v1.BatchMovieResult, 0, len(lookups))}
+	for _, lookup := range lookups {
+		result := &v1.BatchMovieResult{Title: lookup.Title, Id: lookup.ID}
+		if lookup.Err != nil {
+			result.Error = lookupErrorToProto(lookup.Err)
+		} else {
+			result.Movie = s.movieItemToProto(ctx, lookup.Movie)
+		}
+		reply.Results = append(reply.Results, result)
+	}
+	return reply, nil
+}
+
+// BulkImportMovies creates movies in bulk from a CSV or JSONL file
+func (s *MovieService) BulkImportMovies(ctx context.Context, req *v1.BulkImportMoviesRequest) (*v1.BulkImportMoviesReply, error) {
+	opts := biz.ImportOptions{
+		Format:    biz.ImportFormat(req.Format),
+		Mode:      biz.ImportMode(req.Mode),
+		DryRun:    req.DryRun,
+		BatchSize: int(req.GetBatchSize()),
+		BoxOffice: req.BoxOffice,
+		Validate:  validateImportRecord,
+	}
+
+	report, err := s.movieUC.ImportMovies(ctx, strings.NewReader(req.Data), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &v1.BulkImportMoviesReply{
+		DryRun:  report.DryRun,
+		Created: int32(report.Created),
+		Updated: int32(report.Updated),
+		Skipped: int32(report.Skipped),
+		Failed:  int32(report.Failed),
+		Rows:    make([]*v1.ImportRowResult, 0, len(report.Rows)),
+	}
+	for _, row := range report.Rows {
+		result := &v1.ImportRowResult{
+			Line:    int32(row.Line),
+			Title:   row.Title,
+			Status:  string(row.Status),
+			MovieId: row.MovieID,
+		}
+		if row.Error != "" {
+			result.Error = &row.Error
+		}
+		reply.Rows = append(reply.Rows, result)
+	}
+	return reply, nil
+}
+
+// GetMovieByExternalId finds the movie holding an external ID
+func (s *MovieService) GetMovieByExternalId(ctx context.Context, req *v1.GetMovieByExternalIdRequest) (*v1.MovieItem, error) {
+	movie, err := s.movieUC.GetMovieByExternalID(ctx, req.Scheme, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	setLastModified(ctx, movieLastModified(movie))
+	return s.movieItemToProto(ctx, movie), nil
+}
+
+// SetMovieExternalId sets or replaces the ID of a movie in an external scheme
+func (s *MovieService) SetMovieExternalId(ctx context.Context, req *v1.SetMovieExternalIdRequest) (*v1.MovieItem, error) {
+	version, err := s.expectedVersion(ctx, req.Title, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	movie, err := s.movieUC.SetMovieExternalID(ctx, req.Title, req.Scheme, req.Id, version)
+	if err != nil {
+		return nil, err
+	}
+	return s.movieItemToProto(ctx, movie), nil
+}
+
+// DeleteMovieExternalId removes the ID of a movie in an external scheme
+func (s *MovieService) DeleteMovieExternalId(ctx context.Context, req *v1.DeleteMovieExternalIdRequest) (*v1.MovieItem, error) {
+	version, err := s.expectedVersion(ctx, req.Title, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	movie, err := s.movieUC.DeleteMovieExternalID(ctx, req.Title, req.Scheme, version)
+	if err != nil {
+		return nil, err
+	}
+	return s.movieItemToProto(ctx, movie), nil
+}
+
+// SetMovieCredits implements replacing the cast and crew of a movie
+func (s *MovieService) SetMovieCredits(ctx context.Context, req *v1.SetMovieCreditsRequest) (*v1.MovieItem, error) {
+	version, err := s.expectedVersion(ctx, req.Title, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	movie, err := s.movieUC.SetMovieCredits(ctx, req.Title, creditsFromProto(req.Credits), version)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.movieItemToProto(ctx, movie), nil
+}
+
+// SetMovieGenres implements replacing the genres of a movie
+func (s *MovieService) SetMovieGenres(ctx context.Context, req *v1.SetMovieGenresRequest) (*v1.MovieItem, error) {
+	version, err := s.expectedVersion(ctx, req.Title, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	movie, err := s.movieUC.SetMovieGenres(ctx, req.Title, req.Genres, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.movieItemToProto(ctx, movie), nil
+}
+
+// SetMovieTags implements replacing the tags of a movie
+func (s *MovieService) SetMovieTags(ctx context.Context, req *v1.SetMovieTagsRequest) (*v1.MovieItem, error) {
+	version, err := s.expectedVersion(ctx, req.Title, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	movie, err := s.movieUC.SetMovieTags(ctx, req.Title, req.Tags, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.movieItemToProto(ctx, movie), nil
+}
+
+// AddAlternateTitle adds another title the movie is known by
+func (s *MovieService) AddAlternateTitle(ctx context.Context, req *v1.AddAlternateTitleRequest) (*v1.MovieItem, error) {
+	alt := &biz.AlternateTitle{
+		Title:    req.AlternateTitle,
+		Language: req.Language,
+		Region:   req.Region,
+	}
+	if req.Kind != nil {
+		alt.Kind = biz.AlternateTitleKind(*req.Kind)
+	}
+
+	version, err := s.expectedVersion(ctx, req.Title, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	movie, err := s.movieUC.AddAlternateTitle(ctx, req.Title, alt, version)
+	if err != nil {
+		return nil, err
+	}
+	return s.movieItemToProto(ctx, movie), nil
+}
+
+// RemoveAlternateTitle removes an alternate title of a movie
+func (s *MovieService) RemoveAlternateTitle(ctx context.Context, req *v1.RemoveAlternateTitleRequest) (*v1.MovieItem, error) {
+	version, err := s.expectedVersion(ctx, req.Title, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	movie, err := s.movieUC.RemoveAlternateTitle(ctx, req.Title, req.Id, version)
+	if err != nil {
+		return nil, err
+	}
+	return s.movieItemToProto(ctx, movie), nil
+}
+
+// SetMovieTranslation creates or replaces the text of a movie in a locale
+func (s *MovieService) SetMovieTranslation(ctx context.Context, req *v1.SetMovieTranslationRequest) (*v1.MovieItem, error) {
+	translation := &biz.MovieTranslation{
+		Locale:   req.Locale,
+		Synopsis: req.Synopsis,
+		Tagline:  req.Tagline,
+	}
+
+	version, err := s.expectedVersion(ctx, req.Title, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	movie, err := s.movieUC.SetMovieTranslation(ctx, req.Title, translation, version)
+	if err != nil {
+		return nil, err
+	}
+	return s.movieItemToProto(ctx, movie), nil
+}
+
+// DeleteMovieTranslation removes the text of a movie in a locale
+func (s *MovieService) DeleteMovieTranslation(ctx context.Context, req *v1.DeleteMovieTranslationRequest) (*v1.MovieItem, error) {
+	version, err := s.expectedVersion(ctx, req.Title, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	movie, err := s.movieUC.DeleteMovieTranslation(ctx, req.Title, req.Locale, version)
+	if err != nil {
+		return nil, err
+	}
+	return s.movieItemToProto(ctx, movie), nil
+}
+
+// UploadMovieImage stores a poster or still of a movie with its thumbnails
+func (s *MovieService) UploadMovieImage(ctx context.Context, req *v1.UploadMovieImageRequest) (*v1.MovieImage, error) {
+	image, err := s.imageUC.UploadMovieImage(ctx, req.Id, biz.ImageKind(req.Kind), req.Data)
+	if err != nil {
+		return nil, err
+	}
+	return imageToProto(image), nil
+}
+
+// DeleteMovieImage removes an image of a movie with its thumbnails
+func (s *MovieService) DeleteMovieImage(ctx context.Context, req *v1.DeleteMovieImageRequest) (*v1.DeleteMovieImageReply, error) {
+	if err := s.imageUC.DeleteMovieImage(ctx, req.Id, req.ImageId); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteMovieImageReply{}, nil
+}
+
 // SubmitRating implements rating submission
 func (s *MovieService) SubmitRating(ctx context.Context, req *v1.SubmitRatingRequest) (*v1.SubmitRatingReply, error) {
 	// Extract rater ID from context (set by middleware)
@@ -143,46 +370,130 @@ func (s *MovieService) SubmitRating(ctx context.Context, req *v1.SubmitRatingReq
 		return nil, kErrors.Unauthorized("UNAUTHORIZED", "missing X-Rater-Id header")
 	}
 
-	// Validate rating value (parameter validation in Service layer)
-	if !isValidRating(req.Rating) {
-		return nil, kErrors.New(422, "INVALID_RATING", "invalid rating value: must be between 0.5 and 5.0 with 0.5 step")
-	}
-
 	// Call business logic
-	rating, err := s.ratingUC.SubmitRating(ctx, req.Title, raterID, req.Rating)
+	rating, err := s.ratingUC.SubmitRating(ctx, req.Title, raterID, req.Rating, req.Review, req.GetVersion())
 	if err != nil {
-		// Check error type using errors.Is()
-		if errors.Is(err, biz.ErrMovieNotFound) {
-			return nil, kErrors.NotFound("NOT_FOUND", "movie not found")
-		}
 		return nil, err
 	}
 
 	return &v1.SubmitRatingReply{
-		MovieTitle: rating.MovieTitle,
-		RaterId:    rating.RaterID,
-		Rating:     rating.Rating,
+		MovieTitle:       rating.MovieTitle,
+		RaterId:          rating.RaterID,
+		Rating:           rating.Rating,
+		Id:               rating.ID,
+		Review:           rating.Review,
+		ModerationStatus: string(rating.ModerationStatus),
+		Version:          rating.Version,
 	}, nil
 }
 
 // GetRating implements rating aggregation
 func (s *MovieService) GetRating(ctx context.Context, req *v1.GetRatingRequest) (*v1.GetRatingReply, error) {
+	window, err := biz.ParseRatingWindow(req.GetWindow())
+	if err != nil {
+		return nil, err
+	}
+
 	// Call business logic
-	agg, err := s.ratingUC.GetRatingAggregate(ctx, req.Title)
+	agg, err := s.ratingUC.GetRatingAggregate(ctx, req.Title, window)
 	if err != nil {
-		// Check error type using errors.Is()
-		if errors.Is(err, biz.ErrMovieNotFound) {
-			return nil, kErrors.NotFound("NOT_FOUND", "movie not found")
-		}
 		return nil, err
 	}
+	setLastModified(ctx, agg.LastModified)
 
 	return &v1.GetRatingReply{
-		Average: agg.Average,
-		Count:   agg.Count,
+		Average:     agg.Average,
+		Count:       agg.Count,
+		UnderReview: agg.UnderReview,
 	}, nil
 }
 
+// BatchGetRatings returns the rating aggregates of several movies
+func (s *MovieService) BatchGetRatings(ctx context.Context, req *v1.BatchGetRatingsRequest) (*v1.BatchGetRatingsReply, error) {
+	window, err := biz.ParseRatingWindow(req.GetWindow())
+	if err != nil {
+		return nil, err
+	}
+
+	lookups, err := s.ratingUC.BatchGetRatingAggregates(ctx, req.Titles, req.Ids, window)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &v1.BatchGetRatingsReply{Results: make([]*v1.BatchRatingResult, 0, len(lookups))}
+	for _, lookup := range lookups {
+		result := &v1.BatchRatingResult{Title: lookup.Title, Id: lookup.ID}
+		if lookup.Err != nil {
+			result.Error = lookupErrorToProto(lookup.Err)
+		} else {
+			result.Rating = &v1.GetRatingReply{
+				Average:     lookup.Aggregate.Average,
+				Count:       lookup.Aggregate.Count,
+				UnderReview: lookup.Aggregate.UnderReview,
+			}
+		}
+		reply.Results = append(reply.Results, result)
+	}
+	return reply, nil
+}
+
+// GetRatingHistory implements rating history listing
+func (s *MovieService) GetRatingHistory(ctx context.Context, req *v1.GetRatingHistoryRequest) (*v1.GetRatingHistoryReply, error) {
+	query := &biz.RatingHistoryQuery{
+		MovieTitle: req.Title,
+		RaterID:    req.RaterId,
+		Limit:      10, // Default limit
+		Cursor:     req.Cursor,
+	}
+
+	// Parse time filters
+	if req.Since != nil {
+		since, err := time.Parse(time.RFC3339, *req.Since)
+		if err != nil {
+			return nil, biz.NewValidationError("since", "must be an RFC 3339 timestamp")
+		}
+		query.Since = &since
+	}
+	if req.Until != nil {
+		until, err := time.Parse(time.RFC3339, *req.Until)
+		if err != nil {
+			return nil, biz.NewValidationError("until", "must be an RFC 3339 timestamp")
+		}
+		query.Until = &until
+	}
+	if req.Limit != nil {
+		query.Limit = *req.Limit
+	}
+
+	// Call business logic
+	page, err := s.ratingUC.GetRatingHistory(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &v1.GetRatingHistoryReply{
+		Items: make([]*v1.RatingEvent, 0, len(page.Items)),
+	}
+	for _, event := range page.Items {
+		reply.Items = append(reply.Items, &v1.RatingEvent{
+			Id:         event.ID,
+			MovieTitle: event.MovieTitle,
+			RaterId:    event.RaterID,
+			EventType:  event.EventType,
+			OldRating:  event.OldRating,
+			NewRating:  event.NewRating,
+			ClientIp:   event.ClientIP,
+			UserAgent:  event.UserAgent,
+			CreatedAt:  timestamppb.New(convertToLocalTime(event.CreatedAt)),
+		})
+	}
+	if page.NextCursor != nil {
+		reply.NextCursor = page.NextCursor
+	}
+
+	return reply, nil
+}
+
 // HealthCheck implements health check
 func (s *MovieService) HealthCheck(ctx context.Context, req *v1.HealthCheckRequest) (*v1.HealthCheckReply, error) {
 	return &v1.HealthCheckReply{
@@ -192,7 +503,7 @@ func (s *MovieService) HealthCheck(ctx context.Context, req *v1.HealthCheckReque
 
 // Helper functions
 
-func (s *MovieService) movieToProto(movie *biz.Movie) *v1.CreateMovieReply {
+func (s *MovieService) movieToProto(ctx context.Context, movie *biz.Movie) *v1.CreateMovieReply {
 	reply := &v1.CreateMovieReply{
 		Id:          movie.ID,
 		Title:       movie.Title,
@@ -229,10 +540,24 @@ func (s *MovieService) movieToProto(movie *biz.Movie) *v1.CreateMovieReply {
 	}
 	// Note: Do NOT set empty BoxOffice here - let it be nil for null serialization
 
+	reply.Genres = movie.Genres
+	reply.Tags = movie.Tags
+	reply.Credits = creditsToProto(movie.Credits)
+	reply.AlternateTitles = alternateTitlesToProto(movie.AlternateTitles)
+	reply.ExternalIds = movie.ExternalIDs
+	reply.Version = movie.Version
+
+	localization := movie.Localize(biz.LocalesFromContext(ctx))
+	reply.Locale = localization.Locale
+	reply.Synopsis = localization.Synopsis
+	reply.Tagline = localization.Tagline
+	reply.LocalizedGenres = localization.Genres
+	reply.Images = imagesToProto(movie.Images)
+
 	return reply
 }
 
-func (s *MovieService) movieItemToProto(movie *biz.Movie) *v1.MovieItem {
+func (s *MovieService) movieItemToProto(ctx context.Context, movie *biz.Movie) *v1.MovieItem {
 	item := &v1.MovieItem{
 		Id:          movie.ID,
 		Title:       movie.Title,
@@ -270,16 +595,90 @@ func (s *MovieService) movieItemToProto(movie *biz.Movie) *v1.MovieItem {
 		item.BoxOffice = &v1.BoxOffice{}
 	}
 
+	item.Genres = movie.Genres
+	item.Tags = movie.Tags
+	item.Credits = creditsToProto(movie.Credits)
+	item.AlternateTitles = alternateTitlesToProto(movie.AlternateTitles)
+	item.ExternalIds = movie.ExternalIDs
+	item.Version = movie.Version
+
+	localization := movie.Localize(biz.LocalesFromContext(ctx))
+	item.Locale = localization.Locale
+	item.Synopsis = localization.Synopsis
+	item.Tagline = localization.Tagline
+	item.LocalizedGenres = localization.Genres
+	item.Images = imagesToProto(movie.Images)
+
 	return item
 }
 
-// isValidRating checks if the rating value is valid (0.5 to 5.0 with 0.5 step)
-func isValidRating(rating float64) bool {
-	validRatings := []float64{0.5, 1.0, 1.5, 2.0, 2.5, 3.0, 3.5, 4.0, 4.5, 5.0}
-	for _, valid := range validRatings {
-		if rating == valid {
-			return true
-		}
+func creditsFromProto(credits []*v1.CreditInput) []*biz.Credit {
+	result := make([]*biz.Credit, 0, len(credits))
+	for _, c := range credits {
+		result = append(result, &biz.Credit{
+			PersonID:      c.PersonId,
+			Role:          biz.CreditRole(c.Role),
+			CharacterName: c.CharacterName,
+			BillingOrder:  c.BillingOrder,
+		})
+	}
+	return result
+}
+
+func creditsToProto(credits []*biz.Credit) []*v1.Credit {
+	result := make([]*v1.Credit, 0, len(credits))
+	for _, c := range credits {
+		result = append(result, &v1.Credit{
+			PersonId:      c.PersonID,
+			Name:          c.PersonName,
+			Role:          string(c.Role),
+			CharacterName: c.CharacterName,
+			BillingOrder:  c.BillingOrder,
+		})
+	}
+	return result
+}
+
+func alternateTitlesToProto(titles []*biz.AlternateTitle) []*v1.AlternateTitle {
+	result := make([]*v1.AlternateTitle, 0, len(titles))
+	for _, t := range titles {
+		result = append(result, &v1.AlternateTitle{
+			Id:       t.ID,
+			Title:    t.Title,
+			Language: t.Language,
+			Region:   t.Region,
+			Kind:     string(t.Kind),
+		})
+	}
+	return result
+}
+
+func imageToProto(image *biz.MovieImage) *v1.MovieImage {
+	result := &v1.MovieImage{
+		Id:          image.ID,
+		Kind:        string(image.Kind),
+		Url:         image.URL,
+		ContentType: image.ContentType,
+		Width:       image.Width,
+		Height:      image.Height,
+		SizeBytes:   image.SizeBytes,
+		Thumbnails:  make([]*v1.Thumbnail, 0, len(image.Thumbnails)),
+		CreatedAt:   timestamppb.New(convertToLocalTime(image.CreatedAt)),
+	}
+	for _, t := range image.Thumbnails {
+		result.Thumbnails = append(result.Thumbnails, &v1.Thumbnail{
+			Width:  t.Width,
+			Height: t.Height,
+			Url:    t.URL,
+		})
+	}
+	return result
+}
+
+func imagesToProto(images []*biz.MovieImage) []*v1.MovieImage {
+	result := make([]*v1.MovieImage, 0, len(images))
+	for _, image := range images {
+		result = append(result, imageToProto(image))
 	}
-	return false
+	return result
 }
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	kErrors "github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "src/api/movie/v1"
+	"src/internal/biz"
+)
+
+// PersonService implements the PersonService API
+type PersonService struct {
+	v1.UnimplementedPersonServiceServer
+
+	personUC *biz.PersonUseCase
+	movieSvc *MovieService
+}
+
+// NewPersonService creates a new PersonService
+func NewPersonService(personUC *biz.PersonUseCase, movieSvc *MovieService) *PersonService {
+	return &PersonService{
+		personUC: personUC,
+		movieSvc: movieSvc,
+	}
+}
+
+// CreatePerson implements person creation
+func (s *PersonService) CreatePerson(ctx context.Context, req *v1.CreatePersonRequest) (*v1.Person, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, kErrors.New(422, "UNPROCESSABLE_ENTITY", "name is required")
+	}
+
+	birthDate, err := parseBirthDate(req.BirthDate)
+	if err != nil {
+		return nil, err
+	}
+
+	person, err := s.personUC.CreatePerson(ctx, &biz.Person{
+		Name:      name,
+		BirthDate: birthDate,
+		Biography: req.Biography,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return personToProto(person), nil
+}
+
+// GetPerson implements person lookup
+func (s *PersonService) GetPerson(ctx context.Context, req *v1.GetPersonRequest) (*v1.Person, error) {
+	person, err := s.personUC.GetPerson(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return personToProto(person), nil
+}
+
+// UpdatePerson implements partial person updates
+func (s *PersonService) UpdatePerson(ctx context.Context, req *v1.UpdatePersonRequest) (*v1.Person, error) {
+	update := &biz.PersonUpdate{
+		Biography: req.Biography,
+	}
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, kErrors.New(422, "UNPROCESSABLE_ENTITY", "name must not be empty")
+		}
+		update.Name = &name
+	}
+
+	birthDate, err := parseBirthDate(req.BirthDate)
+	if err != nil {
+		return nil, err
+	}
+	update.BirthDate = birthDate
+
+	person, err := s.personUC.UpdatePerson(ctx, req.Id, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return personToProto(person), nil
+}
+
+// DeletePerson implements person deletion
+func (s *PersonService) DeletePerson(ctx context.Context, req *v1.DeletePersonRequest) (*v1.DeletePersonReply, error) {
+	if err := s.personUC.DeletePerson(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &v1.DeletePersonReply{}, nil
+}
+
+// ListPeople implements people listing
+func (s *PersonService) ListPeople(ctx context.Context, req *v1.ListPeopleRequest) (*v1.ListPeopleReply, error) {
+	query := &biz.PersonListQuery{
+		Q:      req.Q,
+		Limit:  10, // Default limit
+		Cursor: req.Cursor,
+	}
+	if req.Limit != nil {
+		query.Limit = *req.Limit
+	}
+
+	page, err := s.personUC.ListPeople(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &v1.ListPeopleReply{
+		Items:      make([]*v1.Person, 0, len(page.Items)),
+		NextCursor: page.NextCursor,
+	}
+	for _, person := range page.Items {
+		reply.Items = append(reply.Items, personToProto(person))
+	}
+
+	return reply, nil
+}
+
+// ListPersonMovies implements a person's filmography
+func (s *PersonService) ListPersonMovies(ctx context.Context, req *v1.ListPersonMoviesRequest) (*v1.ListPersonMoviesReply, error) {
+	credits, err := s.personUC.ListPersonMovies(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &v1.ListPersonMoviesReply{
+		Items: make([]*v1.PersonMovie, 0, len(credits)),
+	}
+	for _, c := range credits {
+		reply.Items = append(reply.Items, &v1.PersonMovie{
+			Movie:         s.movieSvc.movieItemToProto(ctx, c.Movie),
+			Role:          string(c.Role),
+			CharacterName: c.CharacterName,
+			BillingOrder:  c.BillingOrder,
+		})
+	}
+
+	return reply, nil
+}
+
+// parseBirthDate parses an optional YYYY-MM-DD date
+func parseBirthDate(value *string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+	date, err := time.Parse("2006-01-02", *value)
+	if err != nil {
+		return nil, kErrors.New(422, "UNPROCESSABLE_ENTITY", fmt.Sprintf("invalid birth_date format, expected YYYY-MM-DD: %v", err))
+	}
+	return &date, nil
+}
+
+// personToProto converts biz.Person to proto
+func personToProto(person *biz.Person) *v1.Person {
+	reply := &v1.Person{
+		Id:        person.ID,
+		Name:      person.Name,
+		Biography: person.Biography,
+		CreatedAt: timestamppb.New(convertToLocalTime(person.CreatedAt)),
+		UpdatedAt: timestamppb.New(convertToLocalTime(person.UpdatedAt)),
+	}
+	if person.BirthDate != nil {
+		birthDate := person.BirthDate.Format("2006-01-02")
+		reply.BirthDate = &birthDate
+	}
+	return reply
+}
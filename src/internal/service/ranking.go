@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+
+	v1 "src/api/movie/v1"
+	"src/internal/biz"
+)
+
+// RankingService implements the RankingService API
+type RankingService struct {
+	v1.UnimplementedRankingServiceServer
+
+	rankingUC *biz.RankingUseCase
+}
+
+// NewRankingService creates a new RankingService
+func NewRankingService(rankingUC *biz.RankingUseCase) *RankingService {
+	return &RankingService{
+		rankingUC: rankingUC,
+	}
+}
+
+// ListTopRated implements the top-rated leaderboard
+func (s *RankingService) ListTopRated(ctx context.Context, req *v1.ListRankingRequest) (*v1.ListRankingReply, error) {
+	query, err := rankingQueryFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	movies, err := s.rankingUC.ListTopRated(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return rankingReply(query.Window, movies), nil
+}
+
+// ListPopular implements the most-rated leaderboard
+func (s *RankingService) ListPopular(ctx context.Context, req *v1.ListRankingRequest) (*v1.ListRankingReply, error) {
+	query, err := rankingQueryFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	movies, err := s.rankingUC.ListPopular(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return rankingReply(query.Window, movies), nil
+}
+
+// ListTrending implements the trending leaderboard
+func (s *RankingService) ListTrending(ctx context.Context, req *v1.ListTrendingRequest) (*v1.ListTrendingReply, error) {
+	query := &biz.TrendingQuery{
+		Genre: req.Genre,
+		Year:  req.Year,
+		Limit: 10, // Default limit
+	}
+	if req.Limit != nil {
+		query.Limit = *req.Limit
+	}
+
+	movies, err := s.rankingUC.ListTrending(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*v1.TrendingItem, 0, len(movies))
+	for i, m := range movies {
+		items = append(items, &v1.TrendingItem{
+			Rank:    int32(i + 1),
+			Title:   m.Title,
+			Score:   m.Score,
+			Average: m.Average,
+			Count:   m.Count,
+		})
+	}
+
+	return &v1.ListTrendingReply{Items: items}, nil
+}
+
+// rankingQueryFromProto converts a leaderboard request to a biz query
+func rankingQueryFromProto(req *v1.ListRankingRequest) (*biz.RankingQuery, error) {
+	window, err := biz.ParseRatingWindow(req.GetWindow())
+	if err != nil {
+		return nil, err
+	}
+	query := &biz.RankingQuery{
+		Window:    window,
+		Limit:     10, // Default limit
+		Genre:     req.Genre,
+		Year:      req.Year,
+		MPARating: req.MpaRating,
+	}
+	if req.Limit != nil {
+		query.Limit = *req.Limit
+	}
+
+	return query, nil
+}
+
+// rankingReply converts leaderboard entries to proto with 1-based ranks
+func rankingReply(window biz.RatingWindow, movies []*biz.RankedMovie) *v1.ListRankingReply {
+	items := make([]*v1.RankingItem, 0, len(movies))
+	for i, m := range movies {
+		items = append(items, &v1.RankingItem{
+			Rank:    int32(i + 1),
+			Title:   m.Title,
+			Average: m.Average,
+			Count:   m.Count,
+		})
+	}
+
+	return &v1.ListRankingReply{
+		Window: window.String(),
+		Items:  items,
+	}
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+
+	kErrors "github.com/go-kratos/kratos/v2/errors"
+
+	v1 "src/api/movie/v1"
+	"src/internal/biz"
+)
+
+// RecommendationService implements the RecommendationService API
+type RecommendationService struct {
+	v1.UnimplementedRecommendationServiceServer
+
+	similarityUC     *biz.SimilarityUseCase
+	recommendationUC *biz.RecommendationUseCase
+}
+
+// NewRecommendationService creates a new RecommendationService
+func NewRecommendationService(similarityUC *biz.SimilarityUseCase, recommendationUC *biz.RecommendationUseCase) *RecommendationService {
+	return &RecommendationService{
+		similarityUC:     similarityUC,
+		recommendationUC: recommendationUC,
+	}
+}
+
+// GetSimilarMovies implements similar-movie listing
+func (s *RecommendationService) GetSimilarMovies(ctx context.Context, req *v1.GetSimilarMoviesRequest) (*v1.GetSimilarMoviesReply, error) {
+	limit := int32(10) // Default limit
+	if req.Limit != nil {
+		limit = *req.Limit
+	}
+
+	similar, err := s.similarityUC.GetSimilarMovies(ctx, req.Title, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*v1.SimilarMovieItem, 0, len(similar))
+	for _, m := range similar {
+		items = append(items, &v1.SimilarMovieItem{
+			Title:      m.Title,
+			Similarity: m.Similarity,
+			CoRaters:   m.CoRaters,
+			Source:     string(m.Source),
+		})
+	}
+
+	return &v1.GetSimilarMoviesReply{Items: items}, nil
+}
+
+// GetRecommendations implements personalized recommendations for the calling rater
+func (s *RecommendationService) GetRecommendations(ctx context.Context, req *v1.GetRecommendationsRequest) (*v1.GetRecommendationsReply, error) {
+	// Extract rater ID from context (set by middleware)
+	raterID, ok := ctx.Value("rater_id").(string)
+	if !ok || raterID == "" {
+		return nil, kErrors.Unauthorized("UNAUTHORIZED", "missing X-Rater-Id header")
+	}
+
+	limit := int32(10) // Default limit
+	if req.Limit != nil {
+		limit = *req.Limit
+	}
+
+	movies, err := s.recommendationUC.Recommend(ctx, raterID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*v1.RecommendationItem, 0, len(movies))
+	for _, m := range movies {
+		items = append(items, &v1.RecommendationItem{
+			Title:           m.Title,
+			PredictedRating: m.PredictedRating,
+		})
+	}
+
+	return &v1.GetRecommendationsReply{Items: items}, nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	v1 "src/api/movie/v1"
+	"src/internal/biz"
+)
+
+// fieldError is a violation of a rule on one field; a message field reports
+// the violations within it as its cause
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError holds the violations of all fields of a message
+type multiError interface {
+	AllErrors() []error
+}
+
+// ValidationError turns the error of a generated ValidateAll into a
+// *biz.ValidationError with every violated rule as a field, so it is
+// reported as a 422 listing the fields
+func ValidationError(err error) *biz.ValidationError {
+	invalid := &biz.ValidationError{}
+	addViolations(invalid, "", err)
+	return invalid
+}
+
+// addViolations records the violations of err on fields under path
+func addViolations(invalid *biz.ValidationError, path string, err error) {
+	var multi multiError
+	if errors.As(err, &multi) {
+		for _, e := range multi.AllErrors() {
+			addViolations(invalid, path, e)
+		}
+		return
+	}
+	var field fieldError
+	if !errors.As(err, &field) {
+		invalid.Add(strings.TrimPrefix(path, "."), "%s", err.Error())
+		return
+	}
+	path += "." + fieldName(field.Field())
+	if cause := field.Cause(); cause != nil && field.Reason() == "embedded message failed validation" {
+		addViolations(invalid, path, cause)
+		return
+	}
+	invalid.Add(strings.TrimPrefix(path, "."), "%s", strings.TrimPrefix(field.Reason(), "value "))
+}
+
+// fieldName turns the Go name of a field, as validators report it, into its
+// name in the API: "ReleaseDate" is release_date and "Credits[0]" credits[0]
+func fieldName(goName string) string {
+	var b strings.Builder
+	prev := rune(0)
+	for _, r := range goName {
+		if unicode.IsUpper(r) {
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+		prev = r
+	}
+	return b.String()
+}
+
+// validateImportRecord checks an import record with the rules movie.proto
+// declares for CreateMovieRequest, so imported movies meet the same rules as
+// created ones
+func validateImportRecord(req *biz.CreateMovieRequest) error {
+	credits := make([]*v1.CreditInput, 0, len(req.Credits))
+	for _, c := range req.Credits {
+		credits = append(credits, &v1.CreditInput{
+			PersonId:      c.PersonID,
+			Role:          string(c.Role),
+			CharacterName: c.CharacterName,
+			BillingOrder:  c.BillingOrder,
+		})
+	}
+	in := &v1.CreateMovieRequest{
+		Title:       req.Title,
+		Genre:       req.Genre,
+		ReleaseDate: req.ReleaseDate.Format("2006-01-02"),
+		Distributor: req.Distributor,
+		Budget:      req.Budget,
+		MpaRating:   req.MPARating,
+		Credits:     credits,
+		Genres:      req.Genres,
+		Tags:        req.Tags,
+		ExternalIds: req.ExternalIDs,
+	}
+	if err := in.ValidateAll(); err != nil {
+		return ValidationError(err)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "src/api/movie/v1"
+	"src/internal/biz"
+)
+
+// testFieldError is a violation as the generated validators report it
+type testFieldError struct {
+	field  string
+	reason string
+	cause  error
+}
+
+func (e testFieldError) Field() string  { return e.field }
+func (e testFieldError) Reason() string { return e.reason }
+func (e testFieldError) Cause() error   { return e.cause }
+func (e testFieldError) Error() string  { return e.field + ": " + e.reason }
+
+// testMultiError holds the violations of a message
+type testMultiError []error
+
+func (m testMultiError) Error() string      { return "multiple violations" }
+func (m testMultiError) AllErrors() []error { return m }
+
+func TestFieldName(t *testing.T) {
+	tests := []struct {
+		goName string
+		want   string
+	}{
+		{goName: "Title", want: "title"},
+		{goName: "ReleaseDate", want: "release_date"},
+		{goName: "MpaRating", want: "mpa_rating"},
+		{goName: "Credits[0]", want: "credits[0]"},
+		{goName: "ExternalIds[imdb]", want: "external_ids[imdb]"},
+		{goName: "Rating2Value", want: "rating2_value"},
+		{goName: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.goName, func(t *testing.T) {
+			if got := fieldName(tt.goName); got != tt.want {
+				t.Errorf("fieldName(%q) = %q, want %q", tt.goName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddViolations(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want []biz.FieldViolation
+	}{
+		{
+			name: "one field",
+			err:  testFieldError{field: "ReleaseDate", reason: "value does not match regex pattern"},
+			want: []biz.FieldViolation{{Field: "release_date", Description: "does not match regex pattern"}},
+		},
+		{
+			name: "every field of a message",
+			err: testMultiError{
+				testFieldError{field: "Title", reason: "value length must be at least 1 runes"},
+				testFieldError{field: "Budget", reason: "value must be greater than or equal to 0"},
+			},
+			want: []biz.FieldViolation{
+				{Field: "title", Description: "length must be at least 1 runes"},
+				{Field: "budget", Description: "must be greater than or equal to 0"},
+			},
+		},
+		{
+			name: "embedded message",
+			err: testFieldError{
+				field:  "Items[1]",
+				reason: "embedded message failed validation",
+				cause: testMultiError{
+					testFieldError{field: "MovieTitle", reason: "value is required"},
+					testFieldError{field: "Position", reason: "value must be positive"},
+				},
+			},
+			want: []biz.FieldViolation{
+				{Field: "items[1].movie_title", Description: "is required"},
+				{Field: "items[1].position", Description: "must be positive"},
+			},
+		},
+		{
+			name: "other cause is not followed",
+			err:  testFieldError{field: "Title", reason: "value is reserved", cause: errors.New("reserved words")},
+			want: []biz.FieldViolation{{Field: "title", Description: "is reserved"}},
+		},
+		{
+			name: "error without a field",
+			err:  errors.New("request is empty"),
+			want: []biz.FieldViolation{{Field: "", Description: "request is empty"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidationError(tt.err).Violations
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("violations = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidationErrorFromGeneratedValidator(t *testing.T) {
+	budget := int64(-1)
+	rating := "X"
+	req := &v1.CreateMovieRequest{
+		Title:       "",
+		Genre:       "Drama",
+		ReleaseDate: "2010-07-16",
+		Budget:      &budget,
+		MpaRating:   &rating,
+	}
+
+	invalid := ValidationError(req.ValidateAll())
+
+	fields := make([]string, 0, len(invalid.Violations))
+	for _, v := range invalid.Violations {
+		fields = append(fields, v.Field)
+	}
+	want := []string{"title", "budget", "mpa_rating"}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("fields = %v, want %v", fields, want)
+	}
+}
+
+func TestValidateImportRecord(t *testing.T) {
+	budget := int64(-1)
+	rating := "PG-13"
+	releaseDate := time.Date(2010, 7, 16, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		req        *biz.CreateMovieRequest
+		wantFields []string
+	}{
+		{
+			name: "valid record",
+			req:  &biz.CreateMovieRequest{Title: "Inception", Genre: "Sci-Fi", ReleaseDate: releaseDate, MPARating: &rating},
+		},
+		{
+			name:       "invalid fields",
+			req:        &biz.CreateMovieRequest{Title: "Inception", Genre: "--", ReleaseDate: releaseDate, Budget: &budget},
+			wantFields: []string{"genre", "budget"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateImportRecord(tt.req)
+			if tt.wantFields == nil {
+				if err != nil {
+					t.Fatalf("validateImportRecord() error = %v", err)
+				}
+				return
+			}
+			var invalid *biz.ValidationError
+			if !errors.As(err, &invalid) {
+				t.Fatalf("validateImportRecord() error = %v, want a *biz.ValidationError", err)
+			}
+			fields := make([]string, 0, len(invalid.Violations))
+			for _, v := range invalid.Violations {
+				fields = append(fields, v.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("fields = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}